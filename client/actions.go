@@ -0,0 +1,367 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gomicro/concord/report"
+	"github.com/google/go-github/v56/github"
+	"golang.org/x/exp/slices"
+)
+
+// GetActionsPermissions returns whether GitHub Actions is enabled on the repo
+// and which actions it may run.
+func (c *Client) GetActionsPermissions(ctx context.Context, org, repo string) (*github.ActionsPermissionsRepository, error) {
+	c.rate.Wait(ctx) //nolint: errcheck
+	p, resp, err := c.ghClient.Repositories.GetActionsPermissions(ctx, org, repo)
+	if err != nil {
+		if _, ok := err.(*github.RateLimitError); ok {
+			return nil, ErrRateLimited
+		}
+
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return nil, ErrRepoNotFound
+		}
+
+		return nil, wrapErr("get actions permissions", org+"/"+repo, err)
+	}
+
+	return p, nil
+}
+
+// SetActionsPermissions queues enabling or disabling GitHub Actions on the
+// repo and setting which actions it may run. Settings left unset in desired
+// are kept, and nothing is queued when the rest already match.
+func (c *Client) SetActionsPermissions(ctx context.Context, org, repo string, current, desired *github.ActionsPermissionsRepository) {
+	cs := &report.ChangeSet{}
+
+	if desired.Enabled != nil && current.GetEnabled() != desired.GetEnabled() {
+		cs.Add(fieldChange("actions enabled", fmt.Sprint(current.GetEnabled()), fmt.Sprint(desired.GetEnabled())))
+	}
+
+	if desired.AllowedActions != nil && current.GetAllowedActions() != desired.GetAllowedActions() {
+		cs.Add(fieldChange("allowed actions", current.GetAllowedActions(), desired.GetAllowedActions()))
+	}
+
+	if !cs.HasChanges() {
+		report.PrintInfo("actions permissions are up to date")
+		report.Println()
+
+		return
+	}
+
+	// github needs enabled on every update
+	if desired.Enabled == nil {
+		desired.Enabled = github.Bool(current.GetEnabled())
+	}
+
+	cs.PrintPre()
+
+	c.Add(Change{Resource: "actions permissions", Action: "update", Org: org, Repo: repo, Target: desired.GetAllowedActions()}, func() error {
+		c.rate.Wait(ctx) //nolint: errcheck
+		_, _, err := c.ghClient.Repositories.EditActionsPermissions(ctx, org, repo, *desired)
+		if err != nil {
+			if _, ok := err.(*github.RateLimitError); ok {
+				return ErrRateLimited
+			}
+
+			return wrapErr("edit actions permissions", org+"/"+repo, err)
+		}
+
+		cs.PrintPost()
+
+		return nil
+	})
+}
+
+// GetActionsAllowed returns the actions the repo may run when its allowed
+// actions are set to selected.
+func (c *Client) GetActionsAllowed(ctx context.Context, org, repo string) (*github.ActionsAllowed, error) {
+	c.rate.Wait(ctx) //nolint: errcheck
+	a, resp, err := c.ghClient.Repositories.GetActionsAllowed(ctx, org, repo)
+	if err != nil {
+		if _, ok := err.(*github.RateLimitError); ok {
+			return nil, ErrRateLimited
+		}
+
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return nil, ErrRepoNotFound
+		}
+
+		return nil, wrapErr("get allowed actions", org+"/"+repo, err)
+	}
+
+	return a, nil
+}
+
+// SetActionsAllowed queues setting which actions the repo may run when its
+// allowed actions are set to selected. Settings left unset in desired are
+// kept, and nothing is queued when the rest already match.
+func (c *Client) SetActionsAllowed(ctx context.Context, org, repo string, current, desired *github.ActionsAllowed) {
+	cs := allowedChanges(current, desired)
+
+	if !cs.HasChanges() {
+		report.PrintInfo("allowed actions are up to date")
+		report.Println()
+
+		return
+	}
+
+	cs.PrintPre()
+
+	c.Add(Change{Resource: "allowed actions", Action: "update", Org: org, Repo: repo}, func() error {
+		c.rate.Wait(ctx) //nolint: errcheck
+		_, _, err := c.ghClient.Repositories.EditActionsAllowed(ctx, org, repo, *desired)
+		if err != nil {
+			if _, ok := err.(*github.RateLimitError); ok {
+				return ErrRateLimited
+			}
+
+			return wrapErr("edit allowed actions", org+"/"+repo, err)
+		}
+
+		cs.PrintPost()
+
+		return nil
+	})
+}
+
+// allowedChanges lists the differences between the selected actions allowed
+// and how they should be, filling the settings left unset in desired from
+// current so an update keeps them.
+func allowedChanges(current, desired *github.ActionsAllowed) *report.ChangeSet {
+	cs := &report.ChangeSet{}
+
+	if desired.GithubOwnedAllowed == nil {
+		desired.GithubOwnedAllowed = github.Bool(current.GetGithubOwnedAllowed())
+	} else if current.GetGithubOwnedAllowed() != desired.GetGithubOwnedAllowed() {
+		cs.Add(fieldChange("github owned actions allowed", fmt.Sprint(current.GetGithubOwnedAllowed()), fmt.Sprint(desired.GetGithubOwnedAllowed())))
+	}
+
+	if desired.VerifiedAllowed == nil {
+		desired.VerifiedAllowed = github.Bool(current.GetVerifiedAllowed())
+	} else if current.GetVerifiedAllowed() != desired.GetVerifiedAllowed() {
+		cs.Add(fieldChange("verified actions allowed", fmt.Sprint(current.GetVerifiedAllowed()), fmt.Sprint(desired.GetVerifiedAllowed())))
+	}
+
+	if desired.PatternsAllowed == nil {
+		desired.PatternsAllowed = current.PatternsAllowed
+	} else {
+		cp := slices.Clone(current.PatternsAllowed)
+		slices.Sort(cp)
+
+		dp := slices.Clone(desired.PatternsAllowed)
+		slices.Sort(dp)
+
+		if !slices.Equal(cp, dp) {
+			cs.Add(fieldChange("allowed action patterns", strings.Join(cp, ", "), strings.Join(dp, ", ")))
+		}
+	}
+
+	return cs
+}
+
+// GetOrgActionsPermissions returns which of the org's repos may use GitHub
+// Actions and which actions they may run.
+func (c *Client) GetOrgActionsPermissions(ctx context.Context, org string) (*github.ActionsPermissions, error) {
+	c.rate.Wait(ctx) //nolint: errcheck
+	p, resp, err := c.ghClient.Actions.GetActionsPermissions(ctx, org)
+	if err != nil {
+		if _, ok := err.(*github.RateLimitError); ok {
+			return nil, ErrRateLimited
+		}
+
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return nil, ErrOrgNotFound
+		}
+
+		return nil, wrapErr("get actions permissions", org, err)
+	}
+
+	return p, nil
+}
+
+// SetOrgActionsPermissions queues setting which of the org's repos may use
+// GitHub Actions and which actions they may run. Settings left unset in
+// desired are kept, and nothing is queued when the rest already match.
+func (c *Client) SetOrgActionsPermissions(ctx context.Context, org string, current, desired *github.ActionsPermissions) {
+	cs := &report.ChangeSet{}
+
+	if desired.EnabledRepositories != nil && current.GetEnabledRepositories() != desired.GetEnabledRepositories() {
+		cs.Add(fieldChange("actions enabled repositories", current.GetEnabledRepositories(), desired.GetEnabledRepositories()))
+	}
+
+	if desired.AllowedActions != nil && current.GetAllowedActions() != desired.GetAllowedActions() {
+		cs.Add(fieldChange("allowed actions", current.GetAllowedActions(), desired.GetAllowedActions()))
+	}
+
+	if !cs.HasChanges() {
+		report.PrintInfo("actions permissions are up to date")
+		report.Println()
+
+		return
+	}
+
+	// github needs the enabled repositories on every update
+	if desired.EnabledRepositories == nil {
+		desired.EnabledRepositories = current.EnabledRepositories
+	}
+
+	cs.PrintPre()
+
+	c.Add(Change{Resource: "actions permissions", Action: "update", Org: org, Target: desired.GetEnabledRepositories()}, func() error {
+		c.rate.Wait(ctx) //nolint: errcheck
+		_, _, err := c.ghClient.Actions.EditActionsPermissions(ctx, org, *desired)
+		if err != nil {
+			if _, ok := err.(*github.RateLimitError); ok {
+				return ErrRateLimited
+			}
+
+			return wrapErr("edit actions permissions", org, err)
+		}
+
+		cs.PrintPost()
+
+		return nil
+	})
+}
+
+// ListOrgActionsRepos returns the names of the repos that may use GitHub
+// Actions when the org only enables selected repos.
+func (c *Client) ListOrgActionsRepos(ctx context.Context, org string) ([]string, error) {
+	opts := &github.ListOptions{
+		Page:    0,
+		PerPage: 100,
+	}
+
+	var names []string
+	for {
+		c.rate.Wait(ctx) //nolint: errcheck
+		rs, resp, err := c.ghClient.Actions.ListEnabledReposInOrg(ctx, org, opts)
+		if err != nil {
+			if _, ok := err.(*github.RateLimitError); ok {
+				return nil, ErrRateLimited
+			}
+
+			if resp != nil && resp.StatusCode == http.StatusNotFound {
+				return nil, ErrOrgNotFound
+			}
+
+			return nil, wrapErr("list actions repos", org, err)
+		}
+
+		for _, r := range rs.Repositories {
+			names = append(names, r.GetName())
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+
+		opts.Page = resp.NextPage
+	}
+
+	return names, nil
+}
+
+// SetOrgActionsRepos queues replacing the repos that may use GitHub Actions
+// when the org only enables selected repos. Nothing is queued when the same
+// repos are already enabled. Repo ids are looked up at apply time so repos
+// created in the same run resolve.
+func (c *Client) SetOrgActionsRepos(ctx context.Context, org string, current, repos []string) {
+	// sorted ignoring case, so names differing only in case line up
+	byName := func(a, b string) int {
+		return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+	}
+
+	cr := slices.Clone(current)
+	slices.SortFunc(cr, byName)
+
+	dr := slices.Clone(repos)
+	slices.SortFunc(dr, byName)
+
+	if slices.EqualFunc(cr, dr, strings.EqualFold) {
+		report.PrintInfo("actions repositories are up to date")
+		report.Println()
+
+		return
+	}
+
+	cs := &report.ChangeSet{}
+	cs.Add(fieldChange("actions repositories", strings.Join(cr, ", "), strings.Join(dr, ", ")))
+
+	cs.PrintPre()
+
+	c.Add(Change{Resource: "actions repositories", Action: "update", Org: org}, func() error {
+		ids, err := c.repoIDs(ctx, org, "selected", repos)
+		if err != nil {
+			return err
+		}
+
+		c.rate.Wait(ctx) //nolint: errcheck
+		_, err = c.ghClient.Actions.SetEnabledReposInOrg(ctx, org, ids)
+		if err != nil {
+			if _, ok := err.(*github.RateLimitError); ok {
+				return ErrRateLimited
+			}
+
+			return wrapErr("set actions repos", org, err)
+		}
+
+		cs.PrintPost()
+
+		return nil
+	})
+}
+
+// GetOrgActionsAllowed returns the actions the org's repos may run when its
+// allowed actions are set to selected.
+func (c *Client) GetOrgActionsAllowed(ctx context.Context, org string) (*github.ActionsAllowed, error) {
+	c.rate.Wait(ctx) //nolint: errcheck
+	a, resp, err := c.ghClient.Actions.GetActionsAllowed(ctx, org)
+	if err != nil {
+		if _, ok := err.(*github.RateLimitError); ok {
+			return nil, ErrRateLimited
+		}
+
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return nil, ErrOrgNotFound
+		}
+
+		return nil, wrapErr("get allowed actions", org, err)
+	}
+
+	return a, nil
+}
+
+// SetOrgActionsAllowed is SetActionsAllowed for the org as a whole.
+func (c *Client) SetOrgActionsAllowed(ctx context.Context, org string, current, desired *github.ActionsAllowed) {
+	cs := allowedChanges(current, desired)
+
+	if !cs.HasChanges() {
+		report.PrintInfo("allowed actions are up to date")
+		report.Println()
+
+		return
+	}
+
+	cs.PrintPre()
+
+	c.Add(Change{Resource: "allowed actions", Action: "update", Org: org}, func() error {
+		c.rate.Wait(ctx) //nolint: errcheck
+		_, _, err := c.ghClient.Actions.EditActionsAllowed(ctx, org, *desired)
+		if err != nil {
+			if _, ok := err.(*github.RateLimitError); ok {
+				return ErrRateLimited
+			}
+
+			return wrapErr("edit allowed actions", org, err)
+		}
+
+		cs.PrintPost()
+
+		return nil
+	})
+}
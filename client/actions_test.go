@@ -0,0 +1,226 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/gomicro/concord/report"
+	"github.com/google/go-github/v56/github"
+	"golang.org/x/exp/slices"
+)
+
+// putRecorder answers every PUT with an empty object, keeping the body it was
+// sent by path.
+func putRecorder(t *testing.T, sent map[string]map[string]interface{}) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+
+		body := map[string]interface{}{}
+		json.NewDecoder(r.Body).Decode(&body) //nolint: errcheck
+		sent[strings.TrimPrefix(r.URL.Path, "/api/v3")] = body
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{}`)) //nolint: errcheck
+	})
+}
+
+func TestSetActionsPermissions(t *testing.T) {
+	current := &github.ActionsPermissionsRepository{
+		Enabled:        github.Bool(true),
+		AllowedActions: github.String("all"),
+	}
+
+	tests := []struct {
+		name    string
+		desired *github.ActionsPermissionsRepository
+		want    map[string]interface{}
+		printed string
+	}{
+		{
+			name:    "up to date",
+			desired: &github.ActionsPermissionsRepository{Enabled: github.Bool(true), AllowedActions: github.String("all")},
+			printed: "actions permissions are up to date",
+		},
+		{
+			name:    "only allowed actions keeps enabled",
+			desired: &github.ActionsPermissionsRepository{AllowedActions: github.String("selected")},
+			want:    map[string]interface{}{"enabled": true, "allowed_actions": "selected"},
+			printed: "updating allowed actions: 'all' -> 'selected'",
+		},
+		{
+			name:    "disabled",
+			desired: &github.ActionsPermissionsRepository{Enabled: github.Bool(false)},
+			want:    map[string]interface{}{"enabled": false},
+			printed: "updating actions enabled: 'true' -> 'false'",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sent := map[string]map[string]interface{}{}
+			c := newTestClient(t, putRecorder(t, sent))
+
+			var out strings.Builder
+			report.SetOutput(&out)
+			report.SetColor(false)
+
+			c.SetActionsPermissions(context.Background(), "acme", "widgets", current, tt.desired)
+
+			if !strings.Contains(out.String(), tt.printed) {
+				t.Errorf("output missing %q:\n%s", tt.printed, out.String())
+			}
+
+			err := c.Flush(context.Background())
+			if err != nil {
+				t.Fatalf("flush: %v", err)
+			}
+
+			body, ok := sent["/repos/acme/widgets/actions/permissions"]
+			if ok != (tt.want != nil) {
+				t.Fatalf("sent %v, want %v", sent, tt.want)
+			}
+
+			for k, v := range tt.want {
+				if body[k] != v {
+					t.Errorf("sent %s = %v, want %v", k, body[k], v)
+				}
+			}
+		})
+	}
+}
+
+func TestAllowedChanges(t *testing.T) {
+	current := &github.ActionsAllowed{
+		GithubOwnedAllowed: github.Bool(true),
+		VerifiedAllowed:    github.Bool(false),
+		PatternsAllowed:    []string{"acme/*", "docker/*"},
+	}
+
+	tests := []struct {
+		name     string
+		desired  *github.ActionsAllowed
+		changes  bool
+		patterns []string
+	}{
+		{
+			name:     "unset settings are kept",
+			desired:  &github.ActionsAllowed{},
+			patterns: []string{"acme/*", "docker/*"},
+		},
+		{
+			name:     "pattern order is ignored",
+			desired:  &github.ActionsAllowed{PatternsAllowed: []string{"docker/*", "acme/*"}},
+			patterns: []string{"docker/*", "acme/*"},
+		},
+		{
+			name:     "patterns replaced",
+			desired:  &github.ActionsAllowed{PatternsAllowed: []string{"acme/*"}},
+			changes:  true,
+			patterns: []string{"acme/*"},
+		},
+		{
+			name:     "verified allowed",
+			desired:  &github.ActionsAllowed{VerifiedAllowed: github.Bool(true)},
+			changes:  true,
+			patterns: []string{"acme/*", "docker/*"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cs := allowedChanges(current, tt.desired)
+
+			if cs.HasChanges() != tt.changes {
+				t.Errorf("changes = %v, want %v", cs.HasChanges(), tt.changes)
+			}
+
+			// the desired settings are filled so an update keeps the rest
+			if tt.desired.GithubOwnedAllowed == nil || tt.desired.VerifiedAllowed == nil {
+				t.Errorf("desired left with unset settings: %+v", tt.desired)
+			}
+
+			if !slices.Equal(tt.desired.PatternsAllowed, tt.patterns) {
+				t.Errorf("patterns = %v, want %v", tt.desired.PatternsAllowed, tt.patterns)
+			}
+		})
+	}
+}
+
+func TestSetOrgActionsPermissionsKeepsScope(t *testing.T) {
+	sent := map[string]map[string]interface{}{}
+	c := newTestClient(t, putRecorder(t, sent))
+
+	current := &github.ActionsPermissions{
+		EnabledRepositories: github.String("selected"),
+		AllowedActions:      github.String("all"),
+	}
+
+	c.SetOrgActionsPermissions(context.Background(), "acme", current, &github.ActionsPermissions{AllowedActions: github.String("local_only")})
+
+	err := c.Flush(context.Background())
+	if err != nil {
+		t.Fatalf("flush: %v", err)
+	}
+
+	body := sent["/orgs/acme/actions/permissions"]
+	if body["enabled_repositories"] != "selected" || body["allowed_actions"] != "local_only" {
+		t.Errorf("sent %v, want the scope kept and local_only", body)
+	}
+}
+
+func TestSetOrgActionsRepos(t *testing.T) {
+	tests := []struct {
+		name    string
+		current []string
+		repos   []string
+		ids     []interface{}
+	}{
+		{name: "same repos in another order and case", current: []string{"Widgets", "gadgets"}, repos: []string{"gadgets", "widgets"}},
+		{name: "repo added", current: []string{"widgets"}, repos: []string{"widgets", "gadgets"}, ids: []interface{}{float64(1), float64(2)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ids := map[string]int{"widgets": 1, "gadgets": 2}
+			var sent []interface{}
+
+			c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+
+				switch {
+				case r.Method == http.MethodGet && strings.HasPrefix(r.URL.Path, "/api/v3/repos/acme/"):
+					name := strings.TrimPrefix(r.URL.Path, "/api/v3/repos/acme/")
+					json.NewEncoder(w).Encode(map[string]interface{}{"id": ids[name], "name": name}) //nolint: errcheck
+				case r.Method == http.MethodPut && r.URL.Path == "/api/v3/orgs/acme/actions/permissions/repositories":
+					body := map[string][]interface{}{}
+					json.NewDecoder(r.Body).Decode(&body) //nolint: errcheck
+					sent = body["selected_repository_ids"]
+
+					w.WriteHeader(http.StatusNoContent)
+				default:
+					t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+				}
+			}))
+
+			c.SetOrgActionsRepos(context.Background(), "acme", tt.current, tt.repos)
+
+			if (len(c.Pending()) != 0) != (tt.ids != nil) {
+				t.Fatalf("queued %v, want a change %v", c.Pending(), tt.ids != nil)
+			}
+
+			err := c.Flush(context.Background())
+			if err != nil {
+				t.Fatalf("flush: %v", err)
+			}
+
+			if !slices.Equal(sent, tt.ids) {
+				t.Errorf("sent ids %v, want %v", sent, tt.ids)
+			}
+		})
+	}
+}
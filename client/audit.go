@@ -0,0 +1,80 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+)
+
+// Change describes a single write queued against github.
+type Change struct {
+	Resource string `json:"resource"`
+	Action   string `json:"action"`
+	Org      string `json:"org"`
+	Repo     string `json:"repo,omitempty"`
+	Target   string `json:"target,omitempty"`
+}
+
+// Destructive reports whether the change takes something away, such as
+// deleting a resource, removing access, or archiving a repo.
+func (c Change) Destructive() bool {
+	switch c.Action {
+	case "delete", "remove", "cancel invitation", "disable", "archive":
+		return true
+	}
+
+	return false
+}
+
+type auditEntry struct {
+	Time time.Time `json:"time"`
+	Change
+	DryRun bool `json:"dry_run"`
+}
+
+// WithAuditLog writes a JSON line to w for every change the client applies,
+// or would apply on a dry run.
+func WithAuditLog(w io.Writer) Option {
+	return func(o *options) {
+		o.audit = w
+	}
+}
+
+func (c *Client) audit(change Change, dry bool) error {
+	if c.auditLog == nil {
+		return nil
+	}
+
+	err := c.auditLog.Encode(auditEntry{
+		Time:   time.Now().UTC(),
+		Change: change,
+		DryRun: dry,
+	})
+	if err != nil {
+		return fmt.Errorf("write audit log: %w", err)
+	}
+
+	return nil
+}
+
+// RecordDryRun logs the queued changes to the audit log as dry run entries
+// without applying them.
+func (c *Client) RecordDryRun() error {
+	for _, q := range c.stack {
+		err := c.audit(q.change, true)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func newAuditLog(w io.Writer) *json.Encoder {
+	if w == nil {
+		return nil
+	}
+
+	return json.NewEncoder(w)
+}
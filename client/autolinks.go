@@ -0,0 +1,107 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/gomicro/concord/report"
+	"github.com/google/go-github/v56/github"
+)
+
+// ListAutolinks lists the repo's autolink references.
+func (c *Client) ListAutolinks(ctx context.Context, org, repo string) ([]*github.Autolink, error) {
+	opts := &github.ListOptions{
+		Page:    0,
+		PerPage: 100,
+	}
+
+	var links []*github.Autolink
+	for {
+		c.rate.Wait(ctx) //nolint: errcheck
+		ls, resp, err := c.ghClient.Repositories.ListAutolinks(ctx, org, repo, opts)
+		if err != nil {
+			if _, ok := err.(*github.RateLimitError); ok {
+				return nil, ErrRateLimited
+			}
+
+			if resp != nil && resp.StatusCode == http.StatusNotFound {
+				return nil, ErrRepoNotFound
+			}
+
+			return nil, wrapErr("list autolinks", org+"/"+repo, err)
+		}
+
+		links = append(links, ls...)
+
+		if resp.NextPage == 0 {
+			break
+		}
+
+		opts.Page = resp.NextPage
+	}
+
+	return links, nil
+}
+
+// AddAutolink queues adding an autolink reference to the repo.
+func (c *Client) AddAutolink(ctx context.Context, org, repo string, link *github.AutolinkOptions) {
+	prefix := link.GetKeyPrefix()
+
+	target := fmt.Sprintf("'%s' to '%s', alphanumeric '%t'", prefix, link.GetURLTemplate(), link.GetIsAlphanumeric())
+
+	cs := &report.ChangeSet{}
+	cs.Add("adding autolink "+target, "added autolink "+target)
+
+	cs.PrintPre()
+
+	c.Add(Change{Resource: "autolink", Action: "create", Org: org, Repo: repo, Target: prefix}, func() error {
+		c.rate.Wait(ctx) //nolint: errcheck
+		_, resp, err := c.ghClient.Repositories.AddAutolink(ctx, org, repo, link)
+		if err != nil {
+			if _, ok := err.(*github.RateLimitError); ok {
+				return ErrRateLimited
+			}
+
+			if resp != nil && resp.StatusCode == http.StatusNotFound {
+				return ErrRepoNotFound
+			}
+
+			return wrapErr("add autolink "+prefix, org+"/"+repo, err)
+		}
+
+		cs.PrintPost()
+
+		return nil
+	})
+}
+
+// DeleteAutolink queues removing an autolink reference from the repo.
+func (c *Client) DeleteAutolink(ctx context.Context, org, repo string, link *github.Autolink) {
+	prefix := link.GetKeyPrefix()
+
+	cs := &report.ChangeSet{}
+	cs.Add("removing autolink '"+prefix+"'", "removed autolink '"+prefix+"'")
+
+	cs.PrintPre()
+
+	c.Add(Change{Resource: "autolink", Action: "delete", Org: org, Repo: repo, Target: prefix}, func() error {
+		c.rate.Wait(ctx) //nolint: errcheck
+		resp, err := c.ghClient.Repositories.DeleteAutolink(ctx, org, repo, link.GetID())
+		if err != nil {
+			if _, ok := err.(*github.RateLimitError); ok {
+				return ErrRateLimited
+			}
+
+			if resp != nil && resp.StatusCode == http.StatusNotFound {
+				return ErrRepoNotFound
+			}
+
+			return wrapErr("delete autolink "+prefix, org+"/"+repo, err)
+		}
+
+		cs.PrintPost()
+
+		return nil
+	})
+}
@@ -0,0 +1,95 @@
+package client
+
+import (
+	"strings"
+	"sync"
+
+	"golang.org/x/exp/slices"
+
+	"github.com/google/go-github/v56/github"
+)
+
+// cache holds the repos and branch protections read during a single run so
+// every step reconciling a repo does not fetch them again. Entries are
+// dropped when a queued change writes to them.
+type cache struct {
+	mu          sync.Mutex
+	repos       map[string]*github.Repository
+	protections map[string]*github.Protection
+
+	// protected lists, per repo, every branch with protection when that is
+	// known from a bulk read, so other branches need not be read one by one
+	protected map[string][]string
+}
+
+func newCache() *cache {
+	return &cache{
+		repos:       map[string]*github.Repository{},
+		protections: map[string]*github.Protection{},
+		protected:   map[string][]string{},
+	}
+}
+
+func (c *cache) repo(org, repo string) (*github.Repository, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	r, ok := c.repos[org+"/"+repo]
+	return r, ok
+}
+
+func (c *cache) setRepo(org, repo string, r *github.Repository) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.repos[org+"/"+repo] = r
+}
+
+// forgetRepo drops a repo along with all of its branch protections.
+func (c *cache) forgetRepo(org, repo string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.repos, org+"/"+repo)
+	delete(c.protected, org+"/"+repo)
+
+	for k := range c.protections {
+		if strings.HasPrefix(k, org+"/"+repo+":") {
+			delete(c.protections, k)
+		}
+	}
+}
+
+// protection returns a cached branch protection. A nil protection that is
+// found means the branch is known to be unprotected, either from reading it or
+// from it not being among the repo's protected branches.
+func (c *cache) protection(org, repo, branch string) (*github.Protection, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	p, ok := c.protections[org+"/"+repo+":"+branch]
+	if ok {
+		return p, true
+	}
+
+	branches, known := c.protected[org+"/"+repo]
+	if known && !slices.Contains(branches, branch) {
+		return nil, true
+	}
+
+	return nil, false
+}
+
+func (c *cache) setProtectedBranches(org, repo string, branches []string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.protected[org+"/"+repo] = branches
+}
+
+func (c *cache) setProtection(org, repo, branch string, p *github.Protection) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.protections[org+"/"+repo+":"+branch] = p
+}
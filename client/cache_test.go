@@ -0,0 +1,143 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+	"testing"
+)
+
+// countingHandler answers the repo and branch protection reads with
+// something valid, counting every request it sees.
+func countingHandler(calls *int64) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt64(calls, 1)
+
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/api/v3/repos/acme/widgets":
+			w.Write([]byte(`{"name": "widgets", "default_branch": "main"}`)) //nolint: errcheck
+		case "/api/v3/repos/acme/widgets/branches/main/protection":
+			w.Write([]byte(`{"enforce_admins": {"enabled": true}}`)) //nolint: errcheck
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// readRepo reads a repo and its default branch protection the way the steps
+// reconciling a repo do, each one fetching what it needs for itself.
+func readRepo(ctx context.Context, c *Client) error {
+	for i := 0; i < 3; i++ {
+		_, err := c.GetRepo(ctx, "acme", "widgets")
+		if err != nil {
+			return err
+		}
+
+		_, err = c.GetBranchProtection(ctx, "acme", "widgets", "main")
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func TestCacheReadsOnce(t *testing.T) {
+	var calls int64
+	c := newTestClient(t, countingHandler(&calls))
+	ctx := context.Background()
+
+	err := readRepo(ctx, c)
+	if err != nil {
+		t.Fatalf("read repo: %v", err)
+	}
+
+	if calls != 2 {
+		t.Errorf("made %d calls, want 2", calls)
+	}
+
+	c.Add(Change{Resource: "repo", Action: "update", Org: "acme", Repo: "widgets"}, func() error { return nil })
+
+	err = c.Flush(ctx)
+	if err != nil {
+		t.Fatalf("flush: %v", err)
+	}
+
+	err = readRepo(ctx, c)
+	if err != nil {
+		t.Fatalf("read repo after a write: %v", err)
+	}
+
+	if calls != 4 {
+		t.Errorf("made %d calls after a write, want 4", calls)
+	}
+}
+
+func TestCacheRemembersUnprotected(t *testing.T) {
+	var calls int64
+	c := newTestClient(t, countingHandler(&calls))
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		_, err := c.GetBranchProtection(ctx, "acme", "widgets", "develop")
+		if err != ErrBranchProtectionNotFound {
+			t.Fatalf("err = %v, want %v", err, ErrBranchProtectionNotFound)
+		}
+	}
+
+	if calls != 1 {
+		t.Errorf("made %d calls, want 1", calls)
+	}
+}
+
+func BenchmarkRepoReads(b *testing.B) {
+	ctx := context.Background()
+
+	b.Run("cached", func(b *testing.B) {
+		var calls int64
+		c := newTestClient(b, countingHandler(&calls), WithRate(1e6, 1e6))
+
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			// each run starts with nothing read
+			c.Reset()
+
+			err := readRepo(ctx, c)
+			if err != nil {
+				b.Fatal(err)
+			}
+		}
+
+		b.ReportMetric(float64(calls)/float64(b.N), "calls/op")
+	})
+
+	b.Run("uncached", func(b *testing.B) {
+		var calls int64
+		c := newTestClient(b, countingHandler(&calls), WithRate(1e6, 1e6))
+
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			// dropping the cache before every read is what the client did
+			// before it had one
+			for j := 0; j < 3; j++ {
+				c.Reset()
+
+				_, err := c.GetRepo(ctx, "acme", "widgets")
+				if err != nil {
+					b.Fatal(err)
+				}
+
+				c.Reset()
+
+				_, err = c.GetBranchProtection(ctx, "acme", "widgets", "main")
+				if err != nil {
+					b.Fatal(err)
+				}
+			}
+		}
+
+		b.ReportMetric(float64(calls)/float64(b.N), "calls/op")
+	})
+}
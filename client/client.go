@@ -3,9 +3,13 @@ package client
 import (
 	"context"
 	"crypto/tls"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
+	"net/url"
+	"time"
 
 	"github.com/gomicro/concord/report"
 	"github.com/gomicro/trust"
@@ -20,22 +24,147 @@ const (
 )
 
 var (
-	ErrClientNotFound = errors.New("client not found in context")
-	ErrTokenEmpty     = errors.New("token is empty; please run `concord auth` or set the GITHUB_TOKEN environment variable")
+	ErrClientNotFound    = errors.New("client not found in context")
+	ErrInvalidBaseURL    = errors.New("invalid base url")
+	ErrRateLimited       = errors.New("github: hit rate limit")
+	ErrRateLimitDisabled = errors.New("github: rate limiting is not enabled")
+	ErrTokenEmpty        = errors.New("token is empty; please run `concord auth`, pass --token, or set the CONCORD_GITHUB_TOKEN or GITHUB_TOKEN environment variable")
 )
 
+// Client wraps the github API. Reads happen straight away, while writes such
+// as InviteMember only print the planned change and queue the call. Queued
+// calls run, in order, when Flush is called, and any failure surfaces there
+// rather than from the write method itself.
 type Client struct {
 	ghClient *github.Client
 	rate     *rate.Limiter
+	cache    *cache
 
-	stack []func() error
+	auditLog        *json.Encoder
+	continueOnError bool
+	includeArchived bool
+	graphql         bool
+
+	commitAuthor  *github.CommitAuthor
+	commitTrailer string
+
+	stack []queued
+}
+
+type queued struct {
+	change  Change
+	details []string
+	fn      func() error
+}
+
+// PlannedChange is a queued change along with the lines printed when it was
+// planned, such as "updating description: 'a' -> 'b'".
+type PlannedChange struct {
+	Change
+	Details []string `json:"details,omitempty"`
+}
+
+// Option configures optional client settings.
+type Option func(*options)
+
+type options struct {
+	baseURL         string
+	audit           io.Writer
+	continueOnError bool
+	includeArchived bool
+	graphql         bool
+	commitAuthor    *github.CommitAuthor
+	commitTrailer   string
+	rate            float64
+	burst           int
+	requestTimeout  time.Duration
+}
+
+// WithBaseURL points the client at a GitHub Enterprise Server instance. The
+// API and upload paths are added to the host when they are missing.
+func WithBaseURL(u string) Option {
+	return func(o *options) {
+		o.baseURL = u
+	}
+}
+
+// WithContinueOnError makes Flush report failed changes and carry on with the
+// rest of the queue. Rate limit errors still stop it.
+func WithContinueOnError(continueOnError bool) Option {
+	return func(o *options) {
+		o.continueOnError = continueOnError
+	}
+}
+
+// WithIncludeArchived makes GetRepos list archived repos too, which are left
+// out by default.
+func WithIncludeArchived(includeArchived bool) Option {
+	return func(o *options) {
+		o.includeArchived = includeArchived
+	}
+}
+
+// WithCommitAuthor sets the author and committer of the commits the client
+// makes when changing files, instead of the token's user.
+func WithCommitAuthor(name, email string) Option {
+	return func(o *options) {
+		o.commitAuthor = &github.CommitAuthor{
+			Name:  github.String(name),
+			Email: github.String(email),
+		}
+	}
 }
 
-func New(ctx context.Context, tkn string) (*Client, error) {
+// WithCommitTrailer adds a line, such as [skip ci], to the end of the message
+// of every commit the client makes when changing files.
+func WithCommitTrailer(trailer string) Option {
+	return func(o *options) {
+		o.commitTrailer = trailer
+	}
+}
+
+// WithGraphQL makes GetRepos read an org's repos, their topics, and which of
+// their branches are protected over GraphQL, a page of 50 repos per call,
+// caching them for the reads that follow instead of reading each repo over
+// REST. Writes always use REST.
+func WithGraphQL(graphql bool) Option {
+	return func(o *options) {
+		o.graphql = graphql
+	}
+}
+
+// WithRate sets how many requests per second the client makes to github, and
+// how many it may burst above that. Zero values keep the defaults.
+func WithRate(requestsPerSecond float64, burst int) Option {
+	return func(o *options) {
+		o.rate = requestsPerSecond
+		o.burst = burst
+	}
+}
+
+// WithRequestTimeout limits how long any single call to github may take,
+// including reading its response, apart from any deadline on the run as a
+// whole. Calls that take longer fail with ErrRequestTimeout. Zero sets no
+// limit.
+func WithRequestTimeout(d time.Duration) Option {
+	return func(o *options) {
+		o.requestTimeout = d
+	}
+}
+
+func New(ctx context.Context, tkn string, opts ...Option) (*Client, error) {
 	if tkn == "" {
 		return nil, ErrTokenEmpty
 	}
 
+	o := &options{
+		rate:  RequestsPerSecond,
+		burst: BurstLimit,
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+
 	pool := trust.New()
 
 	certs, err := pool.CACerts()
@@ -44,8 +173,15 @@ func New(ctx context.Context, tkn string) (*Client, error) {
 	}
 
 	httpClient := &http.Client{
-		Transport: &http.Transport{
-			TLSClientConfig: &tls.Config{RootCAs: certs},
+		Transport: &retryTransport{
+			next: &timeoutTransport{
+				timeout: o.requestTimeout,
+				next: &traceTransport{
+					next: &http.Transport{
+						TLSClientConfig: &tls.Config{RootCAs: certs},
+					},
+				},
+			},
 		},
 	}
 
@@ -57,23 +193,133 @@ func New(ctx context.Context, tkn string) (*Client, error) {
 		},
 	)
 
+	if o.rate <= 0 {
+		o.rate = RequestsPerSecond
+	}
+
+	if o.burst <= 0 {
+		o.burst = BurstLimit
+	}
+
 	rl := rate.NewLimiter(
-		rate.Limit(RequestsPerSecond),
-		BurstLimit,
+		rate.Limit(o.rate),
+		o.burst,
 	)
 
+	ghClient := github.NewClient(oauth2.NewClient(ctx, ts))
+
+	if o.baseURL != "" {
+		u, err := url.Parse(o.baseURL)
+		if err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+			return nil, fmt.Errorf("%w: '%s' must be an absolute http(s) url", ErrInvalidBaseURL, o.baseURL)
+		}
+
+		ghClient, err = ghClient.WithEnterpriseURLs(o.baseURL, o.baseURL)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidBaseURL, err)
+		}
+	}
+
 	return &Client{
-		ghClient: github.NewClient(oauth2.NewClient(ctx, ts)),
+		ghClient: ghClient,
 		rate:     rl,
+		cache:    newCache(),
+		auditLog: newAuditLog(o.audit),
+
+		continueOnError: o.continueOnError,
+		includeArchived: o.includeArchived,
+		graphql:         o.graphql,
+
+		commitAuthor:  o.commitAuthor,
+		commitTrailer: o.commitTrailer,
 	}, nil
 }
 
-func (c *Client) Add(fn func() error) {
-	c.stack = append(c.stack, fn)
+// connErr adds the targeted endpoint to errors where github could not be
+// reached at all, which usually points to a wrong base url.
+func (c *Client) connErr(err error) error {
+	var uerr *url.Error
+	if errors.As(err, &uerr) {
+		return fmt.Errorf("connect to %s: %w", c.BaseURL(), err)
+	}
+
+	return err
+}
+
+// wrapErr adds what was being done, and to which org, repo, or other
+// resource, to an error from github, such as "get repo acme/widgets: ...".
+func wrapErr(op, resource string, err error) error {
+	return fmt.Errorf("%s %s: %w", op, resource, err)
+}
+
+// BaseURL returns the API endpoint the client targets.
+func (c *Client) BaseURL() string {
+	return c.ghClient.BaseURL.String()
+}
+
+// IsEnterprise reports whether the client targets a GitHub Enterprise Server
+// instance rather than github.com.
+func (c *Client) IsEnterprise() bool {
+	return c.ghClient.BaseURL.Host != "api.github.com"
+}
+
+// IsRateLimited reports whether err was caused by github's rate limit, or its
+// secondary limit once retrying gave up, in which case carrying on with
+// further requests is pointless.
+func IsRateLimited(err error) bool {
+	var rlErr *github.RateLimitError
+	var abuseErr *github.AbuseRateLimitError
+	return errors.Is(err, ErrRateLimited) || errors.As(err, &rlErr) || errors.As(err, &abuseErr)
+}
+
+// Add queues a write to run on Flush. The lines printed for it by change sets
+// are kept with it.
+func (c *Client) Add(change Change, fn func() error) {
+	c.stack = append(c.stack, queued{
+		change:  change,
+		details: report.TakeDescribed(),
+		fn:      fn,
+	})
+}
+
+// Pending returns the changes queued so far.
+func (c *Client) Pending() []Change {
+	changes := make([]Change, 0, len(c.stack))
+	for _, q := range c.stack {
+		changes = append(changes, q.change)
+	}
+
+	return changes
+}
+
+// Reset drops the queued changes without running them, along with everything
+// read so far, so the next reads see github as it is now.
+func (c *Client) Reset() {
+	c.stack = nil
+	c.cache = newCache()
 }
 
-func (c *Client) Apply() error {
-	if len(c.stack) == 0 {
+// Planned returns the changes queued so far with the lines describing them.
+func (c *Client) Planned() []PlannedChange {
+	changes := make([]PlannedChange, 0, len(c.stack))
+	for _, q := range c.stack {
+		changes = append(changes, PlannedChange{
+			Change:  q.change,
+			Details: q.details,
+		})
+	}
+
+	return changes
+}
+
+// Flush runs the queued changes in order and empties the queue, so each runs
+// at most once. It returns the first failure, or all of them when continuing
+// on error.
+func (c *Client) Flush(ctx context.Context) error {
+	stack := c.stack
+	c.stack = nil
+
+	if len(stack) == 0 {
 		return nil
 	}
 
@@ -81,11 +327,38 @@ func (c *Client) Apply() error {
 	report.PrintHeader("Applying")
 	report.Println()
 
-	for _, fn := range c.stack {
-		err := fn()
+	var errs []error
+	for _, q := range stack {
+		err := ctx.Err()
 		if err != nil {
 			return err
 		}
+
+		err = q.fn()
+
+		// whatever was read for the repo may no longer match github
+		if q.change.Repo != "" {
+			c.cache.forgetRepo(q.change.Org, q.change.Repo)
+		}
+
+		if err == nil {
+			err = c.audit(q.change, false)
+		}
+
+		if err != nil {
+			if !c.continueOnError || IsRateLimited(err) || ctx.Err() != nil {
+				return err
+			}
+
+			report.PrintError(err.Error())
+			report.Println()
+
+			errs = append(errs, err)
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("%d changes failed to apply: %w", len(errs), errors.Join(errs...))
 	}
 
 	return nil
@@ -0,0 +1,208 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/gomicro/concord/report"
+)
+
+// newTestClient returns a client talking to a server answering with h, with
+// the report silenced for the length of the test.
+func newTestClient(t testing.TB, h http.Handler, opts ...Option) *Client {
+	t.Helper()
+
+	srv := httptest.NewServer(h)
+	t.Cleanup(srv.Close)
+
+	quietReport(t)
+
+	c, err := New(context.Background(), "tkn", append([]Option{WithBaseURL(srv.URL + "/")}, opts...)...)
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	return c
+}
+
+// quietReport silences the report for the length of the test.
+func quietReport(t testing.TB) {
+	t.Helper()
+
+	report.SetOutput(io.Discard)
+	t.Cleanup(func() { report.SetOutput(os.Stdout) })
+}
+
+func TestNewBaseURL(t *testing.T) {
+	tests := []struct {
+		name       string
+		baseURL    string
+		want       string
+		enterprise bool
+		wantErr    error
+	}{
+		{name: "github.com by default", want: "https://api.github.com/"},
+		{name: "enterprise host", baseURL: "https://ghes.example.com", want: "https://ghes.example.com/api/v3/", enterprise: true},
+		{name: "enterprise api path", baseURL: "https://ghes.example.com/api/v3/", want: "https://ghes.example.com/api/v3/", enterprise: true},
+		{name: "not a url", baseURL: "ghes.example.com", wantErr: ErrInvalidBaseURL},
+		{name: "not http", baseURL: "ftp://ghes.example.com", wantErr: ErrInvalidBaseURL},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var opts []Option
+			if tt.baseURL != "" {
+				opts = append(opts, WithBaseURL(tt.baseURL))
+			}
+
+			c, err := New(context.Background(), "tkn", opts...)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("err = %v, want %v", err, tt.wantErr)
+			}
+
+			if err != nil {
+				return
+			}
+
+			if c.BaseURL() != tt.want {
+				t.Errorf("base url = %s, want %s", c.BaseURL(), tt.want)
+			}
+
+			if c.IsEnterprise() != tt.enterprise {
+				t.Errorf("enterprise = %v, want %v", c.IsEnterprise(), tt.enterprise)
+			}
+		})
+	}
+}
+
+func TestNewTargetsBaseURL(t *testing.T) {
+	var host string
+
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		host = r.Host
+		w.Write([]byte(`{"login": "acme"}`)) //nolint: errcheck
+	}))
+
+	_, err := c.OrgExists(context.Background(), "acme")
+	if err != nil {
+		t.Fatalf("org exists: %v", err)
+	}
+
+	if !strings.Contains(c.BaseURL(), host) {
+		t.Errorf("request went to %s, want the host of %s", host, c.BaseURL())
+	}
+}
+
+func TestFlushRunsQueuedOnce(t *testing.T) {
+	c := newTestClient(t, http.NotFoundHandler())
+	ctx := context.Background()
+
+	var ran []string
+	for _, name := range []string{"a", "b", "c"} {
+		name := name
+		c.Add(Change{Resource: "test", Action: "update", Org: "acme", Target: name}, func() error {
+			ran = append(ran, name)
+			return nil
+		})
+	}
+
+	if len(ran) != 0 {
+		t.Fatalf("ran %v before flushing", ran)
+	}
+
+	err := c.Flush(ctx)
+	if err != nil {
+		t.Fatalf("flush: %v", err)
+	}
+
+	err = c.Flush(ctx)
+	if err != nil {
+		t.Fatalf("second flush: %v", err)
+	}
+
+	if strings.Join(ran, "") != "abc" {
+		t.Errorf("ran %v, want a, b, and c once each in order", ran)
+	}
+}
+
+func TestFlushErrors(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	tests := []struct {
+		name            string
+		continueOnError bool
+		want            string
+	}{
+		{name: "stops at the first failure", want: "ab"},
+		{name: "continues on error", continueOnError: true, want: "abc"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newTestClient(t, http.NotFoundHandler(), WithContinueOnError(tt.continueOnError))
+
+			var ran string
+			c.Add(Change{Target: "a"}, func() error { ran += "a"; return nil })
+			c.Add(Change{Target: "b"}, func() error { ran += "b"; return errBoom })
+			c.Add(Change{Target: "c"}, func() error { ran += "c"; return nil })
+
+			err := c.Flush(context.Background())
+			if !errors.Is(err, errBoom) {
+				t.Fatalf("err = %v, want %v", err, errBoom)
+			}
+
+			if ran != tt.want {
+				t.Errorf("ran %s, want %s", ran, tt.want)
+			}
+
+			// the queue is drained even when a change fails
+			err = c.Flush(context.Background())
+			if err != nil {
+				t.Errorf("second flush: %v, want the queue drained", err)
+			}
+		})
+	}
+}
+
+func TestFlushStopsWhenCancelled(t *testing.T) {
+	c := newTestClient(t, http.NotFoundHandler(), WithContinueOnError(true))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var ran string
+	c.Add(Change{Target: "a"}, func() error { ran += "a"; cancel(); return ctx.Err() })
+	c.Add(Change{Target: "b"}, func() error { ran += "b"; return nil })
+
+	err := c.Flush(ctx)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("err = %v, want %v", err, context.Canceled)
+	}
+
+	if ran != "a" {
+		t.Errorf("ran %s, want a", ran)
+	}
+}
+
+func TestReadsStopWhenCancelled(t *testing.T) {
+	var calls int64
+	c := newTestClient(t, countingHandler(&calls))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := c.GetRepo(ctx, "acme", "widgets")
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("err = %v, want %v", err, context.Canceled)
+	}
+
+	if calls != 0 {
+		t.Errorf("made %d calls after the run was cancelled", calls)
+	}
+}
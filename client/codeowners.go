@@ -0,0 +1,66 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/google/go-github/v56/github"
+)
+
+// codeownersPaths are where github looks for a CODEOWNERS file, in the order
+// it looks.
+var codeownersPaths = []string{".github/CODEOWNERS", "CODEOWNERS", "docs/CODEOWNERS"}
+
+// GetCodeowners returns the contents and path of the CODEOWNERS file github
+// uses for the branch. Both are empty when the branch has none.
+func (c *Client) GetCodeowners(ctx context.Context, org, repo, branch string) (string, string, error) {
+	for _, p := range codeownersPaths {
+		c.rate.Wait(ctx) //nolint: errcheck
+		fc, _, resp, err := c.ghClient.Repositories.GetContents(ctx, org, repo, p, &github.RepositoryContentGetOptions{Ref: branch})
+		if err != nil {
+			if _, ok := err.(*github.RateLimitError); ok {
+				return "", "", ErrRateLimited
+			}
+
+			if resp != nil && resp.StatusCode == http.StatusNotFound {
+				continue
+			}
+
+			return "", "", wrapErr("get "+p, org+"/"+repo, err)
+		}
+
+		// a directory by the same name is not a CODEOWNERS file
+		if fc == nil {
+			continue
+		}
+
+		content, err := fc.GetContent()
+		if err != nil {
+			return "", "", fmt.Errorf("decode %s: %w", p, err)
+		}
+
+		return content, p, nil
+	}
+
+	return "", "", nil
+}
+
+// UserExists reports whether a github account with the login exists.
+func (c *Client) UserExists(ctx context.Context, login string) (bool, error) {
+	c.rate.Wait(ctx) //nolint: errcheck
+	_, resp, err := c.ghClient.Users.Get(ctx, login)
+	if err != nil {
+		if _, ok := err.(*github.RateLimitError); ok {
+			return false, ErrRateLimited
+		}
+
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return false, nil
+		}
+
+		return false, wrapErr("get user", login, err)
+	}
+
+	return true, nil
+}
@@ -0,0 +1,150 @@
+package client
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gomicro/concord/report"
+	"github.com/google/go-github/v56/github"
+)
+
+// ListCollaborators lists the users given access to a repo directly, rather
+// than through a team, with their role name as the permission.
+func (c *Client) ListCollaborators(ctx context.Context, org, repo string) ([]*github.User, error) {
+	opts := &github.ListCollaboratorsOptions{
+		Affiliation: "direct",
+		ListOptions: github.ListOptions{
+			Page:    0,
+			PerPage: 100,
+		},
+	}
+
+	var users []*github.User
+	for {
+		c.rate.Wait(ctx) //nolint: errcheck
+		us, resp, err := c.ghClient.Repositories.ListCollaborators(ctx, org, repo, opts)
+		if err != nil {
+			if _, ok := err.(*github.RateLimitError); ok {
+				return nil, ErrRateLimited
+			}
+
+			if resp != nil && resp.StatusCode == http.StatusNotFound {
+				return nil, ErrRepoNotFound
+			}
+
+			return nil, wrapErr("list collaborators", org+"/"+repo, err)
+		}
+
+		users = append(users, us...)
+
+		if resp.NextPage == 0 {
+			break
+		}
+
+		opts.Page = resp.NextPage
+	}
+
+	return users, nil
+}
+
+// ListRepoInvitations lists the pending invitations to collaborate on a repo.
+func (c *Client) ListRepoInvitations(ctx context.Context, org, repo string) ([]*github.RepositoryInvitation, error) {
+	opts := &github.ListOptions{
+		Page:    0,
+		PerPage: 100,
+	}
+
+	var invites []*github.RepositoryInvitation
+	for {
+		c.rate.Wait(ctx) //nolint: errcheck
+		is, resp, err := c.ghClient.Repositories.ListInvitations(ctx, org, repo, opts)
+		if err != nil {
+			if _, ok := err.(*github.RateLimitError); ok {
+				return nil, ErrRateLimited
+			}
+
+			if resp != nil && resp.StatusCode == http.StatusNotFound {
+				return nil, ErrRepoNotFound
+			}
+
+			return nil, wrapErr("list repo invitations", org+"/"+repo, err)
+		}
+
+		invites = append(invites, is...)
+
+		if resp.NextPage == 0 {
+			break
+		}
+
+		opts.Page = resp.NextPage
+	}
+
+	return invites, nil
+}
+
+// AddCollaborator queues giving a user direct access to a repo, or changing
+// the permission of an existing collaborator when current is not empty. Users
+// who are not collaborators yet are sent an invitation.
+func (c *Client) AddCollaborator(ctx context.Context, org, repo, user, perm, current string) {
+	cs := &report.ChangeSet{}
+
+	action := "add"
+	if current != "" {
+		action = "update"
+		cs.Add(fieldChange("collaborator '"+user+"' permission", current, perm))
+	} else {
+		cs.Add("adding collaborator '"+user+"' with '"+perm+"'", "added collaborator '"+user+"' with '"+perm+"'")
+	}
+
+	cs.PrintPre()
+
+	c.Add(Change{Resource: "collaborator", Action: action, Org: org, Repo: repo, Target: user}, func() error {
+		c.rate.Wait(ctx) //nolint: errcheck
+		_, resp, err := c.ghClient.Repositories.AddCollaborator(ctx, org, repo, user, &github.RepositoryAddCollaboratorOptions{
+			Permission: apiPermission(perm),
+		})
+		if err != nil {
+			if _, ok := err.(*github.RateLimitError); ok {
+				return ErrRateLimited
+			}
+
+			if resp != nil && resp.StatusCode == http.StatusNotFound {
+				return ErrRepoNotFound
+			}
+
+			return wrapErr("add collaborator "+user, org+"/"+repo, err)
+		}
+
+		cs.PrintPost()
+
+		return nil
+	})
+}
+
+// RemoveCollaborator queues removing a user's direct access to a repo.
+func (c *Client) RemoveCollaborator(ctx context.Context, org, repo, user string) {
+	cs := &report.ChangeSet{}
+	cs.Add("removing collaborator '"+user+"'", "removed collaborator '"+user+"'")
+
+	cs.PrintPre()
+
+	c.Add(Change{Resource: "collaborator", Action: "remove", Org: org, Repo: repo, Target: user}, func() error {
+		c.rate.Wait(ctx) //nolint: errcheck
+		resp, err := c.ghClient.Repositories.RemoveCollaborator(ctx, org, repo, user)
+		if err != nil {
+			if _, ok := err.(*github.RateLimitError); ok {
+				return ErrRateLimited
+			}
+
+			if resp != nil && resp.StatusCode == http.StatusNotFound {
+				return ErrRepoNotFound
+			}
+
+			return wrapErr("remove collaborator "+user, org+"/"+repo, err)
+		}
+
+		cs.PrintPost()
+
+		return nil
+	})
+}
@@ -0,0 +1,24 @@
+package client
+
+import (
+	"github.com/google/go-github/v56/github"
+)
+
+// fileOptions builds the options for committing a file change, attributed to
+// the configured commit author and with the configured trailer, if any.
+func (c *Client) fileOptions(message string, content []byte) *github.RepositoryContentFileOptions {
+	if c.commitTrailer != "" {
+		message += "\n\n" + c.commitTrailer
+	}
+
+	return &github.RepositoryContentFileOptions{
+		Message:   github.String(message),
+		Content:   content,
+		Author:    c.commitAuthor,
+		Committer: c.commitAuthor,
+	}
+}
+
+func commitAuthorName(a *github.CommitAuthor) string {
+	return a.GetName() + " <" + a.GetEmail() + ">"
+}
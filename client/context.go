@@ -8,17 +8,23 @@ const (
 	clientConextKey ctxKey = "client"
 )
 
-func WithClient(ctx context.Context, tkn string) (context.Context, error) {
-	c, err := New(ctx, tkn)
+func WithClient(ctx context.Context, tkn string, opts ...Option) (context.Context, error) {
+	c, err := New(ctx, tkn, opts...)
 	if err != nil {
 		return nil, err
 	}
 
-	return context.WithValue(ctx, clientConextKey, c), nil
+	return WithGitHubClient(ctx, c), nil
 }
 
-func ClientFromContext(ctx context.Context) (*Client, error) {
-	c, ok := ctx.Value(clientConextKey).(*Client)
+// WithGitHubClient adds an already built client to the context, such as a
+// fake standing in for github.
+func WithGitHubClient(ctx context.Context, c GitHubClient) context.Context {
+	return context.WithValue(ctx, clientConextKey, c)
+}
+
+func ClientFromContext(ctx context.Context) (GitHubClient, error) {
+	c, ok := ctx.Value(clientConextKey).(GitHubClient)
 	if !ok {
 		return nil, ErrClientNotFound
 	}
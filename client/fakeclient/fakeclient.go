@@ -0,0 +1,722 @@
+// Package fakeclient provides a GitHubClient that stands in for github in
+// tests. Reads are served from the fields of the fake and writes are recorded
+// rather than made, so commands can be run and checked without a network.
+package fakeclient
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/gomicro/concord/client"
+	"github.com/google/go-github/v56/github"
+)
+
+// Call is a write made against the fake, with the arguments it was given
+// after the context and org.
+type Call struct {
+	Method string
+	Repo   string
+	Args   []interface{}
+}
+
+// Client is a fake GitHubClient. Its zero value is an empty org with nothing
+// in it. Repo level fields are keyed by repo name, team level fields by team
+// slug, and branch protections by "repo/branch".
+type Client struct {
+	mu sync.Mutex
+
+	URL        string
+	Enterprise bool
+	Rate       *github.Rate
+	Token      *client.TokenInfo
+	Users      map[string]bool
+	Orgs       map[string]bool
+
+	Members               []*github.User
+	Invitations           []*github.Invitation
+	OrgSecrets            []*github.Secret
+	OrgSecretRepos        map[string][]string
+	OrgVariables          []*github.ActionsVariable
+	OrgVariableRepos      map[string][]string
+	OrgHooks              []*github.Hook
+	OrgActionsPermissions *github.ActionsPermissions
+	OrgActionsRepos       []string
+	OrgActionsAllowed     *github.ActionsAllowed
+
+	Teams         []*github.Team
+	TeamMembers   map[string][]*github.User
+	IDPGroups     []*github.IDPGroup
+	TeamIDPGroups map[string][]*github.IDPGroup
+	RepoTeams     map[string][]*github.Team
+
+	Repos                  []*github.Repository
+	Subscriptions          map[string]*github.Subscription
+	RepoSecrets            map[string][]string
+	RepoVariables          map[string][]*github.ActionsVariable
+	RepoHooks              map[string][]*github.Hook
+	PreReceiveHooks        map[string][]*github.PreReceiveHook
+	VulnerabilityAlerts    map[string]bool
+	AutomatedSecurityFixes map[string]bool
+	SecretScanning         map[string]bool
+	Pages                  map[string]*github.Pages
+	Licenses               map[string]*github.RepositoryLicense
+	Codeowners             map[string]string
+	Collaborators          map[string][]*github.User
+	RepoInvitations        map[string][]*github.RepositoryInvitation
+	Branches               map[string][]*github.Branch
+	Protections            map[string]*github.Protection
+	Rulesets               map[string][]*github.Ruleset
+	TagProtection          map[string][]*github.TagProtection
+	Autolinks              map[string][]*github.Autolink
+	Labels                 map[string][]*github.Label
+	ActionsPermissions     map[string]*github.ActionsPermissionsRepository
+	ActionsAllowed         map[string]*github.ActionsAllowed
+
+	// Errs makes the named method fail with the error rather than answer,
+	// such as Errs["GetRepo"] = client.ErrRateLimited.
+	Errs map[string]error
+
+	// Calls records every write, in the order they were made.
+	Calls []Call
+
+	// Flushed records the changes applied by Flush, in order.
+	Flushed []client.Change
+
+	queue []client.Change
+}
+
+var _ client.GitHubClient = (*Client)(nil)
+
+// New returns an empty fake.
+func New() *Client {
+	return &Client{}
+}
+
+// CallsTo returns the writes made with the named method.
+func (c *Client) CallsTo(method string) []Call {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var calls []Call
+	for _, call := range c.Calls {
+		if call.Method == method {
+			calls = append(calls, call)
+		}
+	}
+
+	return calls
+}
+
+// Methods returns the name of every write made, in order.
+func (c *Client) Methods() []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	ms := make([]string, 0, len(c.Calls))
+	for _, call := range c.Calls {
+		ms = append(ms, call.Method)
+	}
+
+	return ms
+}
+
+func (c *Client) err(method string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.Errs[method]
+}
+
+func (c *Client) write(method string, change client.Change, args ...interface{}) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.Calls = append(c.Calls, Call{Method: method, Repo: change.Repo, Args: args})
+	c.queue = append(c.queue, change)
+}
+
+// writeErr records a write that can fail before being queued, returning the
+// error set for it instead when there is one.
+func (c *Client) writeErr(method string, change client.Change, args ...interface{}) error {
+	err := c.err(method)
+	if err != nil {
+		return err
+	}
+
+	c.write(method, change, args...)
+
+	return nil
+}
+
+// The change queue.
+
+func (c *Client) Pending() []client.Change {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return append([]client.Change(nil), c.queue...)
+}
+
+func (c *Client) Planned() []client.PlannedChange {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	changes := make([]client.PlannedChange, 0, len(c.queue))
+	for _, q := range c.queue {
+		changes = append(changes, client.PlannedChange{Change: q})
+	}
+
+	return changes
+}
+
+func (c *Client) Flush(ctx context.Context) error {
+	err := ctx.Err()
+	if err != nil {
+		return err
+	}
+
+	err = c.err("Flush")
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err == nil {
+		c.Flushed = append(c.Flushed, c.queue...)
+	}
+
+	c.queue = nil
+
+	return err
+}
+
+func (c *Client) Reset() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.queue = nil
+}
+
+func (c *Client) RecordDryRun() error {
+	return c.err("RecordDryRun")
+}
+
+// The connection and token.
+
+func (c *Client) BaseURL() string {
+	if c.URL == "" {
+		return "https://api.github.com/"
+	}
+
+	return c.URL
+}
+
+func (c *Client) IsEnterprise() bool {
+	return c.Enterprise
+}
+
+func (c *Client) RateStatus(ctx context.Context) (*github.Rate, error) {
+	err := c.err("RateStatus")
+	if err != nil {
+		return nil, err
+	}
+
+	if c.Rate == nil {
+		return nil, client.ErrRateLimitDisabled
+	}
+
+	return c.Rate, nil
+}
+
+func (c *Client) GetTokenInfo(ctx context.Context) (*client.TokenInfo, error) {
+	err := c.err("GetTokenInfo")
+	if err != nil {
+		return nil, err
+	}
+
+	if c.Token == nil {
+		return nil, client.ErrBadCredentials
+	}
+
+	return c.Token, nil
+}
+
+func (c *Client) UserExists(ctx context.Context, login string) (bool, error) {
+	return c.Users[login], c.err("UserExists")
+}
+
+// Organizations and their members.
+
+func (c *Client) OrgExists(ctx context.Context, orgName string) (bool, error) {
+	return c.Orgs[orgName], c.err("OrgExists")
+}
+
+// UpdateOrg queues nothing when there are no edits, as the client does.
+func (c *Client) UpdateOrg(ctx context.Context, orgName string, edits *github.Organization) error {
+	if reflect.DeepEqual(edits, &github.Organization{}) {
+		return c.err("UpdateOrg")
+	}
+
+	return c.writeErr("UpdateOrg", client.Change{Resource: "organization", Action: "update", Org: orgName}, edits)
+}
+
+func (c *Client) GetMembers(ctx context.Context, orgName string) ([]*github.User, error) {
+	return c.Members, c.err("GetMembers")
+}
+
+func (c *Client) InviteMember(ctx context.Context, orgName string, username string) {
+	c.write("InviteMember", client.Change{Resource: "member", Action: "invite", Org: orgName, Target: username}, username)
+}
+
+func (c *Client) ListPendingInvitations(ctx context.Context, orgName string) ([]*github.Invitation, error) {
+	err := c.err("ListPendingInvitations")
+	if err != nil {
+		return nil, err
+	}
+
+	return c.Invitations, nil
+}
+
+func (c *Client) CancelInvitation(ctx context.Context, orgName string, invite *github.Invitation) {
+	c.write("CancelInvitation", client.Change{Resource: "member", Action: "cancel invitation", Org: orgName, Target: invite.GetLogin()}, invite)
+}
+
+func (c *Client) GetOrgSecrets(ctx context.Context, org string) ([]*github.Secret, error) {
+	return c.OrgSecrets, c.err("GetOrgSecrets")
+}
+
+func (c *Client) ListOrgSecretRepos(ctx context.Context, org, name string) ([]string, error) {
+	return c.OrgSecretRepos[name], c.err("ListOrgSecretRepos")
+}
+
+func (c *Client) PutOrgSecret(ctx context.Context, org, name, value, visibility string, repos []string) {
+	c.write("PutOrgSecret", client.Change{Resource: "org secret", Action: "put", Org: org, Target: name}, name, value, visibility, repos)
+}
+
+func (c *Client) GetOrgVariables(ctx context.Context, org string) ([]*github.ActionsVariable, error) {
+	return c.OrgVariables, c.err("GetOrgVariables")
+}
+
+func (c *Client) ListOrgVariableRepos(ctx context.Context, org, name string) ([]string, error) {
+	return c.OrgVariableRepos[name], c.err("ListOrgVariableRepos")
+}
+
+func (c *Client) PutOrgVariable(ctx context.Context, org, name, value, visibility string, repos []string, exists bool) {
+	c.write("PutOrgVariable", client.Change{Resource: "org variable", Action: "put", Org: org, Target: name}, name, value, visibility, repos, exists)
+}
+
+func (c *Client) ListOrgHooks(ctx context.Context, org string) ([]*github.Hook, error) {
+	return c.OrgHooks, c.err("ListOrgHooks")
+}
+
+func (c *Client) CreateOrgHook(ctx context.Context, org string, hook *github.Hook) {
+	c.write("CreateOrgHook", client.Change{Resource: "org webhook", Action: "create", Org: org, Target: client.HookURL(hook)}, hook)
+}
+
+func (c *Client) EditOrgHook(ctx context.Context, org string, current, desired *github.Hook) {
+	c.write("EditOrgHook", client.Change{Resource: "org webhook", Action: "update", Org: org, Target: client.HookURL(current)}, current, desired)
+}
+
+func (c *Client) DeleteOrgHook(ctx context.Context, org string, hook *github.Hook) {
+	c.write("DeleteOrgHook", client.Change{Resource: "org webhook", Action: "delete", Org: org, Target: client.HookURL(hook)}, hook)
+}
+
+func (c *Client) GetOrgActionsPermissions(ctx context.Context, org string) (*github.ActionsPermissions, error) {
+	err := c.err("GetOrgActionsPermissions")
+	if err != nil {
+		return nil, err
+	}
+
+	if c.OrgActionsPermissions == nil {
+		return &github.ActionsPermissions{}, nil
+	}
+
+	return c.OrgActionsPermissions, nil
+}
+
+func (c *Client) SetOrgActionsPermissions(ctx context.Context, org string, current, desired *github.ActionsPermissions) {
+	c.write("SetOrgActionsPermissions", client.Change{Resource: "actions permissions", Action: "update", Org: org}, current, desired)
+}
+
+func (c *Client) ListOrgActionsRepos(ctx context.Context, org string) ([]string, error) {
+	return c.OrgActionsRepos, c.err("ListOrgActionsRepos")
+}
+
+func (c *Client) SetOrgActionsRepos(ctx context.Context, org string, current, repos []string) {
+	c.write("SetOrgActionsRepos", client.Change{Resource: "actions repositories", Action: "update", Org: org}, current, repos)
+}
+
+func (c *Client) GetOrgActionsAllowed(ctx context.Context, org string) (*github.ActionsAllowed, error) {
+	err := c.err("GetOrgActionsAllowed")
+	if err != nil {
+		return nil, err
+	}
+
+	if c.OrgActionsAllowed == nil {
+		return &github.ActionsAllowed{}, nil
+	}
+
+	return c.OrgActionsAllowed, nil
+}
+
+func (c *Client) SetOrgActionsAllowed(ctx context.Context, org string, current, desired *github.ActionsAllowed) {
+	c.write("SetOrgActionsAllowed", client.Change{Resource: "allowed actions", Action: "update", Org: org}, current, desired)
+}
+
+// Teams.
+
+func (c *Client) GetTeams(ctx context.Context, orgName string) ([]*github.Team, error) {
+	return c.Teams, c.err("GetTeams")
+}
+
+func (c *Client) CreateTeam(ctx context.Context, orgName, teamName, parent string) {
+	c.write("CreateTeam", client.Change{Resource: "team", Action: "create", Org: orgName, Target: teamName}, teamName, parent)
+}
+
+func (c *Client) GetTeamMembers(ctx context.Context, org, team string) ([]*github.User, error) {
+	return c.TeamMembers[team], c.err("GetTeamMembers")
+}
+
+func (c *Client) GetIDPGroups(ctx context.Context, org string) ([]*github.IDPGroup, error) {
+	return c.IDPGroups, c.err("GetIDPGroups")
+}
+
+func (c *Client) GetTeamIDPGroups(ctx context.Context, org, team string) ([]*github.IDPGroup, error) {
+	return c.TeamIDPGroups[team], c.err("GetTeamIDPGroups")
+}
+
+func (c *Client) SetTeamIDPGroup(ctx context.Context, org, team string, current []string, group *github.IDPGroup) {
+	c.write("SetTeamIDPGroup", client.Change{Resource: "team idp group", Action: "set", Org: org, Target: team}, team, current, group)
+}
+
+func (c *Client) InviteTeamMember(ctx context.Context, org, team, user string) {
+	c.write("InviteTeamMember", client.Change{Resource: "team member", Action: "add", Org: org, Target: team}, team, user)
+}
+
+func (c *Client) GetRepoTeams(ctx context.Context, org, repo string) ([]*github.Team, error) {
+	return c.RepoTeams[repo], c.err("GetRepoTeams")
+}
+
+func (c *Client) AddRepoToTeam(ctx context.Context, org, team, repo, perm string) error {
+	return c.writeErr("AddRepoToTeam", client.Change{Resource: "team repo", Action: "add", Org: org, Repo: repo, Target: team}, team, perm)
+}
+
+func (c *Client) RemoveRepoFromTeam(ctx context.Context, org, team, repo string) {
+	c.write("RemoveRepoFromTeam", client.Change{Resource: "team repo", Action: "remove", Org: org, Repo: repo, Target: team}, team)
+}
+
+// Repositories.
+
+func (c *Client) GetRepos(ctx context.Context, name string) ([]*github.Repository, error) {
+	err := c.err("GetRepos")
+	if err != nil {
+		return nil, err
+	}
+
+	if len(c.Repos) == 0 {
+		return nil, client.ErrNoReposFound
+	}
+
+	return c.Repos, nil
+}
+
+func (c *Client) GetRepo(ctx context.Context, org, name string) (*github.Repository, error) {
+	err := c.err("GetRepo")
+	if err != nil {
+		return nil, err
+	}
+
+	for _, r := range c.Repos {
+		if strings.EqualFold(r.GetName(), name) {
+			return r, nil
+		}
+	}
+
+	return nil, client.ErrRepoNotFound
+}
+
+func (c *Client) CreateRepo(ctx context.Context, org string, repo *github.Repository) {
+	c.write("CreateRepo", client.Change{Resource: "repo", Action: "create", Org: org, Repo: repo.GetName()}, repo)
+}
+
+// UpdateRepo queues nothing when there are no edits, as the client does.
+func (c *Client) UpdateRepo(ctx context.Context, org, repo string, current, edits *github.Repository) error {
+	if reflect.DeepEqual(edits, &github.Repository{}) {
+		return c.err("UpdateRepo")
+	}
+
+	return c.writeErr("UpdateRepo", client.Change{Resource: "repo", Action: "update", Org: org, Repo: repo}, current, edits)
+}
+
+func (c *Client) RenameRepo(ctx context.Context, org, from, to string) {
+	c.write("RenameRepo", client.Change{Resource: "repo", Action: "rename", Org: org, Repo: from, Target: to}, to)
+}
+
+func (c *Client) SetRepoTopics(ctx context.Context, org, repo string, current, topics []string) {
+	c.write("SetRepoTopics", client.Change{Resource: "topics", Action: "update", Org: org, Repo: repo}, current, topics)
+}
+
+func (c *Client) SetRepoWatched(ctx context.Context, org, repo string, watch bool) {
+	c.write("SetRepoWatched", client.Change{Resource: "subscription", Action: "set", Org: org, Repo: repo}, watch)
+}
+
+func (c *Client) GetRepoSubscription(ctx context.Context, org, repo string) (*github.Subscription, error) {
+	return c.Subscriptions[repo], c.err("GetRepoSubscription")
+}
+
+func (c *Client) GetRepoSecrets(ctx context.Context, org, repo string) ([]string, error) {
+	return c.RepoSecrets[repo], c.err("GetRepoSecrets")
+}
+
+func (c *Client) PutRepoSecret(ctx context.Context, org, repo, name, value string) {
+	c.write("PutRepoSecret", client.Change{Resource: "secret", Action: "put", Org: org, Repo: repo, Target: name}, name, value)
+}
+
+func (c *Client) GetRepoVariables(ctx context.Context, org, repo string) ([]*github.ActionsVariable, error) {
+	return c.RepoVariables[repo], c.err("GetRepoVariables")
+}
+
+func (c *Client) PutRepoVariable(ctx context.Context, org, repo, name, value string, exists bool) {
+	c.write("PutRepoVariable", client.Change{Resource: "variable", Action: "put", Org: org, Repo: repo, Target: name}, name, value, exists)
+}
+
+func (c *Client) ListRepoHooks(ctx context.Context, org, repo string) ([]*github.Hook, error) {
+	return c.RepoHooks[repo], c.err("ListRepoHooks")
+}
+
+func (c *Client) CreateRepoHook(ctx context.Context, org, repo string, hook *github.Hook) {
+	c.write("CreateRepoHook", client.Change{Resource: "webhook", Action: "create", Org: org, Repo: repo, Target: client.HookURL(hook)}, hook)
+}
+
+func (c *Client) EditRepoHook(ctx context.Context, org, repo string, current, desired *github.Hook) {
+	c.write("EditRepoHook", client.Change{Resource: "webhook", Action: "update", Org: org, Repo: repo, Target: client.HookURL(current)}, current, desired)
+}
+
+func (c *Client) DeleteRepoHook(ctx context.Context, org, repo string, hook *github.Hook) {
+	c.write("DeleteRepoHook", client.Change{Resource: "webhook", Action: "delete", Org: org, Repo: repo, Target: client.HookURL(hook)}, hook)
+}
+
+func (c *Client) GetPreReceiveHooks(ctx context.Context, org, repo string) ([]*github.PreReceiveHook, error) {
+	return c.PreReceiveHooks[repo], c.err("GetPreReceiveHooks")
+}
+
+func (c *Client) SetPreReceiveHookEnforcement(ctx context.Context, org, repo string, hook *github.PreReceiveHook, enforcement string) {
+	c.write("SetPreReceiveHookEnforcement", client.Change{Resource: "pre-receive hook", Action: "update", Org: org, Repo: repo, Target: hook.GetName()}, hook, enforcement)
+}
+
+func (c *Client) GetVulnerabilityAlerts(ctx context.Context, org, repo string) (bool, error) {
+	return c.VulnerabilityAlerts[repo], c.err("GetVulnerabilityAlerts")
+}
+
+func (c *Client) SetVulnerabilityAlerts(ctx context.Context, org, repo string, enabled bool) {
+	c.write("SetVulnerabilityAlerts", client.Change{Resource: "vulnerability alerts", Action: "set", Org: org, Repo: repo}, enabled)
+}
+
+func (c *Client) GetAutomatedSecurityFixes(ctx context.Context, org, repo string) (bool, error) {
+	return c.AutomatedSecurityFixes[repo], c.err("GetAutomatedSecurityFixes")
+}
+
+func (c *Client) SetAutomatedSecurityFixes(ctx context.Context, org, repo string, enabled bool) {
+	c.write("SetAutomatedSecurityFixes", client.Change{Resource: "automated security fixes", Action: "set", Org: org, Repo: repo}, enabled)
+}
+
+func (c *Client) GetSecretScanning(ctx context.Context, org, repo string) (bool, error) {
+	return c.SecretScanning[repo], c.err("GetSecretScanning")
+}
+
+func (c *Client) SetSecretScanning(ctx context.Context, org, repo string, enabled bool) {
+	c.write("SetSecretScanning", client.Change{Resource: "secret scanning", Action: "set", Org: org, Repo: repo}, enabled)
+}
+
+func (c *Client) GetPages(ctx context.Context, org, repo string) (*github.Pages, error) {
+	return c.Pages[repo], c.err("GetPages")
+}
+
+func (c *Client) EnablePages(ctx context.Context, org, repo string, desired *github.PagesUpdate) {
+	c.write("EnablePages", client.Change{Resource: "pages", Action: "enable", Org: org, Repo: repo}, desired)
+}
+
+func (c *Client) UpdatePages(ctx context.Context, org, repo string, current *github.Pages, desired *github.PagesUpdate) {
+	c.write("UpdatePages", client.Change{Resource: "pages", Action: "update", Org: org, Repo: repo}, current, desired)
+}
+
+func (c *Client) GetLicense(ctx context.Context, org, repo string) (*github.RepositoryLicense, error) {
+	return c.Licenses[repo], c.err("GetLicense")
+}
+
+func (c *Client) SetLicense(ctx context.Context, org, repo string, current *github.RepositoryLicense, spdx string) {
+	c.write("SetLicense", client.Change{Resource: "license", Action: "set", Org: org, Repo: repo, Target: spdx}, current, spdx)
+}
+
+func (c *Client) GetCodeowners(ctx context.Context, org, repo, branch string) (string, string, error) {
+	err := c.err("GetCodeowners")
+	if err != nil {
+		return "", "", err
+	}
+
+	content, ok := c.Codeowners[repo]
+	if !ok {
+		return "", "", nil
+	}
+
+	return content, ".github/CODEOWNERS", nil
+}
+
+func (c *Client) ListCollaborators(ctx context.Context, org, repo string) ([]*github.User, error) {
+	return c.Collaborators[repo], c.err("ListCollaborators")
+}
+
+func (c *Client) ListRepoInvitations(ctx context.Context, org, repo string) ([]*github.RepositoryInvitation, error) {
+	return c.RepoInvitations[repo], c.err("ListRepoInvitations")
+}
+
+func (c *Client) AddCollaborator(ctx context.Context, org, repo, user, perm, current string) {
+	c.write("AddCollaborator", client.Change{Resource: "collaborator", Action: "add", Org: org, Repo: repo, Target: user}, user, perm, current)
+}
+
+func (c *Client) RemoveCollaborator(ctx context.Context, org, repo, user string) {
+	c.write("RemoveCollaborator", client.Change{Resource: "collaborator", Action: "remove", Org: org, Repo: repo, Target: user}, user)
+}
+
+// Branches, tags, and their protection.
+
+func (c *Client) GetBranches(ctx context.Context, org, repo string) ([]*github.Branch, error) {
+	return c.Branches[repo], c.err("GetBranches")
+}
+
+func (c *Client) CreateBranch(ctx context.Context, org, repo, branch, from string) {
+	c.write("CreateBranch", client.Change{Resource: "branch", Action: "create", Org: org, Repo: repo, Target: branch}, branch, from)
+}
+
+func (c *Client) GetProtectedBranches(ctx context.Context, org, repo string) ([]string, error) {
+	err := c.err("GetProtectedBranches")
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for k := range c.Protections {
+		r, b, _ := strings.Cut(k, "/")
+		if r == repo {
+			names = append(names, b)
+		}
+	}
+
+	sort.Strings(names)
+
+	return names, nil
+}
+
+func (c *Client) GetBranchProtection(ctx context.Context, org, repo, branch string) (*github.Protection, error) {
+	err := c.err("GetBranchProtection")
+	if err != nil {
+		return nil, err
+	}
+
+	p, ok := c.Protections[repo+"/"+branch]
+	if !ok {
+		return nil, client.ErrBranchProtectionNotFound
+	}
+
+	return p, nil
+}
+
+func (c *Client) ProtectBranch(ctx context.Context, org, repo, branch string, current *github.Protection, protection *github.ProtectionRequest) {
+	c.write("ProtectBranch", client.Change{Resource: "branch protection", Action: "update", Org: org, Repo: repo, Target: branch}, branch, protection)
+}
+
+func (c *Client) SetRequireSignedCommits(ctx context.Context, org, repo, branch string, current *github.Protection, require bool) {
+	c.write("SetRequireSignedCommits", client.Change{Resource: "signed commits", Action: "set", Org: org, Repo: repo, Target: branch}, branch, require)
+}
+
+func (c *Client) ListRulesets(ctx context.Context, org, repo string) ([]*github.Ruleset, error) {
+	return c.Rulesets[repo], c.err("ListRulesets")
+}
+
+func (c *Client) CreateRuleset(ctx context.Context, org, repo string, rs *github.Ruleset) {
+	c.write("CreateRuleset", client.Change{Resource: "ruleset", Action: "create", Org: org, Repo: repo, Target: rs.Name}, rs)
+}
+
+func (c *Client) UpdateRuleset(ctx context.Context, org, repo string, current, desired *github.Ruleset) {
+	c.write("UpdateRuleset", client.Change{Resource: "ruleset", Action: "update", Org: org, Repo: repo, Target: current.Name}, current, desired)
+}
+
+func (c *Client) ListTagProtection(ctx context.Context, org, repo string) ([]*github.TagProtection, error) {
+	return c.TagProtection[repo], c.err("ListTagProtection")
+}
+
+func (c *Client) ProtectTag(ctx context.Context, org, repo, pattern string) {
+	c.write("ProtectTag", client.Change{Resource: "tag protection", Action: "create", Org: org, Repo: repo, Target: pattern}, pattern)
+}
+
+func (c *Client) UnprotectTag(ctx context.Context, org, repo string, tp *github.TagProtection) {
+	c.write("UnprotectTag", client.Change{Resource: "tag protection", Action: "delete", Org: org, Repo: repo, Target: tp.GetPattern()}, tp)
+}
+
+func (c *Client) ListAutolinks(ctx context.Context, org, repo string) ([]*github.Autolink, error) {
+	return c.Autolinks[repo], c.err("ListAutolinks")
+}
+
+func (c *Client) AddAutolink(ctx context.Context, org, repo string, link *github.AutolinkOptions) {
+	c.write("AddAutolink", client.Change{Resource: "autolink", Action: "create", Org: org, Repo: repo, Target: link.GetKeyPrefix()}, link)
+}
+
+func (c *Client) DeleteAutolink(ctx context.Context, org, repo string, link *github.Autolink) {
+	c.write("DeleteAutolink", client.Change{Resource: "autolink", Action: "delete", Org: org, Repo: repo, Target: link.GetKeyPrefix()}, link)
+}
+
+func (c *Client) ListLabels(ctx context.Context, org, repo string) ([]*github.Label, error) {
+	return c.Labels[repo], c.err("ListLabels")
+}
+
+func (c *Client) CreateLabel(ctx context.Context, org, repo string, label *github.Label) {
+	c.write("CreateLabel", client.Change{Resource: "label", Action: "create", Org: org, Repo: repo, Target: label.GetName()}, label)
+}
+
+func (c *Client) EditLabel(ctx context.Context, org, repo string, current, desired *github.Label) {
+	c.write("EditLabel", client.Change{Resource: "label", Action: "update", Org: org, Repo: repo, Target: current.GetName()}, current, desired)
+}
+
+func (c *Client) DeleteLabel(ctx context.Context, org, repo, name string) {
+	c.write("DeleteLabel", client.Change{Resource: "label", Action: "delete", Org: org, Repo: repo, Target: name}, name)
+}
+
+// Actions permissions.
+
+func (c *Client) GetActionsPermissions(ctx context.Context, org, repo string) (*github.ActionsPermissionsRepository, error) {
+	err := c.err("GetActionsPermissions")
+	if err != nil {
+		return nil, err
+	}
+
+	p, ok := c.ActionsPermissions[repo]
+	if !ok {
+		return &github.ActionsPermissionsRepository{}, nil
+	}
+
+	return p, nil
+}
+
+func (c *Client) SetActionsPermissions(ctx context.Context, org, repo string, current, desired *github.ActionsPermissionsRepository) {
+	c.write("SetActionsPermissions", client.Change{Resource: "actions permissions", Action: "update", Org: org, Repo: repo}, current, desired)
+}
+
+func (c *Client) GetActionsAllowed(ctx context.Context, org, repo string) (*github.ActionsAllowed, error) {
+	err := c.err("GetActionsAllowed")
+	if err != nil {
+		return nil, err
+	}
+
+	a, ok := c.ActionsAllowed[repo]
+	if !ok {
+		return &github.ActionsAllowed{}, nil
+	}
+
+	return a, nil
+}
+
+func (c *Client) SetActionsAllowed(ctx context.Context, org, repo string, current, desired *github.ActionsAllowed) {
+	c.write("SetActionsAllowed", client.Change{Resource: "allowed actions", Action: "update", Org: org, Repo: repo}, current, desired)
+}
@@ -0,0 +1,265 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v56/github"
+)
+
+// reposQuery lists an owner's repos along with their topics and the branches
+// their protection rules apply to, 50 repos per page. Nested connections are
+// kept small so a page stays well inside github's node limit.
+const reposQuery = `query($owner: String!, $cursor: String) {
+  repositoryOwner(login: $owner) {
+    repositories(first: 50, after: $cursor, ownerAffiliations: OWNER) {
+      pageInfo { hasNextPage endCursor }
+      nodes {
+        name
+        description
+        homepageUrl
+        isArchived
+        isPrivate
+        visibility
+        hasIssuesEnabled
+        hasProjectsEnabled
+        hasWikiEnabled
+        hasDiscussionsEnabled
+        deleteBranchOnMerge
+        autoMergeAllowed
+        pushedAt
+        updatedAt
+        defaultBranchRef { name }
+        repositoryTopics(first: 20) { nodes { topic { name } } }
+        branchProtectionRules(first: 10) {
+          totalCount
+          nodes { matchingRefs(first: 50) { totalCount nodes { name } } }
+        }
+      }
+    }
+  }
+}`
+
+type graphQLRequest struct {
+	Query     string                 `json:"query"`
+	Variables map[string]interface{} `json:"variables"`
+}
+
+type graphQLError struct {
+	Type    string `json:"type"`
+	Message string `json:"message"`
+}
+
+type reposResponse struct {
+	Data struct {
+		RepositoryOwner *struct {
+			Repositories struct {
+				PageInfo struct {
+					HasNextPage bool   `json:"hasNextPage"`
+					EndCursor   string `json:"endCursor"`
+				} `json:"pageInfo"`
+				Nodes []*graphQLRepo `json:"nodes"`
+			} `json:"repositories"`
+		} `json:"repositoryOwner"`
+	} `json:"data"`
+	Errors []graphQLError `json:"errors"`
+}
+
+type graphQLRepo struct {
+	Name                  string    `json:"name"`
+	Description           string    `json:"description"`
+	HomepageURL           string    `json:"homepageUrl"`
+	IsArchived            bool      `json:"isArchived"`
+	IsPrivate             bool      `json:"isPrivate"`
+	Visibility            string    `json:"visibility"`
+	HasIssuesEnabled      bool      `json:"hasIssuesEnabled"`
+	HasProjectsEnabled    bool      `json:"hasProjectsEnabled"`
+	HasWikiEnabled        bool      `json:"hasWikiEnabled"`
+	HasDiscussionsEnabled bool      `json:"hasDiscussionsEnabled"`
+	DeleteBranchOnMerge   bool      `json:"deleteBranchOnMerge"`
+	AutoMergeAllowed      bool      `json:"autoMergeAllowed"`
+	PushedAt              time.Time `json:"pushedAt"`
+	UpdatedAt             time.Time `json:"updatedAt"`
+	DefaultBranchRef      *struct {
+		Name string `json:"name"`
+	} `json:"defaultBranchRef"`
+	RepositoryTopics struct {
+		Nodes []struct {
+			Topic struct {
+				Name string `json:"name"`
+			} `json:"topic"`
+		} `json:"nodes"`
+	} `json:"repositoryTopics"`
+	BranchProtectionRules struct {
+		TotalCount int `json:"totalCount"`
+		Nodes      []struct {
+			MatchingRefs struct {
+				TotalCount int `json:"totalCount"`
+				Nodes      []struct {
+					Name string `json:"name"`
+				} `json:"nodes"`
+			} `json:"matchingRefs"`
+		} `json:"nodes"`
+	} `json:"branchProtectionRules"`
+}
+
+// getReposGraphQL lists an owner's repos over GraphQL, a page of 50 per call,
+// and caches each one as GetRepo would have read it, along with which of its
+// branches are protected. Protections themselves are still read over REST, as
+// every setting on them is needed to carry it over when they are updated, but
+// branches known to be unprotected no longer need a call each.
+func (c *Client) getReposGraphQL(ctx context.Context, owner string) ([]*github.Repository, error) {
+	vars := map[string]interface{}{"owner": owner}
+
+	var repos []*github.Repository
+	for {
+		var res reposResponse
+		err := c.graphQL(ctx, reposQuery, vars, &res)
+		if err != nil {
+			if errors.Is(err, ErrRateLimited) {
+				return nil, err
+			}
+
+			return nil, wrapErr("list repos", owner, c.connErr(err))
+		}
+
+		if res.Data.RepositoryOwner == nil {
+			return nil, wrapErr("list repos", owner, ErrOrgNotFound)
+		}
+
+		rs := res.Data.RepositoryOwner.Repositories
+		for _, n := range rs.Nodes {
+			r := n.repository()
+
+			// empty repos have no default branch to compare against, so
+			// they are left for GetRepo to read
+			if n.DefaultBranchRef != nil {
+				c.cache.setRepo(owner, n.Name, r)
+			}
+
+			if branches, ok := n.protectedBranches(); ok {
+				c.cache.setProtectedBranches(owner, n.Name, branches)
+			}
+
+			if r.GetArchived() && !c.includeArchived {
+				continue
+			}
+
+			repos = append(repos, r)
+		}
+
+		if !rs.PageInfo.HasNextPage {
+			break
+		}
+
+		vars["cursor"] = rs.PageInfo.EndCursor
+	}
+
+	if len(repos) == 0 {
+		return nil, ErrNoReposFound
+	}
+
+	return repos, nil
+}
+
+// repository converts the repo into the shape the REST API returns it in. The
+// security and analysis settings are not available over GraphQL.
+func (n *graphQLRepo) repository() *github.Repository {
+	r := &github.Repository{
+		Name:                github.String(n.Name),
+		Description:         github.String(n.Description),
+		Homepage:            github.String(n.HomepageURL),
+		Archived:            github.Bool(n.IsArchived),
+		Private:             github.Bool(n.IsPrivate),
+		Visibility:          github.String(strings.ToLower(n.Visibility)),
+		HasIssues:           github.Bool(n.HasIssuesEnabled),
+		HasProjects:         github.Bool(n.HasProjectsEnabled),
+		HasWiki:             github.Bool(n.HasWikiEnabled),
+		HasDiscussions:      github.Bool(n.HasDiscussionsEnabled),
+		DeleteBranchOnMerge: github.Bool(n.DeleteBranchOnMerge),
+		AllowAutoMerge:      github.Bool(n.AutoMergeAllowed),
+		PushedAt:            &github.Timestamp{Time: n.PushedAt},
+		UpdatedAt:           &github.Timestamp{Time: n.UpdatedAt},
+		Topics:              []string{},
+	}
+
+	if n.DefaultBranchRef != nil {
+		r.DefaultBranch = github.String(n.DefaultBranchRef.Name)
+	}
+
+	for _, t := range n.RepositoryTopics.Nodes {
+		r.Topics = append(r.Topics, t.Topic.Name)
+	}
+
+	return r
+}
+
+// protectedBranches returns the names of the branches matched by any of the
+// repo's protection rules, and false when there were more rules or matches
+// than were fetched.
+func (n *graphQLRepo) protectedBranches() ([]string, bool) {
+	rules := n.BranchProtectionRules
+	if rules.TotalCount > len(rules.Nodes) {
+		return nil, false
+	}
+
+	branches := []string{}
+	for _, rule := range rules.Nodes {
+		if rule.MatchingRefs.TotalCount > len(rule.MatchingRefs.Nodes) {
+			return nil, false
+		}
+
+		for _, ref := range rule.MatchingRefs.Nodes {
+			branches = append(branches, ref.Name)
+		}
+	}
+
+	return branches, true
+}
+
+// graphQL runs a query against github's GraphQL API and decodes the response
+// into v. Errors github reports in the response body are returned as well.
+func (c *Client) graphQL(ctx context.Context, query string, vars map[string]interface{}, v *reposResponse) error {
+	req, err := c.ghClient.NewRequest(http.MethodPost, c.graphQLURL(), &graphQLRequest{Query: query, Variables: vars})
+	if err != nil {
+		return err
+	}
+
+	c.rate.Wait(ctx) //nolint: errcheck
+	_, err = c.ghClient.Do(ctx, req, v)
+	if err != nil {
+		if _, ok := err.(*github.RateLimitError); ok {
+			return ErrRateLimited
+		}
+
+		return err
+	}
+
+	for _, e := range v.Errors {
+		if e.Type == "RATE_LIMITED" {
+			return ErrRateLimited
+		}
+	}
+
+	if len(v.Errors) > 0 {
+		return errors.New(v.Errors[0].Message)
+	}
+
+	return nil
+}
+
+// graphQLURL returns the GraphQL endpoint next to the REST one. Enterprise
+// servers serve it at /api/graphql rather than under /api/v3.
+func (c *Client) graphQLURL() string {
+	u := *c.ghClient.BaseURL
+	if strings.HasSuffix(u.Path, "/api/v3/") {
+		u.Path = strings.TrimSuffix(u.Path, "v3/") + "graphql"
+	} else {
+		u.Path += "graphql"
+	}
+
+	return u.String()
+}
@@ -0,0 +1,161 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+// orgHandler serves an org of n repos, none with protected branches, over
+// both REST and GraphQL, counting every request it sees.
+func orgHandler(n int, calls *int64) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt64(calls, 1)
+
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case r.URL.Path == "/api/graphql":
+			var req graphQLRequest
+			json.NewDecoder(r.Body).Decode(&req) //nolint: errcheck
+
+			start := 0
+			if cursor, ok := req.Variables["cursor"].(string); ok {
+				start, _ = strconv.Atoi(cursor)
+			}
+
+			end := start + 50
+			if end > n {
+				end = n
+			}
+
+			nodes := []string{}
+			for i := start; i < end; i++ {
+				nodes = append(nodes, fmt.Sprintf(`{"name": "repo%d", "defaultBranchRef": {"name": "main"}, "branchProtectionRules": {"totalCount": 0, "nodes": []}}`, i))
+			}
+
+			fmt.Fprintf(w, `{"data": {"repositoryOwner": {"repositories": {"pageInfo": {"hasNextPage": %t, "endCursor": "%d"}, "nodes": [%s]}}}}`, end < n, end, strings.Join(nodes, ","))
+
+		case r.URL.Path == "/api/v3/orgs/acme":
+			fmt.Fprintf(w, `{"login": "acme", "public_repos": %d}`, n)
+
+		case r.URL.Path == "/api/v3/orgs/acme/repos":
+			page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+			if page == 0 {
+				page = 1
+			}
+
+			start, end := (page-1)*100, page*100
+			if end > n {
+				end = n
+			}
+
+			if end < n {
+				w.Header().Set("Link", fmt.Sprintf(`<http://%s%s?page=%d&per_page=100>; rel="next"`, r.Host, r.URL.Path, page+1))
+			}
+
+			repos := []string{}
+			for i := start; i < end; i++ {
+				repos = append(repos, fmt.Sprintf(`{"name": "repo%d", "default_branch": "main"}`, i))
+			}
+
+			fmt.Fprintf(w, "[%s]", strings.Join(repos, ","))
+
+		case strings.HasPrefix(r.URL.Path, "/api/v3/repos/acme/") && strings.HasSuffix(r.URL.Path, "/branches/main/protection"):
+			http.NotFound(w, r)
+
+		case strings.HasPrefix(r.URL.Path, "/api/v3/repos/acme/"):
+			fmt.Fprintf(w, `{"name": "%s", "default_branch": "main"}`, strings.TrimPrefix(r.URL.Path, "/api/v3/repos/acme/"))
+
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// reconcileReads reads the org's repos, then each repo and its default
+// branch protection, as reconciling every repo does.
+func reconcileReads(ctx context.Context, c *Client) ([]string, error) {
+	repos, err := c.GetRepos(ctx, "acme")
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for _, r := range repos {
+		repo, err := c.GetRepo(ctx, "acme", r.GetName())
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = c.GetBranchProtection(ctx, "acme", repo.GetName(), repo.GetDefaultBranch())
+		if err != nil && !errors.Is(err, ErrBranchProtectionNotFound) {
+			return nil, err
+		}
+
+		names = append(names, repo.GetName())
+	}
+
+	return names, nil
+}
+
+func TestGetReposGraphQL(t *testing.T) {
+	ctx := context.Background()
+
+	var restCalls, graphQLCalls int64
+	rest := newTestClient(t, orgHandler(120, &restCalls), WithRate(1e6, 1e6))
+	graphQL := newTestClient(t, orgHandler(120, &graphQLCalls), WithGraphQL(true), WithRate(1e6, 1e6))
+
+	want, err := reconcileReads(ctx, rest)
+	if err != nil {
+		t.Fatalf("rest reads: %v", err)
+	}
+
+	got, err := reconcileReads(ctx, graphQL)
+	if err != nil {
+		t.Fatalf("graphql reads: %v", err)
+	}
+
+	if strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("graphql read %d repos, rest read %d", len(got), len(want))
+	}
+
+	// three pages of repos and nothing else, as none have protection rules
+	if graphQLCalls != 3 {
+		t.Errorf("graphql made %d calls, want 3", graphQLCalls)
+	}
+}
+
+func BenchmarkReconcileReads(b *testing.B) {
+	ctx := context.Background()
+
+	for _, graphQL := range []bool{false, true} {
+		name := "rest"
+		if graphQL {
+			name = "graphql"
+		}
+
+		b.Run(name, func(b *testing.B) {
+			var calls int64
+			c := newTestClient(b, orgHandler(200, &calls), WithGraphQL(graphQL), WithRate(1e6, 1e6))
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				c.Reset()
+
+				_, err := reconcileReads(ctx, c)
+				if err != nil {
+					b.Fatal(err)
+				}
+			}
+
+			b.ReportMetric(float64(calls)/float64(b.N), "calls/op")
+		})
+	}
+}
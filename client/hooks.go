@@ -0,0 +1,83 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gomicro/concord/report"
+	"github.com/google/go-github/v56/github"
+)
+
+var (
+	ErrPreReceiveHookNotFound = errors.New("pre-receive hook not found")
+)
+
+// GetPreReceiveHooks lists the pre-receive hooks available to a repo. Pre-receive
+// hooks only exist on GitHub Enterprise Server.
+func (c *Client) GetPreReceiveHooks(ctx context.Context, org, repo string) ([]*github.PreReceiveHook, error) {
+	opts := &github.ListOptions{
+		Page:    0,
+		PerPage: 100,
+	}
+
+	var hooks []*github.PreReceiveHook
+	for {
+		c.rate.Wait(ctx) //nolint: errcheck
+		hs, resp, err := c.ghClient.Repositories.ListPreReceiveHooks(ctx, org, repo, opts)
+		if err != nil {
+			if _, ok := err.(*github.RateLimitError); ok {
+				return nil, ErrRateLimited
+			}
+
+			if resp != nil && resp.StatusCode == http.StatusNotFound {
+				return nil, ErrRepoNotFound
+			}
+
+			return nil, wrapErr("list pre-receive hooks", org+"/"+repo, err)
+		}
+
+		hooks = append(hooks, hs...)
+
+		if resp.NextPage == 0 {
+			break
+		}
+
+		opts.Page = resp.NextPage
+	}
+
+	return hooks, nil
+}
+
+func (c *Client) SetPreReceiveHookEnforcement(ctx context.Context, org, repo string, hook *github.PreReceiveHook, enforcement string) {
+	cs := &report.ChangeSet{}
+	cs.Add(
+		fmt.Sprintf("setting pre-receive hook '%s' enforcement to '%s'", hook.GetName(), enforcement),
+		fmt.Sprintf("set pre-receive hook '%s' enforcement to '%s'", hook.GetName(), enforcement),
+	)
+
+	cs.PrintPre()
+
+	c.Add(Change{Resource: "pre-receive hook", Action: "set enforcement", Org: org, Repo: repo, Target: hook.GetName()}, func() error {
+		c.rate.Wait(ctx) //nolint: errcheck
+		_, resp, err := c.ghClient.Repositories.UpdatePreReceiveHook(ctx, org, repo, hook.GetID(), &github.PreReceiveHook{
+			Enforcement: &enforcement,
+		})
+		if err != nil {
+			if _, ok := err.(*github.RateLimitError); ok {
+				return ErrRateLimited
+			}
+
+			if resp != nil && resp.StatusCode == http.StatusNotFound {
+				return ErrPreReceiveHookNotFound
+			}
+
+			return wrapErr("update pre-receive hook", org+"/"+repo, err)
+		}
+
+		cs.PrintPost()
+
+		return nil
+	})
+}
@@ -0,0 +1,67 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestPreReceiveHooks(t *testing.T) {
+	var patched map[string]interface{}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v3/repos/acme/widgets/pre-receive-hooks", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[
+			{"id": 42, "name": "no-secrets", "enforcement": "disabled", "configuration_url": "https://ghes.example.com/api/v3/admin/pre-receive-hooks/42"},
+			{"id": 43, "name": "signed-off", "enforcement": "enabled", "configuration_url": "https://ghes.example.com/api/v3/admin/pre-receive-hooks/43"}
+		]`)) //nolint: errcheck
+	})
+	mux.HandleFunc("/api/v3/repos/acme/widgets/pre-receive-hooks/42", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch {
+			t.Errorf("method = %s, want PATCH", r.Method)
+		}
+
+		err := json.NewDecoder(r.Body).Decode(&patched)
+		if err != nil {
+			t.Errorf("decode body: %v", err)
+		}
+
+		w.Write([]byte(`{"id": 42, "name": "no-secrets", "enforcement": "testing"}`)) //nolint: errcheck
+	})
+
+	c := newTestClient(t, mux)
+	ctx := context.Background()
+
+	hooks, err := c.GetPreReceiveHooks(ctx, "acme", "widgets")
+	if err != nil {
+		t.Fatalf("get pre-receive hooks: %v", err)
+	}
+
+	if len(hooks) != 2 || hooks[0].GetName() != "no-secrets" || hooks[0].GetEnforcement() != "disabled" {
+		t.Fatalf("hooks = %v, want no-secrets and signed-off", hooks)
+	}
+
+	c.SetPreReceiveHookEnforcement(ctx, "acme", "widgets", hooks[0], "testing")
+
+	err = c.Flush(context.Background())
+	if err != nil {
+		t.Fatalf("flush: %v", err)
+	}
+
+	if patched["enforcement"] != "testing" || len(patched) != 1 {
+		t.Errorf("sent %v, want only the enforcement set to testing", patched)
+	}
+}
+
+func TestPreReceiveHooksRepoNotFound(t *testing.T) {
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"message": "Not Found"}`, http.StatusNotFound)
+	}))
+
+	_, err := c.GetPreReceiveHooks(context.Background(), "acme", "widgets")
+	if !errors.Is(err, ErrRepoNotFound) {
+		t.Errorf("err = %v, want %v", err, ErrRepoNotFound)
+	}
+}
@@ -0,0 +1,127 @@
+package client
+
+import (
+	"context"
+
+	"github.com/google/go-github/v56/github"
+)
+
+// GitHubClient is everything the commands need from github. Client is the
+// implementation that talks to the API, other implementations can stand in
+// for it through WithGitHubClient.
+type GitHubClient interface {
+	// The change queue.
+	Pending() []Change
+	Planned() []PlannedChange
+	Flush(ctx context.Context) error
+	Reset()
+	RecordDryRun() error
+
+	// The connection and token.
+	BaseURL() string
+	IsEnterprise() bool
+	RateStatus(ctx context.Context) (*github.Rate, error)
+	GetTokenInfo(ctx context.Context) (*TokenInfo, error)
+	UserExists(ctx context.Context, login string) (bool, error)
+
+	// Organizations and their members.
+	OrgExists(ctx context.Context, orgName string) (bool, error)
+	UpdateOrg(ctx context.Context, orgName string, edits *github.Organization) error
+	GetMembers(ctx context.Context, orgName string) ([]*github.User, error)
+	InviteMember(ctx context.Context, orgName string, username string)
+	ListPendingInvitations(ctx context.Context, orgName string) ([]*github.Invitation, error)
+	CancelInvitation(ctx context.Context, orgName string, invite *github.Invitation)
+	GetOrgSecrets(ctx context.Context, org string) ([]*github.Secret, error)
+	ListOrgSecretRepos(ctx context.Context, org, name string) ([]string, error)
+	PutOrgSecret(ctx context.Context, org, name, value, visibility string, repos []string)
+	GetOrgVariables(ctx context.Context, org string) ([]*github.ActionsVariable, error)
+	ListOrgVariableRepos(ctx context.Context, org, name string) ([]string, error)
+	PutOrgVariable(ctx context.Context, org, name, value, visibility string, repos []string, exists bool)
+	ListOrgHooks(ctx context.Context, org string) ([]*github.Hook, error)
+	CreateOrgHook(ctx context.Context, org string, hook *github.Hook)
+	EditOrgHook(ctx context.Context, org string, current, desired *github.Hook)
+	DeleteOrgHook(ctx context.Context, org string, hook *github.Hook)
+	GetOrgActionsPermissions(ctx context.Context, org string) (*github.ActionsPermissions, error)
+	SetOrgActionsPermissions(ctx context.Context, org string, current, desired *github.ActionsPermissions)
+	ListOrgActionsRepos(ctx context.Context, org string) ([]string, error)
+	SetOrgActionsRepos(ctx context.Context, org string, current, repos []string)
+	GetOrgActionsAllowed(ctx context.Context, org string) (*github.ActionsAllowed, error)
+	SetOrgActionsAllowed(ctx context.Context, org string, current, desired *github.ActionsAllowed)
+
+	// Teams.
+	GetTeams(ctx context.Context, orgName string) ([]*github.Team, error)
+	CreateTeam(ctx context.Context, orgName, teamName, parent string)
+	GetTeamMembers(ctx context.Context, org, team string) ([]*github.User, error)
+	GetIDPGroups(ctx context.Context, org string) ([]*github.IDPGroup, error)
+	GetTeamIDPGroups(ctx context.Context, org, team string) ([]*github.IDPGroup, error)
+	SetTeamIDPGroup(ctx context.Context, org, team string, current []string, group *github.IDPGroup)
+	InviteTeamMember(ctx context.Context, org, team, user string)
+	GetRepoTeams(ctx context.Context, org, repo string) ([]*github.Team, error)
+	AddRepoToTeam(ctx context.Context, org, team, repo, perm string) error
+	RemoveRepoFromTeam(ctx context.Context, org, team, repo string)
+
+	// Repositories.
+	GetRepos(ctx context.Context, name string) ([]*github.Repository, error)
+	GetRepo(ctx context.Context, org, name string) (*github.Repository, error)
+	CreateRepo(ctx context.Context, org string, repo *github.Repository)
+	UpdateRepo(ctx context.Context, org, repo string, current, edits *github.Repository) error
+	RenameRepo(ctx context.Context, org, from, to string)
+	SetRepoTopics(ctx context.Context, org, repo string, current, topics []string)
+	SetRepoWatched(ctx context.Context, org, repo string, watch bool)
+	GetRepoSubscription(ctx context.Context, org, repo string) (*github.Subscription, error)
+	GetRepoSecrets(ctx context.Context, org, repo string) ([]string, error)
+	PutRepoSecret(ctx context.Context, org, repo, name, value string)
+	GetRepoVariables(ctx context.Context, org, repo string) ([]*github.ActionsVariable, error)
+	PutRepoVariable(ctx context.Context, org, repo, name, value string, exists bool)
+	ListRepoHooks(ctx context.Context, org, repo string) ([]*github.Hook, error)
+	CreateRepoHook(ctx context.Context, org, repo string, hook *github.Hook)
+	EditRepoHook(ctx context.Context, org, repo string, current, desired *github.Hook)
+	DeleteRepoHook(ctx context.Context, org, repo string, hook *github.Hook)
+	GetPreReceiveHooks(ctx context.Context, org, repo string) ([]*github.PreReceiveHook, error)
+	SetPreReceiveHookEnforcement(ctx context.Context, org, repo string, hook *github.PreReceiveHook, enforcement string)
+	GetVulnerabilityAlerts(ctx context.Context, org, repo string) (bool, error)
+	SetVulnerabilityAlerts(ctx context.Context, org, repo string, enabled bool)
+	GetAutomatedSecurityFixes(ctx context.Context, org, repo string) (bool, error)
+	SetAutomatedSecurityFixes(ctx context.Context, org, repo string, enabled bool)
+	GetSecretScanning(ctx context.Context, org, repo string) (bool, error)
+	SetSecretScanning(ctx context.Context, org, repo string, enabled bool)
+	GetPages(ctx context.Context, org, repo string) (*github.Pages, error)
+	EnablePages(ctx context.Context, org, repo string, desired *github.PagesUpdate)
+	UpdatePages(ctx context.Context, org, repo string, current *github.Pages, desired *github.PagesUpdate)
+	GetLicense(ctx context.Context, org, repo string) (*github.RepositoryLicense, error)
+	SetLicense(ctx context.Context, org, repo string, current *github.RepositoryLicense, spdx string)
+	GetCodeowners(ctx context.Context, org, repo, branch string) (string, string, error)
+	ListCollaborators(ctx context.Context, org, repo string) ([]*github.User, error)
+	ListRepoInvitations(ctx context.Context, org, repo string) ([]*github.RepositoryInvitation, error)
+	AddCollaborator(ctx context.Context, org, repo, user, perm, current string)
+	RemoveCollaborator(ctx context.Context, org, repo, user string)
+
+	// Branches, tags, and their protection.
+	GetBranches(ctx context.Context, org, repo string) ([]*github.Branch, error)
+	CreateBranch(ctx context.Context, org, repo, branch, from string)
+	GetProtectedBranches(ctx context.Context, org, repo string) ([]string, error)
+	GetBranchProtection(ctx context.Context, org, repo, branch string) (*github.Protection, error)
+	ProtectBranch(ctx context.Context, org, repo, branch string, current *github.Protection, protection *github.ProtectionRequest)
+	SetRequireSignedCommits(ctx context.Context, org, repo, branch string, current *github.Protection, require bool)
+	ListRulesets(ctx context.Context, org, repo string) ([]*github.Ruleset, error)
+	CreateRuleset(ctx context.Context, org, repo string, rs *github.Ruleset)
+	UpdateRuleset(ctx context.Context, org, repo string, current, desired *github.Ruleset)
+	ListTagProtection(ctx context.Context, org, repo string) ([]*github.TagProtection, error)
+	ProtectTag(ctx context.Context, org, repo, pattern string)
+	UnprotectTag(ctx context.Context, org, repo string, tp *github.TagProtection)
+	ListAutolinks(ctx context.Context, org, repo string) ([]*github.Autolink, error)
+	AddAutolink(ctx context.Context, org, repo string, link *github.AutolinkOptions)
+	DeleteAutolink(ctx context.Context, org, repo string, link *github.Autolink)
+	ListLabels(ctx context.Context, org, repo string) ([]*github.Label, error)
+	CreateLabel(ctx context.Context, org, repo string, label *github.Label)
+	EditLabel(ctx context.Context, org, repo string, current, desired *github.Label)
+	DeleteLabel(ctx context.Context, org, repo, name string)
+
+	// Actions permissions.
+	GetActionsPermissions(ctx context.Context, org, repo string) (*github.ActionsPermissionsRepository, error)
+	SetActionsPermissions(ctx context.Context, org, repo string, current, desired *github.ActionsPermissionsRepository)
+	GetActionsAllowed(ctx context.Context, org, repo string) (*github.ActionsAllowed, error)
+	SetActionsAllowed(ctx context.Context, org, repo string, current, desired *github.ActionsAllowed)
+}
+
+var _ GitHubClient = (*Client)(nil)
@@ -0,0 +1,141 @@
+package client
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gomicro/concord/report"
+	"github.com/google/go-github/v56/github"
+)
+
+// ListLabels lists the repo's issue labels.
+func (c *Client) ListLabels(ctx context.Context, org, repo string) ([]*github.Label, error) {
+	opts := &github.ListOptions{
+		Page:    0,
+		PerPage: 100,
+	}
+
+	var labels []*github.Label
+	for {
+		c.rate.Wait(ctx) //nolint: errcheck
+		ls, resp, err := c.ghClient.Issues.ListLabels(ctx, org, repo, opts)
+		if err != nil {
+			if _, ok := err.(*github.RateLimitError); ok {
+				return nil, ErrRateLimited
+			}
+
+			if resp != nil && resp.StatusCode == http.StatusNotFound {
+				return nil, ErrRepoNotFound
+			}
+
+			return nil, wrapErr("list labels", org+"/"+repo, err)
+		}
+
+		labels = append(labels, ls...)
+
+		if resp.NextPage == 0 {
+			break
+		}
+
+		opts.Page = resp.NextPage
+	}
+
+	return labels, nil
+}
+
+// CreateLabel queues adding an issue label to the repo.
+func (c *Client) CreateLabel(ctx context.Context, org, repo string, label *github.Label) {
+	name := label.GetName()
+
+	cs := &report.ChangeSet{}
+	cs.Add("creating label '"+name+"' with color '"+label.GetColor()+"'", "created label '"+name+"' with color '"+label.GetColor()+"'")
+
+	cs.PrintPre()
+
+	c.Add(Change{Resource: "label", Action: "create", Org: org, Repo: repo, Target: name}, func() error {
+		c.rate.Wait(ctx) //nolint: errcheck
+		_, resp, err := c.ghClient.Issues.CreateLabel(ctx, org, repo, label)
+		if err != nil {
+			if _, ok := err.(*github.RateLimitError); ok {
+				return ErrRateLimited
+			}
+
+			if resp != nil && resp.StatusCode == http.StatusNotFound {
+				return ErrRepoNotFound
+			}
+
+			return wrapErr("create label "+name, org+"/"+repo, err)
+		}
+
+		cs.PrintPost()
+
+		return nil
+	})
+}
+
+// EditLabel queues updating the color and description of an issue label to
+// match desired. A nil description on desired leaves the current one alone.
+func (c *Client) EditLabel(ctx context.Context, org, repo string, current, desired *github.Label) {
+	name := current.GetName()
+
+	cs := &report.ChangeSet{}
+
+	if current.GetColor() != desired.GetColor() {
+		cs.Add(fieldChange("label '"+name+"' color", current.GetColor(), desired.GetColor()))
+	}
+
+	if desired.Description != nil && current.GetDescription() != desired.GetDescription() {
+		cs.Add(fieldChange("label '"+name+"' description", current.GetDescription(), desired.GetDescription()))
+	}
+
+	cs.PrintPre()
+
+	c.Add(Change{Resource: "label", Action: "update", Org: org, Repo: repo, Target: name}, func() error {
+		c.rate.Wait(ctx) //nolint: errcheck
+		_, resp, err := c.ghClient.Issues.EditLabel(ctx, org, repo, name, desired)
+		if err != nil {
+			if _, ok := err.(*github.RateLimitError); ok {
+				return ErrRateLimited
+			}
+
+			if resp != nil && resp.StatusCode == http.StatusNotFound {
+				return ErrRepoNotFound
+			}
+
+			return wrapErr("edit label "+name, org+"/"+repo, err)
+		}
+
+		cs.PrintPost()
+
+		return nil
+	})
+}
+
+// DeleteLabel queues removing an issue label from the repo, which also
+// removes it from every issue and pull request it is on.
+func (c *Client) DeleteLabel(ctx context.Context, org, repo, name string) {
+	cs := &report.ChangeSet{}
+	cs.Add("deleting label '"+name+"'", "deleted label '"+name+"'")
+
+	cs.PrintPre()
+
+	c.Add(Change{Resource: "label", Action: "delete", Org: org, Repo: repo, Target: name}, func() error {
+		c.rate.Wait(ctx) //nolint: errcheck
+		resp, err := c.ghClient.Issues.DeleteLabel(ctx, org, repo, name)
+		if err != nil {
+			if _, ok := err.(*github.RateLimitError); ok {
+				return ErrRateLimited
+			}
+
+			if resp != nil && resp.StatusCode == http.StatusNotFound {
+				return ErrRepoNotFound
+			}
+
+			return wrapErr("delete label "+name, org+"/"+repo, err)
+		}
+
+		cs.PrintPost()
+
+		return nil
+	})
+}
@@ -0,0 +1,99 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gomicro/concord/report"
+	"github.com/google/go-github/v56/github"
+)
+
+// GetLicense returns the license file github detects in a repo, along with
+// which license it is, or nil when the repo has none.
+func (c *Client) GetLicense(ctx context.Context, org, repo string) (*github.RepositoryLicense, error) {
+	c.rate.Wait(ctx) //nolint: errcheck
+	l, resp, err := c.ghClient.Repositories.License(ctx, org, repo)
+	if err != nil {
+		if _, ok := err.(*github.RateLimitError); ok {
+			return nil, ErrRateLimited
+		}
+
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return nil, nil
+		}
+
+		return nil, wrapErr("get license", org+"/"+repo, err)
+	}
+
+	return l, nil
+}
+
+// SetLicense queues committing the text of the license with the SPDX id to
+// the repo's default branch, replacing the license file github detected, if
+// any. The year and owner in the license template are filled in when the
+// change is applied.
+func (c *Client) SetLicense(ctx context.Context, org, repo string, current *github.RepositoryLicense, spdx string) {
+	have := "none"
+	if current != nil {
+		have = current.GetLicense().GetSPDXID()
+	}
+
+	cs := &report.ChangeSet{}
+	cs.Add(fieldChange("license", have, spdx))
+
+	if c.commitAuthor != nil {
+		cs.Add("committing as '"+commitAuthorName(c.commitAuthor)+"'", "committed as '"+commitAuthorName(c.commitAuthor)+"'")
+	}
+
+	cs.PrintPre()
+
+	c.Add(Change{Resource: "license", Action: "set", Org: org, Repo: repo, Target: spdx}, func() error {
+		c.rate.Wait(ctx) //nolint: errcheck
+		l, resp, err := c.ghClient.Licenses.Get(ctx, strings.ToLower(spdx))
+		if err != nil {
+			if _, ok := err.(*github.RateLimitError); ok {
+				return ErrRateLimited
+			}
+
+			if resp != nil && resp.StatusCode == http.StatusNotFound {
+				return fmt.Errorf("license %s: github has no template for it", spdx)
+			}
+
+			return wrapErr("get license template "+spdx, org+"/"+repo, err)
+		}
+
+		body := strings.NewReplacer(
+			"[year]", fmt.Sprintf("%d", time.Now().Year()),
+			"[fullname]", org,
+		).Replace(l.GetBody())
+
+		opts := c.fileOptions("Set license to "+spdx, []byte(body))
+
+		path := "LICENSE"
+		if current != nil {
+			path = current.GetPath()
+			opts.SHA = current.SHA
+		}
+
+		c.rate.Wait(ctx) //nolint: errcheck
+		_, resp, err = c.ghClient.Repositories.CreateFile(ctx, org, repo, path, opts)
+		if err != nil {
+			if _, ok := err.(*github.RateLimitError); ok {
+				return ErrRateLimited
+			}
+
+			if resp != nil && resp.StatusCode == http.StatusNotFound {
+				return ErrRepoNotFound
+			}
+
+			return wrapErr("commit license "+spdx, org+"/"+repo, err)
+		}
+
+		cs.PrintPost()
+
+		return nil
+	})
+}
@@ -2,19 +2,72 @@ package client
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/http"
 	"strings"
+	"time"
 
 	"github.com/google/go-github/v56/github"
 )
 
+var (
+	ErrBadCredentials = errors.New("bad credentials")
+)
+
+// TokenInfo describes the identity behind the client's token.
+type TokenInfo struct {
+	User *github.User
+
+	// Scopes granted to a classic token. Fine-grained tokens and apps do not
+	// report scopes, leaving this empty.
+	Scopes []string
+
+	// ServerTime is the time reported by GitHub when the token was checked.
+	ServerTime time.Time
+}
+
+func (c *Client) GetTokenInfo(ctx context.Context) (*TokenInfo, error) {
+	c.rate.Wait(ctx) //nolint: errcheck
+	user, resp, err := c.ghClient.Users.Get(ctx, "")
+	if err != nil {
+		if _, ok := err.(*github.RateLimitError); ok {
+			return nil, ErrRateLimited
+		}
+
+		if resp != nil && resp.StatusCode == http.StatusUnauthorized {
+			return nil, ErrBadCredentials
+		}
+
+		return nil, fmt.Errorf("get user: %w", err)
+	}
+
+	info := &TokenInfo{
+		User: user,
+	}
+
+	for _, s := range strings.Split(resp.Header.Get("X-OAuth-Scopes"), ",") {
+		s = strings.TrimSpace(s)
+		if s != "" {
+			info.Scopes = append(info.Scopes, s)
+		}
+	}
+
+	t, err := http.ParseTime(resp.Header.Get("Date"))
+	if err == nil {
+		info.ServerTime = t
+	}
+
+	return info, nil
+}
+
 func (c *Client) GetLogins(ctx context.Context) ([]string, error) {
 	logins := []string{}
 
 	user, _, err := c.ghClient.Users.Get(ctx, "")
 	if err != nil {
 		if _, ok := err.(*github.RateLimitError); ok {
-			return nil, fmt.Errorf("github: hit rate limit")
+			return nil, ErrRateLimited
 		}
 
 		return nil, fmt.Errorf("get user: %w", err)
@@ -30,7 +83,7 @@ func (c *Client) GetLogins(ctx context.Context) ([]string, error) {
 	orgs, _, err := c.ghClient.Organizations.List(ctx, "", opts)
 	if err != nil {
 		if _, ok := err.(*github.RateLimitError); ok {
-			return nil, fmt.Errorf("github: hit rate limit")
+			return nil, ErrRateLimited
 		}
 
 		return nil, fmt.Errorf("list orgs: %w", err)
@@ -5,14 +5,16 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/gomicro/concord/report"
 	"github.com/google/go-github/v56/github"
 )
 
 var (
-	ErrOrgNotFound  = errors.New("organization not found")
-	ErrUserNotFound = errors.New("user not found")
+	ErrOrgNotFound            = errors.New("organization not found")
+	ErrUserNotFound           = errors.New("user not found")
+	ErrInvitationsNotReadable = errors.New("pending invitations need an org owner to read")
 )
 
 func (c *Client) GetOrg(ctx context.Context, orgName string) (*github.Organization, error) {
@@ -28,7 +30,7 @@ func (c *Client) GetOrg(ctx context.Context, orgName string) (*github.Organizati
 			}
 		}
 
-		return nil, err
+		return nil, wrapErr("get org", orgName, c.connErr(err))
 	}
 
 	return org, nil
@@ -54,7 +56,7 @@ func (c *Client) GetMembers(ctx context.Context, orgName string) ([]*github.User
 			return nil, err
 		}
 
-		return nil, err
+		return nil, wrapErr("list members", orgName, err)
 	}
 
 	return members, nil
@@ -66,20 +68,27 @@ func (c *Client) InviteMember(ctx context.Context, orgName string, username stri
 	cs.Add("invite "+username, "invited "+username)
 	cs.PrintPre()
 
-	c.Add(func() error {
+	c.Add(Change{Resource: "member", Action: "invite", Org: orgName, Target: username}, func() error {
+		c.rate.Wait(ctx) //nolint: errcheck
 		user, resp, err := c.ghClient.Users.Get(ctx, username)
 		if err != nil {
 			if _, ok := err.(*github.RateLimitError); ok {
 				return err
 			}
 
-			if resp.StatusCode == http.StatusNotFound {
+			if resp != nil && resp.StatusCode == http.StatusNotFound {
 				return ErrUserNotFound
 			}
 
 			return err
 		}
 
+		// guard against inviting an account other than the one named
+		if !strings.EqualFold(user.GetLogin(), username) {
+			return fmt.Errorf("invite %s: %w, lookup returned %s", username, ErrUserNotFound, user.GetLogin())
+		}
+
+		c.rate.Wait(ctx) //nolint: errcheck
 		_, _, err = c.ghClient.Organizations.CreateOrgInvitation(ctx, orgName, &github.CreateOrgInvitationOptions{
 			InviteeID: user.ID,
 		})
@@ -97,11 +106,86 @@ func (c *Client) InviteMember(ctx context.Context, orgName string, username stri
 	})
 }
 
-func (c *Client) SetOrgPrivileges(ctx context.Context, orgName string, edits *github.Organization) error {
+// ListPendingInvitations lists the org's invitations that have not been
+// accepted yet. Only org owners can read them, so a token without that
+// returns ErrInvitationsNotReadable.
+func (c *Client) ListPendingInvitations(ctx context.Context, orgName string) ([]*github.Invitation, error) {
+	opts := &github.ListOptions{
+		Page:    0,
+		PerPage: 100,
+	}
+
+	var invites []*github.Invitation
+	for {
+		c.rate.Wait(ctx) //nolint: errcheck
+		is, resp, err := c.ghClient.Organizations.ListPendingOrgInvitations(ctx, orgName, opts)
+		if err != nil {
+			if _, ok := err.(*github.RateLimitError); ok {
+				return nil, ErrRateLimited
+			}
+
+			if resp != nil && resp.StatusCode == http.StatusNotFound {
+				return nil, ErrOrgNotFound
+			}
+
+			if resp != nil && resp.StatusCode == http.StatusForbidden {
+				return nil, ErrInvitationsNotReadable
+			}
+
+			return nil, wrapErr("list pending invitations", orgName, err)
+		}
+
+		invites = append(invites, is...)
+
+		if resp.NextPage == 0 {
+			break
+		}
+
+		opts.Page = resp.NextPage
+	}
+
+	return invites, nil
+}
+
+// CancelInvitation queues cancelling a pending org invitation.
+func (c *Client) CancelInvitation(ctx context.Context, orgName string, invite *github.Invitation) {
+	cs := &report.ChangeSet{}
+
+	cs.Add("cancel invitation for "+invite.GetLogin(), "cancelled invitation for "+invite.GetLogin())
+	cs.PrintPre()
+
+	c.Add(Change{Resource: "member", Action: "cancel invitation", Org: orgName, Target: invite.GetLogin()}, func() error {
+		// go-github does not wrap this endpoint yet
+		req, err := c.ghClient.NewRequest(http.MethodDelete, fmt.Sprintf("orgs/%v/invitations/%v", orgName, invite.GetID()), nil)
+		if err != nil {
+			return wrapErr("cancel invitation", orgName+"/"+invite.GetLogin(), err)
+		}
+
+		c.rate.Wait(ctx) //nolint: errcheck
+		_, err = c.ghClient.Do(ctx, req, nil)
+		if err != nil {
+			if _, ok := err.(*github.RateLimitError); ok {
+				return ErrRateLimited
+			}
+
+			return wrapErr("cancel invitation", orgName+"/"+invite.GetLogin(), err)
+		}
+
+		cs.PrintPost()
+
+		return nil
+	})
+}
+
+// UpdateOrg queues an edit of the org's settings. Only fields set in edits
+// that differ from the live org are printed, and nothing is queued when none
+// do.
+func (c *Client) UpdateOrg(ctx context.Context, orgName string, edits *github.Organization) error {
+	c.rate.Wait(ctx) //nolint: errcheck
 	ghOrg, _, err := c.ghClient.Organizations.Get(ctx, orgName)
 	if err != nil {
 		if _, ok := err.(*github.RateLimitError); ok {
-			return err
+			return ErrRateLimited
 		}
 
 		if errResp, ok := err.(*github.ErrorResponse); ok {
@@ -115,41 +199,95 @@ func (c *Client) SetOrgPrivileges(ctx context.Context, orgName string, edits *gi
 
 	cs := &report.ChangeSet{}
 
-	if edits.DefaultRepoPermission != nil && *edits.DefaultRepoPermission != *ghOrg.DefaultRepoPermission {
+	if edits.Description != nil && *edits.Description != ghOrg.GetDescription() {
+		cs.Add(
+			fmt.Sprintf("setting description to '%s'", *edits.Description),
+			fmt.Sprintf("set description to '%s'", *edits.Description),
+		)
+	}
+
+	if edits.BillingEmail != nil && *edits.BillingEmail != ghOrg.GetBillingEmail() {
+		cs.Add(
+			fmt.Sprintf("setting billing email to '%s'", *edits.BillingEmail),
+			fmt.Sprintf("set billing email to '%s'", *edits.BillingEmail),
+		)
+	}
+
+	if edits.Company != nil && *edits.Company != ghOrg.GetCompany() {
+		cs.Add(
+			fmt.Sprintf("setting company to '%s'", *edits.Company),
+			fmt.Sprintf("set company to '%s'", *edits.Company),
+		)
+	}
+
+	if edits.Location != nil && *edits.Location != ghOrg.GetLocation() {
+		cs.Add(
+			fmt.Sprintf("setting location to '%s'", *edits.Location),
+			fmt.Sprintf("set location to '%s'", *edits.Location),
+		)
+	}
+
+	if edits.Blog != nil && *edits.Blog != ghOrg.GetBlog() {
+		cs.Add(
+			fmt.Sprintf("setting blog to '%s'", *edits.Blog),
+			fmt.Sprintf("set blog to '%s'", *edits.Blog),
+		)
+	}
+
+	if edits.DefaultRepoPermission != nil && *edits.DefaultRepoPermission != ghOrg.GetDefaultRepoPermission() {
 		cs.Add(
 			fmt.Sprintf("setting base permissions to '%s'", *edits.DefaultRepoPermission),
 			fmt.Sprintf("set base permissions to '%s'", *edits.DefaultRepoPermission),
 		)
 	}
 
-	if edits.MembersCanCreatePrivateRepos != nil && *edits.MembersCanCreatePrivateRepos != *ghOrg.MembersCanCreatePrivateRepos {
+	if edits.MembersCanCreatePrivateRepos != nil && *edits.MembersCanCreatePrivateRepos != ghOrg.GetMembersCanCreatePrivateRepos() {
 		cs.Add(
 			fmt.Sprintf("setting private repo creation to '%t'", *edits.MembersCanCreatePrivateRepos),
 			fmt.Sprintf("set private repo creation to '%t'", *edits.MembersCanCreatePrivateRepos),
 		)
 	}
 
-	if edits.MembersCanCreatePublicRepos != nil && *edits.MembersCanCreatePublicRepos != *ghOrg.MembersCanCreatePublicRepos {
+	if edits.MembersCanCreatePublicRepos != nil && *edits.MembersCanCreatePublicRepos != ghOrg.GetMembersCanCreatePublicRepos() {
 		cs.Add(
 			fmt.Sprintf("setting public repo creation to '%t'", *edits.MembersCanCreatePublicRepos),
 			fmt.Sprintf("set public repo creation to '%t'", *edits.MembersCanCreatePublicRepos),
 		)
 	}
 
+	if edits.MembersCanCreateInternalRepos != nil && *edits.MembersCanCreateInternalRepos != ghOrg.GetMembersCanCreateInternalRepos() {
+		cs.Add(
+			fmt.Sprintf("setting internal repo creation to '%t'", *edits.MembersCanCreateInternalRepos),
+			fmt.Sprintf("set internal repo creation to '%t'", *edits.MembersCanCreateInternalRepos),
+		)
+	}
+
+	if edits.MembersCanCreatePages != nil && *edits.MembersCanCreatePages != ghOrg.GetMembersCanCreatePages() {
+		cs.Add(
+			fmt.Sprintf("setting pages creation to '%t'", *edits.MembersCanCreatePages),
+			fmt.Sprintf("set pages creation to '%t'", *edits.MembersCanCreatePages),
+		)
+	}
+
+	if !cs.HasChanges() {
+		return nil
+	}
+
 	cs.PrintPre()
 
-	c.Add(func() error {
+	c.Add(Change{Resource: "organization", Action: "update", Org: orgName}, func() error {
+		c.rate.Wait(ctx) //nolint: errcheck
 		_, resp, err := c.ghClient.Organizations.Edit(ctx, orgName, edits)
 		if err != nil {
 			if _, ok := err.(*github.RateLimitError); ok {
-				return err
+				return ErrRateLimited
 			}
 
-			if resp.StatusCode == http.StatusNotFound {
-				return ErrUserNotFound
+			if resp != nil && resp.StatusCode == http.StatusNotFound {
+				return ErrOrgNotFound
 			}
 
-			return err
+			return wrapErr("update org", orgName, err)
 		}
 
 		cs.PrintPost()
@@ -0,0 +1,143 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestListPendingInvitations(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		body    string
+		want    int
+		wantErr error
+	}{
+		{
+			name:   "lists invitations",
+			status: http.StatusOK,
+			body:   `[{"id": 1, "login": "octocat"}, {"id": 2, "login": "hubot"}]`,
+			want:   2,
+		},
+		{
+			name:    "forbidden without owner access",
+			status:  http.StatusForbidden,
+			body:    `{"message": "You must be an admin to view invitations"}`,
+			wantErr: ErrInvitationsNotReadable,
+		},
+		{
+			name:    "org not found",
+			status:  http.StatusNotFound,
+			body:    `{"message": "Not Found"}`,
+			wantErr: ErrOrgNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/api/v3/orgs/acme/invitations" {
+					t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+				}
+
+				w.WriteHeader(tt.status)
+				w.Write([]byte(tt.body)) //nolint: errcheck
+			}))
+
+			is, err := c.ListPendingInvitations(context.Background(), "acme")
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("err = %v, want %v", err, tt.wantErr)
+			}
+
+			if len(is) != tt.want {
+				t.Errorf("got %d invitations, want %d", len(is), tt.want)
+			}
+		})
+	}
+}
+
+func TestInviteMemberChecksLogin(t *testing.T) {
+	tests := []struct {
+		name    string
+		login   string
+		invited bool
+		wantErr error
+	}{
+		{name: "invites the named account", login: "NewHire", invited: true},
+		{name: "refuses a different account", login: "someone-else", wantErr: ErrUserNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var invitee map[string]interface{}
+
+			mux := http.NewServeMux()
+			mux.HandleFunc("/api/v3/users/newhire", func(w http.ResponseWriter, r *http.Request) {
+				w.Write([]byte(`{"id": 7, "login": "` + tt.login + `", "name": "New Hire"}`)) //nolint: errcheck
+			})
+			mux.HandleFunc("/api/v3/orgs/acme/invitations", func(w http.ResponseWriter, r *http.Request) {
+				json.NewDecoder(r.Body).Decode(&invitee) //nolint: errcheck
+				w.WriteHeader(http.StatusCreated)
+				w.Write([]byte(`{"id": 1}`)) //nolint: errcheck
+			})
+
+			c := newTestClient(t, mux)
+			ctx := context.Background()
+
+			c.InviteMember(ctx, "acme", "newhire")
+
+			err := c.Flush(ctx)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("err = %v, want %v", err, tt.wantErr)
+			}
+
+			if (invitee != nil) != tt.invited {
+				t.Fatalf("invited = %v, want %v", invitee != nil, tt.invited)
+			}
+
+			if tt.invited && invitee["invitee_id"] != float64(7) {
+				t.Errorf("invited %v, want the looked up user's id", invitee)
+			}
+		})
+	}
+}
+
+func TestInviteMemberErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		wantErr error
+	}{
+		{name: "unknown user", status: http.StatusNotFound, wantErr: ErrUserNotFound},
+		{name: "server error", status: http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				http.Error(w, `{"message": "nope"}`, tt.status)
+			}))
+
+			ctx := context.Background()
+
+			// queueing never fails, the error surfaces from flushing
+			c.InviteMember(ctx, "acme", "newhire")
+
+			err := c.Flush(ctx)
+			if err == nil {
+				t.Fatal("flush succeeded, want the invite's error")
+			}
+
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Errorf("err = %v, want %v", err, tt.wantErr)
+			}
+
+			if len(c.Pending()) != 0 {
+				t.Errorf("invite is still queued after flushing")
+			}
+		})
+	}
+}
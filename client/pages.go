@@ -0,0 +1,132 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/gomicro/concord/report"
+	"github.com/google/go-github/v56/github"
+)
+
+// GetPages returns the repo's GitHub Pages site. A nil site is returned when
+// pages are not enabled.
+func (c *Client) GetPages(ctx context.Context, org, repo string) (*github.Pages, error) {
+	c.rate.Wait(ctx) //nolint: errcheck
+	pages, resp, err := c.ghClient.Repositories.GetPagesInfo(ctx, org, repo)
+	if err != nil {
+		if _, ok := err.(*github.RateLimitError); ok {
+			return nil, ErrRateLimited
+		}
+
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return nil, nil
+		}
+
+		return nil, wrapErr("get pages", org+"/"+repo, err)
+	}
+
+	return pages, nil
+}
+
+// EnablePages queues enabling GitHub Pages from the desired source. A custom
+// domain or https enforcement can only be set once the site exists, so they
+// are updated straight after.
+func (c *Client) EnablePages(ctx context.Context, org, repo string, desired *github.PagesUpdate) {
+	src := pagesSource(desired.Source)
+
+	cs := &report.ChangeSet{}
+	cs.Add("enabling pages from '"+src+"'", "enabled pages from '"+src+"'")
+
+	if desired.CNAME != nil {
+		cs.Add("setting pages custom domain to '"+desired.GetCNAME()+"'", "set pages custom domain to '"+desired.GetCNAME()+"'")
+	}
+
+	if desired.HTTPSEnforced != nil {
+		cs.Add(fmt.Sprintf("setting pages enforce https to '%t'", desired.GetHTTPSEnforced()), fmt.Sprintf("set pages enforce https to '%t'", desired.GetHTTPSEnforced()))
+	}
+
+	cs.PrintPre()
+
+	c.Add(Change{Resource: "pages", Action: "enable", Org: org, Repo: repo, Target: src}, func() error {
+		c.rate.Wait(ctx) //nolint: errcheck
+		_, _, err := c.ghClient.Repositories.EnablePages(ctx, org, repo, &github.Pages{
+			Source: desired.Source,
+		})
+		if err != nil {
+			if _, ok := err.(*github.RateLimitError); ok {
+				return ErrRateLimited
+			}
+
+			return wrapErr("enable pages", org+"/"+repo, err)
+		}
+
+		if desired.CNAME != nil || desired.HTTPSEnforced != nil {
+			c.rate.Wait(ctx) //nolint: errcheck
+			_, err = c.ghClient.Repositories.UpdatePages(ctx, org, repo, desired)
+			if err != nil {
+				if _, ok := err.(*github.RateLimitError); ok {
+					return ErrRateLimited
+				}
+
+				return wrapErr("update pages", org+"/"+repo, err)
+			}
+		}
+
+		cs.PrintPost()
+
+		return nil
+	})
+}
+
+// UpdatePages queues updating an existing GitHub Pages site to the desired
+// source, custom domain, and https enforcement. Settings left unset are kept
+// as they are, and nothing is queued when everything already matches.
+func (c *Client) UpdatePages(ctx context.Context, org, repo string, current *github.Pages, desired *github.PagesUpdate) {
+	cs := &report.ChangeSet{}
+
+	src := pagesSource(desired.Source)
+	if pagesSource(current.Source) != src {
+		cs.Add("updating pages source to '"+src+"'", "updated pages source to '"+src+"'")
+	}
+
+	// github clears the custom domain when it is left out of an update
+	if desired.CNAME == nil {
+		desired.CNAME = current.CNAME
+	} else if current.GetCNAME() != desired.GetCNAME() {
+		cs.Add("updating pages custom domain to '"+desired.GetCNAME()+"'", "updated pages custom domain to '"+desired.GetCNAME()+"'")
+	}
+
+	if desired.HTTPSEnforced != nil && current.GetHTTPSEnforced() != desired.GetHTTPSEnforced() {
+		cs.Add(fmt.Sprintf("updating pages enforce https to '%t'", desired.GetHTTPSEnforced()), fmt.Sprintf("updated pages enforce https to '%t'", desired.GetHTTPSEnforced()))
+	}
+
+	if !cs.HasChanges() {
+		report.PrintInfo("pages are up to date")
+		report.Println()
+
+		return
+	}
+
+	cs.PrintPre()
+
+	c.Add(Change{Resource: "pages", Action: "update", Org: org, Repo: repo, Target: src}, func() error {
+		c.rate.Wait(ctx) //nolint: errcheck
+		_, err := c.ghClient.Repositories.UpdatePages(ctx, org, repo, desired)
+		if err != nil {
+			if _, ok := err.(*github.RateLimitError); ok {
+				return ErrRateLimited
+			}
+
+			return wrapErr("update pages", org+"/"+repo, err)
+		}
+
+		cs.PrintPost()
+
+		return nil
+	})
+}
+
+func pagesSource(src *github.PagesSource) string {
+	return src.GetBranch() + ":" + src.GetPath()
+}
@@ -0,0 +1,30 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/google/go-github/v56/github"
+)
+
+// RateStatus returns the current core API rate limit budget for the token.
+// Servers that do not limit requests, as GitHub Enterprise Server can be
+// configured to, return ErrRateLimitDisabled.
+func (c *Client) RateStatus(ctx context.Context) (*github.Rate, error) {
+	c.rate.Wait(ctx) //nolint: errcheck
+	limits, resp, err := c.ghClient.RateLimits(ctx)
+	if err != nil {
+		if _, ok := err.(*github.RateLimitError); ok {
+			return nil, ErrRateLimited
+		}
+
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return nil, ErrRateLimitDisabled
+		}
+
+		return nil, fmt.Errorf("get rate limits: %w", c.connErr(err))
+	}
+
+	return limits.GetCore(), nil
+}
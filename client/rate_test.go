@@ -0,0 +1,67 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"golang.org/x/time/rate"
+)
+
+func TestWithRate(t *testing.T) {
+	tests := []struct {
+		name  string
+		opts  []Option
+		limit rate.Limit
+		burst int
+	}{
+		{name: "defaults", limit: RequestsPerSecond, burst: BurstLimit},
+		{name: "configured", opts: []Option{WithRate(2.5, 4)}, limit: 2.5, burst: 4},
+		{name: "zero keeps the defaults", opts: []Option{WithRate(0, 0)}, limit: RequestsPerSecond, burst: BurstLimit},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newTestClient(t, http.NotFoundHandler(), tt.opts...)
+
+			if c.rate.Limit() != tt.limit {
+				t.Errorf("limit = %v, want %v", c.rate.Limit(), tt.limit)
+			}
+
+			if c.rate.Burst() != tt.burst {
+				t.Errorf("burst = %v, want %v", c.rate.Burst(), tt.burst)
+			}
+		})
+	}
+}
+
+func TestRateStatus(t *testing.T) {
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v3/rate_limit" {
+			http.NotFound(w, r)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"resources": {"core": {"limit": 5000, "remaining": 42, "reset": 1700000000}}}`)) //nolint: errcheck
+	}))
+
+	status, err := c.RateStatus(context.Background())
+	if err != nil {
+		t.Fatalf("rate status: %v", err)
+	}
+
+	if status.Limit != 5000 || status.Remaining != 42 {
+		t.Errorf("status = %d of %d, want 42 of 5000", status.Remaining, status.Limit)
+	}
+}
+
+func TestRateStatusDisabled(t *testing.T) {
+	c := newTestClient(t, http.NotFoundHandler())
+
+	_, err := c.RateStatus(context.Background())
+	if !errors.Is(err, ErrRateLimitDisabled) {
+		t.Errorf("err = %v, want %v", err, ErrRateLimitDisabled)
+	}
+}
@@ -2,6 +2,7 @@ package client
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
@@ -9,16 +10,41 @@ import (
 
 	"github.com/gomicro/concord/report"
 	"github.com/google/go-github/v56/github"
+	"golang.org/x/exp/slices"
 )
 
 var (
 	ErrGetBranch                = errors.New("get branch")
+	ErrBranchNotFound           = errors.New("branch not found")
 	ErrRepoNotFound             = errors.New("repo not found")
 	ErrNoReposFound             = errors.New("no repos found")
 	ErrBranchProtectionNotFound = errors.New("branch protection not found")
+	ErrUnexpectedRepoEdits      = errors.New("repo edits set fields that are not patched")
 )
 
+// repoEditFields are the fields, by their name in the API, UpdateRepo patches.
+var repoEditFields = []string{
+	"description",
+	"homepage",
+	"archived",
+	"private",
+	"visibility",
+	"default_branch",
+	"has_issues",
+	"has_projects",
+	"has_wiki",
+	"has_discussions",
+	"delete_branch_on_merge",
+	"allow_auto_merge",
+}
+
+// GetRepos lists the repos of an org, or of a user when no org has the name.
+// Archived repos are left out unless the client was made to include them.
 func (c *Client) GetRepos(ctx context.Context, name string) ([]*github.Repository, error) {
+	if c.graphql {
+		return c.getReposGraphQL(ctx, name)
+	}
+
 	count := int64(0)
 	orgFound := true
 
@@ -27,10 +53,10 @@ func (c *Client) GetRepos(ctx context.Context, name string) ([]*github.Repositor
 	if resp == nil && err != nil {
 
 		if _, ok := err.(*github.RateLimitError); ok {
-			return nil, fmt.Errorf("github: hit rate limit")
+			return nil, ErrRateLimited
 		}
 
-		return nil, fmt.Errorf("get org: %w", err)
+		return nil, wrapErr("get org", name, err)
 	}
 
 	if resp.StatusCode == http.StatusNotFound {
@@ -40,10 +66,10 @@ func (c *Client) GetRepos(ctx context.Context, name string) ([]*github.Repositor
 		user, _, err := c.ghClient.Users.Get(ctx, name)
 		if err != nil {
 			if _, ok := err.(*github.RateLimitError); ok {
-				return nil, fmt.Errorf("github: hit rate limit")
+				return nil, ErrRateLimited
 			}
 
-			return nil, fmt.Errorf("get user: %v", err.Error())
+			return nil, wrapErr("get user", name, err)
 		}
 
 		count = int64(user.GetPublicRepos()) + user.GetTotalPrivateRepos()
@@ -83,14 +109,14 @@ func (c *Client) GetRepos(ctx context.Context, name string) ([]*github.Repositor
 
 		if err != nil {
 			if _, ok := err.(*github.RateLimitError); ok {
-				return nil, fmt.Errorf("github: hit rate limit")
+				return nil, ErrRateLimited
 			}
 
-			return nil, fmt.Errorf("list repos: %v", err.Error())
+			return nil, wrapErr("list repos", name, err)
 		}
 
 		for i := range rs {
-			if rs[i].GetArchived() {
+			if rs[i].GetArchived() && !c.includeArchived {
 				continue
 			}
 
@@ -111,21 +137,29 @@ func (c *Client) GetRepos(ctx context.Context, name string) ([]*github.Repositor
 	return repos, nil
 }
 
+// GetRepo fetches a repo, reusing the copy already read this run unless a
+// change to it has since been applied.
 func (c *Client) GetRepo(ctx context.Context, org, name string) (*github.Repository, error) {
+	if r, ok := c.cache.repo(org, name); ok {
+		return r, nil
+	}
+
 	c.rate.Wait(ctx) //nolint: errcheck
 	repo, resp, err := c.ghClient.Repositories.Get(ctx, org, name)
 	if err != nil {
 		if _, ok := err.(*github.RateLimitError); ok {
-			return nil, fmt.Errorf("github: hit rate limit")
+			return nil, ErrRateLimited
 		}
 
-		if resp.StatusCode == http.StatusNotFound {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
 			return nil, ErrRepoNotFound
 		}
 
-		return nil, fmt.Errorf("get repo: %w", err)
+		return nil, wrapErr("get repo", org+"/"+name, c.connErr(err))
 	}
 
+	c.cache.setRepo(org, name, repo)
+
 	return repo, nil
 }
 
@@ -134,14 +168,14 @@ func (c *Client) GetRepoTeams(ctx context.Context, org, repo string) ([]*github.
 	teams, resp, err := c.ghClient.Repositories.ListTeams(ctx, org, repo, nil)
 	if err != nil {
 		if _, ok := err.(*github.RateLimitError); ok {
-			return nil, fmt.Errorf("github: hit rate limit")
+			return nil, ErrRateLimited
 		}
 
-		if resp.StatusCode == http.StatusNotFound {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
 			return nil, ErrRepoNotFound
 		}
 
-		return nil, fmt.Errorf("get repo teams: %w", err)
+		return nil, wrapErr("get repo teams", org+"/"+repo, err)
 	}
 
 	return teams, nil
@@ -150,7 +184,7 @@ func (c *Client) GetRepoTeams(ctx context.Context, org, repo string) ([]*github.
 func (c *Client) AddRepoToTeam(ctx context.Context, org, team, repo, perm string) error {
 	gts, err := c.GetRepoTeams(ctx, org, repo)
 	if err != nil {
-		return fmt.Errorf("add repo team: %w", err)
+		return wrapErr("add repo team", org+"/"+repo, err)
 	}
 
 	gtps := map[string]string{}
@@ -160,13 +194,7 @@ func (c *Client) AddRepoToTeam(ctx context.Context, org, team, repo, perm string
 
 	tp, relationExists := gtps[strings.ToLower(team)]
 
-	p := perm
-	switch p {
-	case "read":
-		p = "pull"
-	case "write":
-		p = "push"
-	}
+	p := apiPermission(perm)
 
 	if relationExists && strings.EqualFold(tp, p) {
 		report.PrintInfo("team '" + team + "' has permission '" + perm + "'")
@@ -174,10 +202,19 @@ func (c *Client) AddRepoToTeam(ctx context.Context, org, team, repo, perm string
 		return nil
 	}
 
-	report.PrintAdd("adding repo to team '" + team + "' with '" + perm + "'")
-	report.Println()
+	cs := &report.ChangeSet{}
 
-	c.Add(func() error {
+	action := "add"
+	if relationExists {
+		action = "update"
+		cs.Add(fieldChange("team '"+team+"' permission", manifestPermission(tp), perm))
+	} else {
+		cs.Add("adding repo to team '"+team+"' with '"+perm+"'", "added repo to team '"+team+"' with '"+perm+"'")
+	}
+
+	cs.PrintPre()
+
+	c.Add(Change{Resource: "team repo", Action: action, Org: org, Repo: repo, Target: team}, func() error {
 		c.rate.Wait(ctx) //nolint: errcheck
 
 		resp, err := c.ghClient.Teams.AddTeamRepoBySlug(ctx, org, team, org, repo, &github.TeamAddTeamRepoOptions{
@@ -185,22 +222,17 @@ func (c *Client) AddRepoToTeam(ctx context.Context, org, team, repo, perm string
 		})
 		if err != nil {
 			if _, ok := err.(*github.RateLimitError); ok {
-				return fmt.Errorf("github: hit rate limit")
+				return ErrRateLimited
 			}
 
-			if resp.StatusCode == http.StatusNotFound {
+			if resp != nil && resp.StatusCode == http.StatusNotFound {
 				return ErrRepoNotFound
 			}
 
-			return fmt.Errorf("add repo to team: %w", err)
+			return wrapErr("add repo to team "+team, org+"/"+repo, err)
 		}
 
-		if relationExists {
-			report.PrintSuccess("updated repo to team '" + team + "' with '" + perm + "'")
-		} else {
-			report.PrintAdd("added repo to team '" + team + "' with '" + perm + "'")
-			report.Println()
-		}
+		cs.PrintPost()
 
 		return nil
 	})
@@ -208,24 +240,50 @@ func (c *Client) AddRepoToTeam(ctx context.Context, org, team, repo, perm string
 	return nil
 }
 
+// apiPermission maps a permission named as in manifests to the name the API
+// takes for teams and collaborators.
+func apiPermission(p string) string {
+	switch p {
+	case "read":
+		return "pull"
+	case "write":
+		return "push"
+	}
+
+	return p
+}
+
+// manifestPermission maps the permission github reports back to the name used
+// in manifests.
+func manifestPermission(p string) string {
+	switch p {
+	case "pull":
+		return "read"
+	case "push":
+		return "write"
+	}
+
+	return p
+}
+
 func (c *Client) RemoveRepoFromTeam(ctx context.Context, org, team, repo string) {
 	cs := &report.ChangeSet{}
 	cs.Add("removing repo from team '"+team+"'", "removed repo from team '"+team+"'")
 
 	cs.PrintPre()
 
-	c.Add(func() error {
+	c.Add(Change{Resource: "team repo", Action: "remove", Org: org, Repo: repo, Target: team}, func() error {
 		c.rate.Wait(ctx) //nolint: errcheck
 		resp, err := c.ghClient.Teams.RemoveTeamRepoBySlug(ctx, org, team, org, repo)
 		if err != nil {
 			if _, ok := err.(*github.RateLimitError); ok {
-				return fmt.Errorf("github: hit rate limit")
+				return ErrRateLimited
 			}
-			if resp.StatusCode == http.StatusNotFound {
+			if resp != nil && resp.StatusCode == http.StatusNotFound {
 				return ErrRepoNotFound
 			}
 
-			return fmt.Errorf("remove repo from team: %w", err)
+			return wrapErr("remove repo from team "+team, org+"/"+repo, err)
 		}
 
 		cs.PrintPost()
@@ -239,68 +297,177 @@ func (c *Client) GetRepoTopics(ctx context.Context, org, name string) ([]string,
 	topics, resp, err := c.ghClient.Repositories.ListAllTopics(ctx, org, name)
 	if err != nil {
 		if _, ok := err.(*github.RateLimitError); ok {
-			return nil, fmt.Errorf("github: hit rate limit")
+			return nil, ErrRateLimited
 		}
 
-		if resp.StatusCode == http.StatusNotFound {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
 			return nil, ErrRepoNotFound
 		}
 
-		return nil, fmt.Errorf("get repo topics: %w", err)
+		return nil, wrapErr("get repo topics", org+"/"+name, c.connErr(err))
 	}
 
 	return topics, nil
 }
 
 func (c *Client) GetBranches(ctx context.Context, org, repo string) ([]*github.Branch, error) {
-	c.rate.Wait(ctx) //nolint: errcheck
-	branches, resp, err := c.ghClient.Repositories.ListBranches(ctx, org, repo, nil)
-	if err != nil {
-		if _, ok := err.(*github.RateLimitError); ok {
-			return nil, fmt.Errorf("github: hit rate limit")
+	opts := &github.BranchListOptions{
+		ListOptions: github.ListOptions{
+			Page:    0,
+			PerPage: 100,
+		},
+	}
+
+	var branches []*github.Branch
+	for {
+		c.rate.Wait(ctx) //nolint: errcheck
+		bs, resp, err := c.ghClient.Repositories.ListBranches(ctx, org, repo, opts)
+		if err != nil {
+			if _, ok := err.(*github.RateLimitError); ok {
+				return nil, ErrRateLimited
+			}
+
+			if resp != nil && resp.StatusCode == http.StatusNotFound {
+				return nil, ErrRepoNotFound
+			}
+
+			return nil, wrapErr("get branches", org+"/"+repo, c.connErr(err))
 		}
 
-		if resp.StatusCode == http.StatusNotFound {
-			return nil, ErrRepoNotFound
+		branches = append(branches, bs...)
+
+		if resp.NextPage == 0 {
+			break
 		}
 
-		return nil, fmt.Errorf("get branches: %w", err)
+		opts.Page = resp.NextPage
 	}
 
 	return branches, nil
 }
 
+// CreateBranch queues creating a branch pointing at the head of an existing
+// one. The source head is looked up when the change is applied.
+func (c *Client) CreateBranch(ctx context.Context, org, repo, branch, from string) {
+	cs := &report.ChangeSet{}
+	cs.Add("creating branch '"+branch+"' from '"+from+"'", "created branch '"+branch+"' from '"+from+"'")
+
+	cs.PrintPre()
+
+	c.Add(Change{Resource: "branch", Action: "create", Org: org, Repo: repo, Target: branch}, func() error {
+		c.rate.Wait(ctx) //nolint: errcheck
+		ref, resp, err := c.ghClient.Git.GetRef(ctx, org, repo, "refs/heads/"+from)
+		if err != nil {
+			if _, ok := err.(*github.RateLimitError); ok {
+				return ErrRateLimited
+			}
+
+			if resp != nil && resp.StatusCode == http.StatusNotFound {
+				return fmt.Errorf("create branch %s from %s: %w", branch, from, ErrBranchNotFound)
+			}
+
+			return wrapErr("get ref "+from, org+"/"+repo, err)
+		}
+
+		c.rate.Wait(ctx) //nolint: errcheck
+		_, _, err = c.ghClient.Git.CreateRef(ctx, org, repo, &github.Reference{
+			Ref:    github.String("refs/heads/" + branch),
+			Object: &github.GitObject{SHA: ref.Object.SHA},
+		})
+		if err != nil {
+			if _, ok := err.(*github.RateLimitError); ok {
+				return ErrRateLimited
+			}
+
+			return wrapErr("create branch "+branch, org+"/"+repo, err)
+		}
+
+		cs.PrintPost()
+
+		return nil
+	})
+}
+
+// GetProtectedBranches lists the names of a repo's branches that have
+// protection enabled.
+func (c *Client) GetProtectedBranches(ctx context.Context, org, repo string) ([]string, error) {
+	opts := &github.BranchListOptions{
+		Protected: github.Bool(true),
+		ListOptions: github.ListOptions{
+			Page:    0,
+			PerPage: 100,
+		},
+	}
+
+	var names []string
+	for {
+		c.rate.Wait(ctx) //nolint: errcheck
+		bs, resp, err := c.ghClient.Repositories.ListBranches(ctx, org, repo, opts)
+		if err != nil {
+			if _, ok := err.(*github.RateLimitError); ok {
+				return nil, ErrRateLimited
+			}
+
+			if resp != nil && resp.StatusCode == http.StatusNotFound {
+				return nil, ErrRepoNotFound
+			}
+
+			return nil, wrapErr("get protected branches", org+"/"+repo, c.connErr(err))
+		}
+
+		for _, b := range bs {
+			names = append(names, b.GetName())
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+
+		opts.Page = resp.NextPage
+	}
+
+	return names, nil
+}
+
+// GetBranchProtection fetches a branch's protection, reusing the copy
+// already read this run unless a change to it has since been applied.
 func (c *Client) GetBranchProtection(ctx context.Context, org, repo, branch string) (*github.Protection, error) {
+	if p, ok := c.cache.protection(org, repo, branch); ok {
+		if p == nil {
+			return nil, ErrBranchProtectionNotFound
+		}
+
+		return p, nil
+	}
+
 	c.rate.Wait(ctx) //nolint: errcheck
 	b, resp, err := c.ghClient.Repositories.GetBranchProtection(ctx, org, repo, branch)
 	if err != nil {
 		if _, ok := err.(*github.RateLimitError); ok {
-			return nil, fmt.Errorf("github: hit rate limit")
+			return nil, ErrRateLimited
 		}
 
-		if resp.StatusCode == http.StatusNotFound {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			c.cache.setProtection(org, repo, branch, nil)
 			return nil, ErrBranchProtectionNotFound
 		}
 
-		return nil, fmt.Errorf("get branch: %w", err)
+		return nil, wrapErr("get branch protection "+branch, org+"/"+repo, c.connErr(err))
 	}
 
+	c.cache.setProtection(org, repo, branch, b)
+
 	return b, nil
 }
 
 func (c *Client) IsBranchProtected(ctx context.Context, org, repo, branch string) (bool, error) {
-	c.rate.Wait(ctx) //nolint: errcheck
-	b, resp, err := c.ghClient.Repositories.GetBranchProtection(ctx, org, repo, branch)
+	b, err := c.GetBranchProtection(ctx, org, repo, branch)
 	if err != nil {
-		if _, ok := err.(*github.RateLimitError); ok {
-			return false, fmt.Errorf("github: hit rate limit")
-		}
-
-		if resp.StatusCode == http.StatusNotFound {
+		if errors.Is(err, ErrBranchProtectionNotFound) {
 			return false, nil
 		}
 
-		return false, fmt.Errorf("get branch: %w", err)
+		return false, err
 	}
 
 	return b != nil, nil
@@ -314,6 +481,10 @@ func (c *Client) CreateRepo(ctx context.Context, org string, repo *github.Reposi
 		cs.Add("setting description to '"+repo.GetDescription()+"'", "set description to '"+repo.GetDescription()+"'")
 	}
 
+	if repo.Homepage != nil {
+		cs.Add("setting homepage to '"+repo.GetHomepage()+"'", "set homepage to '"+repo.GetHomepage()+"'")
+	}
+
 	if repo.Archived != nil {
 		cs.Add("setting archived to '"+fmt.Sprintf("%t", repo.GetArchived())+"'", "set archived to '"+fmt.Sprintf("%t", repo.GetArchived())+"'")
 	}
@@ -322,21 +493,61 @@ func (c *Client) CreateRepo(ctx context.Context, org string, repo *github.Reposi
 		cs.Add("setting private to '"+fmt.Sprintf("%t", repo.GetPrivate())+"'", "set private to '"+fmt.Sprintf("%t", repo.GetPrivate())+"'")
 	}
 
+	if repo.Visibility != nil {
+		cs.Add("setting visibility to '"+repo.GetVisibility()+"'", "set visibility to '"+repo.GetVisibility()+"'")
+	}
+
 	if repo.DefaultBranch != nil {
 		cs.Add("setting default branch to '"+repo.GetDefaultBranch()+"'", "set default branch to '"+repo.GetDefaultBranch()+"'")
 	}
 
+	if repo.HasIssues != nil {
+		cs.Add("setting has issues to '"+fmt.Sprintf("%t", repo.GetHasIssues())+"'", "set has issues to '"+fmt.Sprintf("%t", repo.GetHasIssues())+"'")
+	}
+
+	if repo.HasProjects != nil {
+		cs.Add("setting has projects to '"+fmt.Sprintf("%t", repo.GetHasProjects())+"'", "set has projects to '"+fmt.Sprintf("%t", repo.GetHasProjects())+"'")
+	}
+
+	if repo.HasWiki != nil {
+		cs.Add("setting has wiki to '"+fmt.Sprintf("%t", repo.GetHasWiki())+"'", "set has wiki to '"+fmt.Sprintf("%t", repo.GetHasWiki())+"'")
+	}
+
+	if repo.HasDiscussions != nil {
+		cs.Add("setting has discussions to '"+fmt.Sprintf("%t", repo.GetHasDiscussions())+"'", "set has discussions to '"+fmt.Sprintf("%t", repo.GetHasDiscussions())+"'")
+	}
+
+	if repo.DeleteBranchOnMerge != nil {
+		cs.Add("setting auto delete head branches to '"+fmt.Sprintf("%t", repo.GetDeleteBranchOnMerge())+"'", "set auto delete head branches to '"+fmt.Sprintf("%t", repo.GetDeleteBranchOnMerge())+"'")
+	}
+
+	if repo.AllowAutoMerge != nil {
+		cs.Add("setting allow auto merge to '"+fmt.Sprintf("%t", repo.GetAllowAutoMerge())+"'", "set allow auto merge to '"+fmt.Sprintf("%t", repo.GetAllowAutoMerge())+"'")
+	}
+
+	if repo.AutoInit != nil {
+		cs.Add("setting auto init to '"+fmt.Sprintf("%t", repo.GetAutoInit())+"'", "set auto init to '"+fmt.Sprintf("%t", repo.GetAutoInit())+"'")
+	}
+
+	if repo.LicenseTemplate != nil {
+		cs.Add("adding license '"+repo.GetLicenseTemplate()+"'", "added license '"+repo.GetLicenseTemplate()+"'")
+	}
+
+	if repo.GitignoreTemplate != nil {
+		cs.Add("adding gitignore '"+repo.GetGitignoreTemplate()+"'", "added gitignore '"+repo.GetGitignoreTemplate()+"'")
+	}
+
 	cs.PrintPre()
 
-	c.Add(func() error {
+	c.Add(Change{Resource: "repo", Action: "create", Org: org, Repo: repo.GetName()}, func() error {
 		c.rate.Wait(ctx) //nolint: errcheck
 		_, _, err := c.ghClient.Repositories.Create(ctx, org, repo)
 		if err != nil {
 			if _, ok := err.(*github.RateLimitError); ok {
-				return fmt.Errorf("github: hit rate limit")
+				return ErrRateLimited
 			}
 
-			return fmt.Errorf("create repo: %w", err)
+			return wrapErr("create repo", org+"/"+repo.GetName(), err)
 		}
 
 		cs.PrintPost()
@@ -345,75 +556,123 @@ func (c *Client) CreateRepo(ctx context.Context, org string, repo *github.Reposi
 	})
 }
 
-func (c *Client) UpdateRepo(ctx context.Context, org, repo string, edits *github.Repository) {
+// UpdateRepo queues the edits to a repo, printing each field's current value
+// next to the new one. Only the fields set on the edits are sent, and any set
+// outside the ones it patches fail it rather than being written to github.
+// Nothing is queued when there are no edits.
+func (c *Client) UpdateRepo(ctx context.Context, org, repo string, current, edits *github.Repository) error {
+	fields, err := patchedFields(edits)
+	if err != nil {
+		return wrapErr("update repo", org+"/"+repo, err)
+	}
+
 	cs := &report.ChangeSet{}
 
 	if edits.Description != nil {
-		cs.Add("updating description to '"+*edits.Description+"'", "updated description to '"+*edits.Description+"'")
+		cs.Add(fieldChange("description", current.GetDescription(), edits.GetDescription()))
+	}
+
+	if edits.Homepage != nil {
+		cs.Add(fieldChange("homepage", current.GetHomepage(), edits.GetHomepage()))
 	}
 
 	if edits.Archived != nil {
-		cs.Add("updating archived to '"+fmt.Sprintf("%t", *edits.Archived)+"'", "updated archived to '"+fmt.Sprintf("%t", *edits.Archived)+"'")
+		cs.Add(fieldChange("archived", fmt.Sprintf("%t", current.GetArchived()), fmt.Sprintf("%t", edits.GetArchived())))
 	}
 
 	if edits.Private != nil {
-		cs.Add("updating private to '"+fmt.Sprintf("%t", *edits.Private)+"'", "updated private to '"+fmt.Sprintf("%t", *edits.Private)+"'")
+		cs.Add(fieldChange("private", fmt.Sprintf("%t", current.GetPrivate()), fmt.Sprintf("%t", edits.GetPrivate())))
+	}
+
+	if edits.Visibility != nil {
+		cs.Add(fieldChange("visibility", current.GetVisibility(), edits.GetVisibility()))
 	}
 
 	if edits.DefaultBranch != nil {
-		cs.Add("updating default branch to '"+*edits.DefaultBranch+"'", "updated default branch to '"+*edits.DefaultBranch+"'")
+		cs.Add(fieldChange("default branch", current.GetDefaultBranch(), edits.GetDefaultBranch()))
+	}
+
+	if edits.HasIssues != nil {
+		cs.Add(fieldChange("has issues", fmt.Sprintf("%t", current.GetHasIssues()), fmt.Sprintf("%t", edits.GetHasIssues())))
+	}
+
+	if edits.HasProjects != nil {
+		cs.Add(fieldChange("has projects", fmt.Sprintf("%t", current.GetHasProjects()), fmt.Sprintf("%t", edits.GetHasProjects())))
+	}
+
+	if edits.HasWiki != nil {
+		cs.Add(fieldChange("has wiki", fmt.Sprintf("%t", current.GetHasWiki()), fmt.Sprintf("%t", edits.GetHasWiki())))
+	}
+
+	if edits.HasDiscussions != nil {
+		cs.Add(fieldChange("has discussions", fmt.Sprintf("%t", current.GetHasDiscussions()), fmt.Sprintf("%t", edits.GetHasDiscussions())))
 	}
 
 	if edits.DeleteBranchOnMerge != nil {
-		cs.Add("updating auto delete head branches to '"+fmt.Sprintf("%t", *edits.DeleteBranchOnMerge)+"'", "updated auto delete head branches to '"+fmt.Sprintf("%t", *edits.DeleteBranchOnMerge)+"'")
+		cs.Add(fieldChange("auto delete head branches", fmt.Sprintf("%t", current.GetDeleteBranchOnMerge()), fmt.Sprintf("%t", edits.GetDeleteBranchOnMerge())))
 	}
 
 	if edits.AllowAutoMerge != nil {
-		cs.Add("updating allow auto merge to '"+fmt.Sprintf("%t", *edits.AllowAutoMerge)+"'", "updated allow auto merge to '"+fmt.Sprintf("%t", *edits.AllowAutoMerge)+"'")
+		cs.Add(fieldChange("allow auto merge", fmt.Sprintf("%t", current.GetAllowAutoMerge()), fmt.Sprintf("%t", edits.GetAllowAutoMerge())))
+	}
+
+	if !cs.HasChanges() {
+		return nil
+	}
+
+	action := "update"
+	if edits.GetArchived() && !current.GetArchived() {
+		action = "archive"
 	}
 
+	report.PrintTrace("patching " + org + "/" + repo + " fields [" + strings.Join(fields, ", ") + "]")
+
 	cs.PrintPre()
 
-	c.Add(func() error {
+	c.Add(Change{Resource: "repo", Action: action, Org: org, Repo: repo}, func() error {
 		c.rate.Wait(ctx) //nolint: errcheck
 		_, resp, err := c.ghClient.Repositories.Edit(ctx, org, repo, edits)
 		if err != nil {
 			if _, ok := err.(*github.RateLimitError); ok {
-				return fmt.Errorf("github: hit rate limit")
+				return ErrRateLimited
 			}
 
-			if resp.StatusCode == http.StatusNotFound {
+			if resp != nil && resp.StatusCode == http.StatusNotFound {
 				return ErrRepoNotFound
 			}
 
-			return fmt.Errorf("update repo description: %w", err)
+			return wrapErr("update repo", org+"/"+repo, err)
 		}
 
 		cs.PrintPost()
 
 		return nil
 	})
+
+	return nil
 }
 
-func (c *Client) SetRepoTopics(ctx context.Context, org, repo string, topics []string) {
+// RenameRepo queues renaming a repo. Github redirects the old name to the new
+// one afterwards.
+func (c *Client) RenameRepo(ctx context.Context, org, from, to string) {
 	cs := &report.ChangeSet{}
-	cs.Add("updating labels to ["+strings.Join(topics, ", ")+"]", "updated labels to ["+strings.Join(topics, ", ")+"]")
+	cs.Add("renaming repo '"+from+"' to '"+to+"'", "renamed repo '"+from+"' to '"+to+"'")
 
 	cs.PrintPre()
 
-	c.Add(func() error {
+	c.Add(Change{Resource: "repo", Action: "rename", Org: org, Repo: from, Target: to}, func() error {
 		c.rate.Wait(ctx) //nolint: errcheck
-		_, resp, err := c.ghClient.Repositories.ReplaceAllTopics(ctx, org, repo, topics)
+		_, resp, err := c.ghClient.Repositories.Edit(ctx, org, from, &github.Repository{Name: github.String(to)})
 		if err != nil {
 			if _, ok := err.(*github.RateLimitError); ok {
-				return fmt.Errorf("github: hit rate limit")
+				return ErrRateLimited
 			}
 
-			if resp.StatusCode == http.StatusNotFound {
+			if resp != nil && resp.StatusCode == http.StatusNotFound {
 				return ErrRepoNotFound
 			}
 
-			return fmt.Errorf("set repo topics: %w", err)
+			return wrapErr("rename repo to "+to, org+"/"+from, err)
 		}
 
 		cs.PrintPost()
@@ -422,15 +681,48 @@ func (c *Client) SetRepoTopics(ctx context.Context, org, repo string, topics []s
 	})
 }
 
-func (c *Client) ProtectBranch(ctx context.Context, org, repo, branch string, protection *github.ProtectionRequest) error {
-	ghpb, err := c.GetBranchProtection(ctx, org, repo, branch)
-	if err != nil && !errors.Is(err, ErrBranchProtectionNotFound) {
-		return err
+// SetRepoTopics replaces the repo's topics, printing the current ones next to
+// the new ones. Current is nil for a repo being created.
+func (c *Client) SetRepoTopics(ctx context.Context, org, repo string, current, topics []string) {
+	cs := &report.ChangeSet{}
+	if current == nil {
+		cs.Add("updating topics to ["+strings.Join(topics, ", ")+"]", "updated topics to ["+strings.Join(topics, ", ")+"]")
+	} else {
+		cs.Add(fieldChange("topics", "["+strings.Join(current, ", ")+"]", "["+strings.Join(topics, ", ")+"]"))
 	}
 
+	cs.PrintPre()
+
+	c.Add(Change{Resource: "repo topics", Action: "set", Org: org, Repo: repo}, func() error {
+		c.rate.Wait(ctx) //nolint: errcheck
+		_, resp, err := c.ghClient.Repositories.ReplaceAllTopics(ctx, org, repo, topics)
+		if err != nil {
+			if _, ok := err.(*github.RateLimitError); ok {
+				return ErrRateLimited
+			}
+
+			if resp != nil && resp.StatusCode == http.StatusNotFound {
+				return ErrRepoNotFound
+			}
+
+			return wrapErr("set repo topics", org+"/"+repo, err)
+		}
+
+		cs.PrintPost()
+
+		return nil
+	})
+}
+
+// ProtectBranch queues an update of a branch's protection. Only whether pull
+// requests and status checks are required, and which checks, are managed;
+// every other setting already on the branch is carried over into the update.
+// Nothing is queued when the managed settings already match. Current is the
+// branch's protection, nil when it has none or the repo is being created.
+func (c *Client) ProtectBranch(ctx context.Context, org, repo, branch string, current *github.Protection, protection *github.ProtectionRequest) {
 	cs := &report.ChangeSet{}
 
-	if ghpb != nil {
+	if current != nil {
 		report.PrintInfo(branch + " branch protected")
 		report.Println()
 	} else {
@@ -438,24 +730,24 @@ func (c *Client) ProtectBranch(ctx context.Context, org, repo, branch string, pr
 	}
 
 	if protection.RequiredPullRequestReviews != nil {
-		if ghpb.GetRequiredPullRequestReviews() == nil {
+		if current.GetRequiredPullRequestReviews() == nil {
 			cs.Add("setting require pr to 'true'", "set require pr to 'true'")
 		}
 	} else {
-		if ghpb.GetRequiredPullRequestReviews() != nil {
+		if current.GetRequiredPullRequestReviews() != nil {
 			cs.Add("setting require pr to 'false'", "set require pr to 'false'")
 		}
 	}
 
 	checks := []string{}
 	if protection.RequiredStatusChecks != nil {
-		if ghpb.GetRequiredStatusChecks() == nil {
+		if current.GetRequiredStatusChecks() == nil {
 			cs.Add("setting require status checks to 'true'", "set require status checks to 'true'")
 
 			rc := protection.GetRequiredStatusChecks()
 			if len(rc.Checks) > 0 {
 				for i := range rc.Checks {
-					checks = append(checks, rc.Checks[i].Context)
+					checks = append(checks, checkName(rc.Checks[i]))
 				}
 			}
 
@@ -465,56 +757,240 @@ func (c *Client) ProtectBranch(ctx context.Context, org, repo, branch string, pr
 		} else {
 			report.PrintInfo("status checks required")
 			report.Println()
+
+			want := requiredChecks(protection.RequiredStatusChecks)
+			if len(want) > 0 && !sameChecks(protection.RequiredStatusChecks, current.GetRequiredStatusChecks()) {
+				cs.Add("setting required checks to ["+strings.Join(want, ", ")+"]", "set required checks to ["+strings.Join(want, ", ")+"]")
+			}
 		}
 	} else {
-		if ghpb.GetRequiredStatusChecks() != nil {
+		if current.GetRequiredStatusChecks() != nil {
 			cs.Add("setting require status checks to 'false'", "set require status checks to 'false'")
 		}
 	}
 
+	if !cs.HasChanges() {
+		return
+	}
+
+	preserveProtection(current, protection)
+
 	cs.PrintPre()
 
-	c.Add(func() error {
+	c.Add(Change{Resource: "branch protection", Action: "update", Org: org, Repo: repo, Target: branch}, func() error {
 		c.rate.Wait(ctx) //nolint: errcheck
 		_, resp, err := c.ghClient.Repositories.UpdateBranchProtection(ctx, org, repo, branch, protection)
 		if err != nil {
 			if _, ok := err.(*github.RateLimitError); ok {
-				return fmt.Errorf("github: hit rate limit")
+				return ErrRateLimited
 			}
 
-			if resp.StatusCode == http.StatusNotFound {
-				return ErrBranchProtectionNotFound
+			if resp != nil && resp.StatusCode == http.StatusNotFound {
+				return fmt.Errorf("protect branch %s on %s: %w; the repo needs at least one commit on the branch before it can be protected", branch, repo, ErrBranchNotFound)
 			}
 
-			return fmt.Errorf("protect branch: %w", err)
+			return wrapErr("protect branch "+branch, org+"/"+repo, err)
 		}
 
 		cs.PrintPost()
 
 		return nil
 	})
+}
 
-	return nil
+// requiredChecks returns the names of the checks, along with the app each is
+// pinned to, sorted.
+func requiredChecks(rc *github.RequiredStatusChecks) []string {
+	checks := []string{}
+	for _, c := range statusChecks(rc) {
+		checks = append(checks, checkName(c))
+	}
+
+	slices.Sort(checks)
+
+	return checks
 }
 
-func (c *Client) SetRequireSignedCommits(ctx context.Context, org, repo, branch string, require bool) error {
-	ghpb, err := c.GetBranchProtection(ctx, org, repo, branch)
-	if err != nil && !errors.Is(err, ErrBranchProtectionNotFound) {
-		return err
+// statusChecks returns the checks required, which older protections only
+// report as contexts.
+func statusChecks(rc *github.RequiredStatusChecks) []*github.RequiredStatusCheck {
+	if rc == nil {
+		return nil
+	}
+
+	if len(rc.Checks) > 0 {
+		return rc.Checks
 	}
 
+	checks := []*github.RequiredStatusCheck{}
+	for _, c := range rc.Contexts {
+		checks = append(checks, &github.RequiredStatusCheck{Context: c})
+	}
+
+	return checks
+}
+
+// sameChecks reports whether the required checks are the ones wanted. Checks
+// are matched by name, and by app only when the wanted one is pinned to an
+// app, as github pins checks to the app that last reported them otherwise.
+func sameChecks(want, have *github.RequiredStatusChecks) bool {
+	hcs := statusChecks(have)
+	wcs := statusChecks(want)
+	if len(wcs) != len(hcs) {
+		return false
+	}
+
+	for _, w := range wcs {
+		found := slices.ContainsFunc(hcs, func(h *github.RequiredStatusCheck) bool {
+			return h.Context == w.Context && (w.AppID == nil || h.GetAppID() == w.GetAppID())
+		})
+
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+func checkName(c *github.RequiredStatusCheck) string {
+	if c.AppID == nil {
+		return c.Context
+	}
+
+	return fmt.Sprintf("%s (app %d)", c.Context, c.GetAppID())
+}
+
+// preserveProtection copies the settings concord does not manage from the
+// branch's current protection into the request, so updating it does not
+// reset them.
+func preserveProtection(current *github.Protection, req *github.ProtectionRequest) {
+	if current == nil {
+		return
+	}
+
+	// github leaves out settings it does not report, such as on older
+	// Enterprise Server releases, so only those it returned are copied
+	if ea := current.GetEnforceAdmins(); ea != nil {
+		req.EnforceAdmins = ea.Enabled
+	}
+
+	if lh := current.GetRequireLinearHistory(); lh != nil {
+		req.RequireLinearHistory = github.Bool(lh.Enabled)
+	}
+
+	if fp := current.GetAllowForcePushes(); fp != nil {
+		req.AllowForcePushes = github.Bool(fp.Enabled)
+	}
+
+	if ad := current.GetAllowDeletions(); ad != nil {
+		req.AllowDeletions = github.Bool(ad.Enabled)
+	}
+
+	if cr := current.GetRequiredConversationResolution(); cr != nil {
+		req.RequiredConversationResolution = github.Bool(cr.Enabled)
+	}
+
+	if bc := current.GetBlockCreations(); bc != nil {
+		req.BlockCreations = bc.Enabled
+	}
+
+	if lb := current.GetLockBranch(); lb != nil {
+		req.LockBranch = lb.Enabled
+	}
+
+	if fs := current.GetAllowForkSyncing(); fs != nil {
+		req.AllowForkSyncing = fs.Enabled
+	}
+
+	if r := current.Restrictions; r != nil {
+		req.Restrictions = &github.BranchRestrictionsRequest{
+			Users: userLogins(r.Users),
+			Teams: teamSlugs(r.Teams),
+			Apps:  appSlugs(r.Apps),
+		}
+	}
+
+	if rc := current.RequiredStatusChecks; rc != nil && req.RequiredStatusChecks != nil {
+		req.RequiredStatusChecks.Strict = rc.Strict
+
+		if len(req.RequiredStatusChecks.Checks) == 0 {
+			req.RequiredStatusChecks.Checks = rc.Checks
+		}
+	}
+
+	if rr := current.RequiredPullRequestReviews; rr != nil && req.RequiredPullRequestReviews != nil {
+		req.RequiredPullRequestReviews = &github.PullRequestReviewsEnforcementRequest{
+			DismissStaleReviews:          rr.DismissStaleReviews,
+			RequireCodeOwnerReviews:      rr.RequireCodeOwnerReviews,
+			RequiredApprovingReviewCount: rr.RequiredApprovingReviewCount,
+			RequireLastPushApproval:      github.Bool(rr.RequireLastPushApproval),
+		}
+
+		if dr := rr.DismissalRestrictions; dr != nil {
+			users, teams, apps := userLogins(dr.Users), teamSlugs(dr.Teams), appSlugs(dr.Apps)
+			req.RequiredPullRequestReviews.DismissalRestrictionsRequest = &github.DismissalRestrictionsRequest{
+				Users: &users,
+				Teams: &teams,
+				Apps:  &apps,
+			}
+		}
+
+		if ba := rr.BypassPullRequestAllowances; ba != nil {
+			req.RequiredPullRequestReviews.BypassPullRequestAllowancesRequest = &github.BypassPullRequestAllowancesRequest{
+				Users: userLogins(ba.Users),
+				Teams: teamSlugs(ba.Teams),
+				Apps:  appSlugs(ba.Apps),
+			}
+		}
+	}
+}
+
+func userLogins(users []*github.User) []string {
+	logins := []string{}
+	for _, u := range users {
+		logins = append(logins, u.GetLogin())
+	}
+
+	return logins
+}
+
+func teamSlugs(teams []*github.Team) []string {
+	slugs := []string{}
+	for _, t := range teams {
+		slugs = append(slugs, t.GetSlug())
+	}
+
+	return slugs
+}
+
+func appSlugs(apps []*github.App) []string {
+	slugs := []string{}
+	for _, a := range apps {
+		slugs = append(slugs, a.GetSlug())
+	}
+
+	return slugs
+}
+
+// SetRequireSignedCommits queues requiring, or no longer requiring, signed
+// commits on a protected branch. Current is the branch's protection, nil when
+// it has none or the repo is being created.
+func (c *Client) SetRequireSignedCommits(ctx context.Context, org, repo, branch string, current *github.Protection, require bool) {
 	cs := &report.ChangeSet{}
 
-	if ghpb.GetRequiredSignatures().GetEnabled() != require {
+	if current.GetRequiredSignatures().GetEnabled() != require {
 		cs.Add(fmt.Sprintf("setting require signed commits to '%t'", require), fmt.Sprintf("set require signed commits to '%t'", require))
 	} else {
 		report.PrintInfo(fmt.Sprintf("require signed commits is '%t'", require))
 		report.Println()
+
+		return
 	}
 
 	cs.PrintPre()
 
-	c.Add(func() error {
+	c.Add(Change{Resource: "signed commits", Action: "set", Org: org, Repo: repo, Target: branch}, func() error {
 		c.rate.Wait(ctx) //nolint: errcheck
 		var resp *github.Response
 		var err error
@@ -526,20 +1002,60 @@ func (c *Client) SetRequireSignedCommits(ctx context.Context, org, repo, branch
 
 		if err != nil {
 			if _, ok := err.(*github.RateLimitError); ok {
-				return fmt.Errorf("github: hit rate limit")
+				return ErrRateLimited
 			}
 
-			if resp.StatusCode == http.StatusNotFound {
+			if resp != nil && resp.StatusCode == http.StatusNotFound {
 				return ErrBranchProtectionNotFound
 			}
 
-			return fmt.Errorf("protect branch: set signature required: %w", err)
+			return wrapErr("set signed commits on branch "+branch, org+"/"+repo, err)
 		}
 
 		cs.PrintPost()
 
 		return nil
 	})
+}
 
-	return nil
+// patchedFields returns the API names of the fields set on the edits, sorted,
+// as those are the only ones sent. Any outside the fields UpdateRepo patches
+// are an error, as sending them would overwrite settings nothing compared.
+func patchedFields(edits *github.Repository) ([]string, error) {
+	b, err := json.Marshal(edits)
+	if err != nil {
+		return nil, err
+	}
+
+	var set map[string]json.RawMessage
+	err = json.Unmarshal(b, &set)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := []string{}
+	unexpected := []string{}
+	for f := range set {
+		fields = append(fields, f)
+
+		if !slices.Contains(repoEditFields, f) {
+			unexpected = append(unexpected, f)
+		}
+	}
+
+	slices.Sort(fields)
+
+	if len(unexpected) > 0 {
+		slices.Sort(unexpected)
+		return nil, fmt.Errorf("%w: %s", ErrUnexpectedRepoEdits, strings.Join(unexpected, ", "))
+	}
+
+	return fields, nil
+}
+
+// fieldChange describes a field going from its current value to a new one,
+// as the pre and post lines of a change set.
+func fieldChange(field, from, to string) (string, string) {
+	d := field + ": '" + from + "' -> '" + to + "'"
+	return "updating " + d, "updated " + d
 }
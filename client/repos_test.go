@@ -0,0 +1,407 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/gomicro/concord/report"
+	"github.com/google/go-github/v56/github"
+)
+
+func TestProtectBranchWithoutCommits(t *testing.T) {
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/api/v3/repos/acme/widgets/branches/main/protection" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+
+		http.Error(w, `{"message": "Branch not found"}`, http.StatusNotFound)
+	}))
+
+	// an empty repo has no protection to read
+	c.ProtectBranch(context.Background(), "acme", "widgets", "main", nil, &github.ProtectionRequest{
+		RequiredPullRequestReviews: &github.PullRequestReviewsEnforcementRequest{},
+	})
+
+	err := c.Flush(context.Background())
+	if !errors.Is(err, ErrBranchNotFound) {
+		t.Fatalf("err = %v, want %v", err, ErrBranchNotFound)
+	}
+
+	if !strings.Contains(err.Error(), "needs at least one commit") {
+		t.Errorf("err = %v, want a hint about committing first", err)
+	}
+}
+
+const protectedMain = `{
+	"required_pull_request_reviews": {"required_approving_review_count": 2, "dismiss_stale_reviews": true},
+	"enforce_admins": {"enabled": true},
+	"required_linear_history": {"enabled": true},
+	"restrictions": {"users": [{"login": "alice"}], "teams": [{"slug": "release"}], "apps": []}
+}`
+
+func TestProtectBranchKeepsUnmanagedSettings(t *testing.T) {
+	var sent map[string]interface{}
+
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPut {
+			json.NewDecoder(r.Body).Decode(&sent) //nolint: errcheck
+		}
+
+		w.Write([]byte(protectedMain)) //nolint: errcheck
+	}))
+
+	ctx := context.Background()
+
+	current, err := c.GetBranchProtection(ctx, "acme", "widgets", "main")
+	if err != nil {
+		t.Fatalf("get branch protection: %v", err)
+	}
+
+	// status checks are added, the rest of the protection is left as it is
+	c.ProtectBranch(ctx, "acme", "widgets", "main", current, &github.ProtectionRequest{
+		RequiredPullRequestReviews: &github.PullRequestReviewsEnforcementRequest{},
+		RequiredStatusChecks: &github.RequiredStatusChecks{
+			Checks: []*github.RequiredStatusCheck{{Context: "ci/build"}},
+		},
+	})
+
+	err = c.Flush(ctx)
+	if err != nil {
+		t.Fatalf("flush: %v", err)
+	}
+
+	if sent == nil {
+		t.Fatal("protection was never updated")
+	}
+
+	if sent["enforce_admins"] != true {
+		t.Errorf("enforce_admins = %v, want true", sent["enforce_admins"])
+	}
+
+	if sent["required_linear_history"] != true {
+		t.Errorf("required_linear_history = %v, want true", sent["required_linear_history"])
+	}
+
+	restrictions, _ := sent["restrictions"].(map[string]interface{})
+	if fmt.Sprint(restrictions["users"]) != "[alice]" || fmt.Sprint(restrictions["teams"]) != "[release]" {
+		t.Errorf("restrictions = %v, want alice and release kept", sent["restrictions"])
+	}
+
+	reviews, _ := sent["required_pull_request_reviews"].(map[string]interface{})
+	if reviews["required_approving_review_count"] != float64(2) || reviews["dismiss_stale_reviews"] != true {
+		t.Errorf("reviews = %v, want the approval count and stale review dismissal kept", reviews)
+	}
+}
+
+func TestProtectBranchUnchanged(t *testing.T) {
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+
+		w.Write([]byte(protectedMain)) //nolint: errcheck
+	}))
+
+	ctx := context.Background()
+
+	current, err := c.GetBranchProtection(ctx, "acme", "widgets", "main")
+	if err != nil {
+		t.Fatalf("get branch protection: %v", err)
+	}
+
+	c.ProtectBranch(ctx, "acme", "widgets", "main", current, &github.ProtectionRequest{
+		RequiredPullRequestReviews: &github.PullRequestReviewsEnforcementRequest{},
+	})
+
+	// nothing is queued, so flushing sends no update
+	err = c.Flush(ctx)
+	if err != nil {
+		t.Fatalf("flush: %v", err)
+	}
+}
+
+func TestAddRepoToTeamReportsOnlyChanges(t *testing.T) {
+	tests := []struct {
+		name    string
+		perm    string
+		want    string
+		changed bool
+	}{
+		{name: "unchanged", perm: "write", want: "team 'platform' has permission 'write'"},
+		{name: "changed", perm: "admin", want: "updated team 'platform' permission: 'write' -> 'admin'", changed: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch {
+				case r.Method == http.MethodGet && r.URL.Path == "/api/v3/repos/acme/widgets/teams":
+					w.Header().Set("Content-Type", "application/json")
+					w.Write([]byte(`[{"name": "platform", "slug": "platform", "permission": "push"}]`)) //nolint: errcheck
+				case r.Method == http.MethodPut && r.URL.Path == "/api/v3/orgs/acme/teams/platform/repos/acme/widgets":
+					w.WriteHeader(http.StatusNoContent)
+				default:
+					t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+					http.NotFound(w, r)
+				}
+			}))
+
+			var out bytes.Buffer
+			report.SetOutput(&out)
+			report.SetColor(false)
+
+			err := c.AddRepoToTeam(context.Background(), "acme", "platform", "widgets", tt.perm)
+			if err != nil {
+				t.Fatalf("add repo to team: %v", err)
+			}
+
+			if (len(c.Pending()) > 0) != tt.changed {
+				t.Errorf("queued %v, changed %v", c.Pending(), tt.changed)
+			}
+
+			err = c.Flush(context.Background())
+			if err != nil {
+				t.Fatalf("flush: %v", err)
+			}
+
+			if !strings.Contains(out.String(), tt.want) {
+				t.Errorf("output missing %q:\n%s", tt.want, out.String())
+			}
+
+			if !tt.changed && strings.Contains(out.String(), "updated") {
+				t.Errorf("reported an update when nothing changed:\n%s", out.String())
+			}
+		})
+	}
+}
+
+func TestReadsWithoutResponse(t *testing.T) {
+	quietReport(t)
+
+	// a closed server refuses connections, so no response comes back at all
+	srv := httptest.NewServer(http.NotFoundHandler())
+	srv.Close()
+
+	c, err := New(context.Background(), "tkn", WithBaseURL(srv.URL+"/"))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	ctx := context.Background()
+
+	tests := []struct {
+		name string
+		read func() error
+	}{
+		{name: "repos", read: func() error { _, err := c.GetRepos(ctx, "acme"); return err }},
+		{name: "repo", read: func() error { _, err := c.GetRepo(ctx, "acme", "widgets"); return err }},
+		{name: "topics", read: func() error { _, err := c.GetRepoTopics(ctx, "acme", "widgets"); return err }},
+		{name: "branches", read: func() error { _, err := c.GetBranches(ctx, "acme", "widgets"); return err }},
+		{name: "branch protection", read: func() error { _, err := c.GetBranchProtection(ctx, "acme", "widgets", "main"); return err }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.read()
+
+			var uerr *url.Error
+			if !errors.As(err, &uerr) {
+				t.Errorf("err = %v, want the connection error", err)
+			}
+		})
+	}
+}
+
+func TestGetReposArchived(t *testing.T) {
+	tests := []struct {
+		name    string
+		include bool
+		want    []string
+	}{
+		{name: "excluded by default", want: []string{"widgets"}},
+		{name: "included", include: true, want: []string{"widgets", "gadgets"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+
+				switch r.URL.Path {
+				case "/api/v3/orgs/acme":
+					w.Write([]byte(`{"login": "acme", "public_repos": 2}`)) //nolint: errcheck
+				case "/api/v3/orgs/acme/repos":
+					w.Write([]byte(`[{"name": "widgets"}, {"name": "gadgets", "archived": true}]`)) //nolint: errcheck
+				default:
+					http.NotFound(w, r)
+				}
+			}), WithIncludeArchived(tt.include))
+
+			repos, err := c.GetRepos(context.Background(), "acme")
+			if err != nil {
+				t.Fatalf("get repos: %v", err)
+			}
+
+			var names []string
+			for _, r := range repos {
+				names = append(names, r.GetName())
+			}
+
+			if strings.Join(names, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("repos = %v, want %v", names, tt.want)
+			}
+		})
+	}
+}
+
+// branchesHandler serves n branches of widgets a page at a time, every even
+// one of them protected, filtering on the protected query param as github
+// does.
+func branchesHandler(t *testing.T, n int) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v3/repos/acme/widgets/branches" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			http.NotFound(w, r)
+
+			return
+		}
+
+		q := r.URL.Query()
+		protectedOnly := q.Get("protected") == "true"
+
+		var names []string
+		for i := 0; i < n; i++ {
+			if !protectedOnly || i%2 == 0 {
+				names = append(names, fmt.Sprintf("branch%d", i))
+			}
+		}
+
+		page := 1
+		if p := q.Get("page"); p != "" {
+			fmt.Sscan(p, &page) //nolint: errcheck
+		}
+
+		start, end := (page-1)*100, page*100
+		if end >= len(names) {
+			end = len(names)
+		} else {
+			next := *r.URL
+			q.Set("page", fmt.Sprint(page+1))
+			next.RawQuery = q.Encode()
+			w.Header().Set("Link", fmt.Sprintf(`<http://%s%s>; rel="next"`, r.Host, next.String()))
+		}
+
+		var bs []map[string]interface{}
+		for i, name := range names[start:end] {
+			bs = append(bs, map[string]interface{}{"name": name, "protected": protectedOnly || (start+i)%2 == 0})
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(bs) //nolint: errcheck
+	})
+}
+
+func TestGetBranchesPaginates(t *testing.T) {
+	c := newTestClient(t, branchesHandler(t, 250))
+
+	branches, err := c.GetBranches(context.Background(), "acme", "widgets")
+	if err != nil {
+		t.Fatalf("get branches: %v", err)
+	}
+
+	if len(branches) != 250 {
+		t.Errorf("got %d branches, want all 250", len(branches))
+	}
+
+	if branches[249].GetName() != "branch249" {
+		t.Errorf("last branch = %s, want branch249", branches[249].GetName())
+	}
+}
+
+func TestGetProtectedBranchesPaginates(t *testing.T) {
+	c := newTestClient(t, branchesHandler(t, 450))
+
+	names, err := c.GetProtectedBranches(context.Background(), "acme", "widgets")
+	if err != nil {
+		t.Fatalf("get protected branches: %v", err)
+	}
+
+	if len(names) != 225 {
+		t.Errorf("got %d protected branches, want 225", len(names))
+	}
+
+	for _, n := range names {
+		var i int
+		fmt.Sscanf(n, "branch%d", &i) //nolint: errcheck
+
+		if i%2 != 0 {
+			t.Errorf("unprotected branch %s listed", n)
+		}
+	}
+}
+
+func TestUpdateRepoSendsOnlyEdits(t *testing.T) {
+	var sent map[string]interface{}
+
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch || r.URL.Path != "/api/v3/repos/acme/widgets" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+
+		json.NewDecoder(r.Body).Decode(&sent) //nolint: errcheck
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"name": "widgets"}`)) //nolint: errcheck
+	}))
+
+	current := &github.Repository{
+		Name:        github.String("widgets"),
+		Description: github.String("old"),
+		Homepage:    github.String("https://example.com"),
+		HasWiki:     github.Bool(true),
+	}
+
+	err := c.UpdateRepo(context.Background(), "acme", "widgets", current, &github.Repository{Description: github.String("new")})
+	if err != nil {
+		t.Fatalf("update repo: %v", err)
+	}
+
+	err = c.Flush(context.Background())
+	if err != nil {
+		t.Fatalf("flush: %v", err)
+	}
+
+	if len(sent) != 1 || sent["description"] != "new" {
+		t.Errorf("sent %v, want only the description", sent)
+	}
+}
+
+func TestUpdateRepoRefusesUnexpectedFields(t *testing.T) {
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+	}))
+
+	// a whole repo as read from github carries fields that are not edits
+	full := &github.Repository{
+		Name:            github.String("widgets"),
+		Description:     github.String("new"),
+		StargazersCount: github.Int(3),
+	}
+
+	err := c.UpdateRepo(context.Background(), "acme", "widgets", &github.Repository{}, full)
+	if !errors.Is(err, ErrUnexpectedRepoEdits) {
+		t.Fatalf("err = %v, want %v", err, ErrUnexpectedRepoEdits)
+	}
+
+	if len(c.Pending()) != 0 {
+		t.Errorf("queued %v, want nothing", c.Pending())
+	}
+}
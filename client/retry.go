@@ -0,0 +1,84 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gomicro/concord/report"
+	"github.com/google/go-github/v56/github"
+)
+
+const (
+	// secondaryRetries is how many times a request hitting github's
+	// secondary rate limit is retried before the error is returned.
+	secondaryRetries = 4
+
+	// secondaryBackoff is the first wait when github does not say how long
+	// to wait, doubling on each retry.
+	secondaryBackoff = 10 * time.Second
+)
+
+// retryTransport retries requests that hit github's secondary, or abuse, rate
+// limit, waiting as long as github asks or backing off exponentially when it
+// does not say. The primary rate limit is not retried as it takes up to an
+// hour to reset.
+type retryTransport struct {
+	next http.RoundTripper
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		resp, err := t.next.RoundTrip(req)
+		if err != nil || attempt == secondaryRetries {
+			return resp, err
+		}
+
+		if resp.StatusCode < 300 {
+			return resp, nil
+		}
+
+		// checking the response reads its body into memory and swaps it out,
+		// so the original is closed here to free the connection and the
+		// request's deadline
+		body := resp.Body
+		rerr := github.CheckResponse(resp)
+		body.Close()
+
+		var abuse *github.AbuseRateLimitError
+		if !errors.As(rerr, &abuse) {
+			return resp, nil
+		}
+
+		// a body that has been sent can only be sent again if it can be
+		// rewound
+		if req.Body != nil && req.GetBody == nil {
+			return resp, nil
+		}
+
+		wait := secondaryBackoff << attempt
+		if abuse.RetryAfter != nil {
+			wait = *abuse.RetryAfter
+		}
+
+		report.PrintWarn(fmt.Sprintf("hit github's secondary rate limit, retrying in %s", wait))
+		report.Println()
+
+		select {
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		case <-time.After(wait):
+		}
+
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+
+			req = req.Clone(req.Context())
+			req.Body = body
+		}
+	}
+}
@@ -0,0 +1,141 @@
+package client
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// trackedBody records whether it was closed.
+type trackedBody struct {
+	io.Reader
+	closed bool
+}
+
+func (b *trackedBody) Close() error {
+	b.closed = true
+	return nil
+}
+
+func response(req *http.Request, status int, body string, header http.Header) (*http.Response, *trackedBody) {
+	b := &trackedBody{Reader: strings.NewReader(body)}
+
+	if header == nil {
+		header = http.Header{}
+	}
+
+	return &http.Response{
+		StatusCode: status,
+		Header:     header,
+		Body:       b,
+		Request:    req,
+	}, b
+}
+
+const abuseBody = `{"message": "You have exceeded a secondary rate limit", "documentation_url": "https://docs.github.com/rest/overview/resources-in-the-rest-api#secondary-rate-limits"}`
+
+func TestRetryTransportRetriesAbuse(t *testing.T) {
+	quietReport(t)
+
+	var bodies []*trackedBody
+
+	rt := &retryTransport{next: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if len(bodies) == 0 {
+			resp, b := response(req, http.StatusForbidden, abuseBody, http.Header{"Retry-After": []string{"0"}})
+			bodies = append(bodies, b)
+
+			return resp, nil
+		}
+
+		resp, b := response(req, http.StatusOK, `{}`, nil)
+		bodies = append(bodies, b)
+
+		return resp, nil
+	})}
+
+	req, _ := http.NewRequest(http.MethodGet, "https://api.github.com/orgs/acme", nil)
+
+	resp, err := rt.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("round trip: %v", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("status = %d, want %d", resp.StatusCode, http.StatusOK)
+	}
+
+	if len(bodies) != 2 {
+		t.Fatalf("made %d requests, want 2", len(bodies))
+	}
+
+	if !bodies[0].closed {
+		t.Error("body of the rate limited response was never closed")
+	}
+}
+
+func TestRetryTransportGivesUp(t *testing.T) {
+	quietReport(t)
+
+	calls := 0
+
+	rt := &retryTransport{next: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		calls++
+
+		resp, _ := response(req, http.StatusForbidden, abuseBody, http.Header{"Retry-After": []string{"0"}})
+
+		return resp, nil
+	})}
+
+	req, _ := http.NewRequest(http.MethodGet, "https://api.github.com/orgs/acme", nil)
+
+	resp, err := rt.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("round trip: %v", err)
+	}
+
+	if calls != secondaryRetries+1 {
+		t.Errorf("made %d requests, want %d", calls, secondaryRetries+1)
+	}
+
+	if resp.StatusCode != http.StatusForbidden {
+		t.Errorf("status = %d, want %d", resp.StatusCode, http.StatusForbidden)
+	}
+}
+
+func TestRetryTransportPassesErrorsOn(t *testing.T) {
+	var body *trackedBody
+
+	rt := &retryTransport{next: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		var resp *http.Response
+		resp, body = response(req, http.StatusNotFound, `{"message": "Not Found"}`, nil)
+
+		return resp, nil
+	})}
+
+	req, _ := http.NewRequest(http.MethodGet, "https://api.github.com/orgs/acme", nil)
+
+	resp, err := rt.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("round trip: %v", err)
+	}
+
+	if !body.closed {
+		t.Error("original body was never closed")
+	}
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("read body: %v", err)
+	}
+
+	if !strings.Contains(string(b), "Not Found") {
+		t.Errorf("body = %q, want the error kept readable", b)
+	}
+}
@@ -0,0 +1,178 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/gomicro/concord/report"
+	"github.com/google/go-github/v56/github"
+	"golang.org/x/exp/slices"
+)
+
+// ListRulesets returns the rulesets defined on the repo itself, with their
+// conditions and rules. Rulesets inherited from the org are left out.
+func (c *Client) ListRulesets(ctx context.Context, org, repo string) ([]*github.Ruleset, error) {
+	c.rate.Wait(ctx) //nolint: errcheck
+	rss, resp, err := c.ghClient.Repositories.GetAllRulesets(ctx, org, repo, false)
+	if err != nil {
+		if _, ok := err.(*github.RateLimitError); ok {
+			return nil, ErrRateLimited
+		}
+
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return nil, ErrRepoNotFound
+		}
+
+		return nil, wrapErr("list rulesets", org+"/"+repo, err)
+	}
+
+	// the list only carries a summary of each ruleset
+	var rulesets []*github.Ruleset
+	for _, rs := range rss {
+		if rs.GetSourceType() != "" && rs.GetSourceType() != "Repository" {
+			continue
+		}
+
+		c.rate.Wait(ctx) //nolint: errcheck
+		full, _, err := c.ghClient.Repositories.GetRuleset(ctx, org, repo, rs.GetID(), false)
+		if err != nil {
+			if _, ok := err.(*github.RateLimitError); ok {
+				return nil, ErrRateLimited
+			}
+
+			return nil, wrapErr("get ruleset "+rs.Name, org+"/"+repo, err)
+		}
+
+		rulesets = append(rulesets, full)
+	}
+
+	return rulesets, nil
+}
+
+func (c *Client) CreateRuleset(ctx context.Context, org, repo string, rs *github.Ruleset) {
+	cs := &report.ChangeSet{}
+	cs.Add("creating ruleset '"+rs.Name+"' "+describeRuleset(rs), "created ruleset '"+rs.Name+"' "+describeRuleset(rs))
+
+	cs.PrintPre()
+
+	c.Add(Change{Resource: "ruleset", Action: "create", Org: org, Repo: repo, Target: rs.Name}, func() error {
+		c.rate.Wait(ctx) //nolint: errcheck
+		_, _, err := c.ghClient.Repositories.CreateRuleset(ctx, org, repo, rs)
+		if err != nil {
+			if _, ok := err.(*github.RateLimitError); ok {
+				return ErrRateLimited
+			}
+
+			return wrapErr("create ruleset", org+"/"+repo, err)
+		}
+
+		cs.PrintPost()
+
+		return nil
+	})
+}
+
+// UpdateRuleset replaces an existing ruleset's enforcement, ref conditions,
+// and rules with the desired ones. Nothing is queued when they already match.
+// Bypass actors are not managed and are carried over.
+func (c *Client) UpdateRuleset(ctx context.Context, org, repo string, current, desired *github.Ruleset) {
+	cs := &report.ChangeSet{}
+
+	if current.Enforcement != desired.Enforcement {
+		cs.Add("updating ruleset '"+desired.Name+"' enforcement to '"+desired.Enforcement+"'", "updated ruleset '"+desired.Name+"' enforcement to '"+desired.Enforcement+"'")
+	}
+
+	ci, ce := refConditions(current)
+	di, de := refConditions(desired)
+	if !slices.Equal(ci, di) || !slices.Equal(ce, de) {
+		cs.Add("updating ruleset '"+desired.Name+"' refs to include ["+strings.Join(di, ", ")+"] exclude ["+strings.Join(de, ", ")+"]", "updated ruleset '"+desired.Name+"' refs to include ["+strings.Join(di, ", ")+"] exclude ["+strings.Join(de, ", ")+"]")
+	}
+
+	cr := ruleKeys(current.Rules)
+	dr := ruleKeys(desired.Rules)
+	if !slices.Equal(cr, dr) {
+		cs.Add("updating ruleset '"+desired.Name+"' rules to ["+strings.Join(ruleTypes(desired.Rules), ", ")+"]", "updated ruleset '"+desired.Name+"' rules to ["+strings.Join(ruleTypes(desired.Rules), ", ")+"]")
+	}
+
+	if !cs.HasChanges() {
+		report.PrintInfo("ruleset '" + desired.Name + "' is up to date")
+		report.Println()
+
+		return
+	}
+
+	desired.BypassActors = current.BypassActors
+
+	cs.PrintPre()
+
+	c.Add(Change{Resource: "ruleset", Action: "update", Org: org, Repo: repo, Target: desired.Name}, func() error {
+		c.rate.Wait(ctx) //nolint: errcheck
+		_, _, err := c.ghClient.Repositories.UpdateRuleset(ctx, org, repo, current.GetID(), desired)
+		if err != nil {
+			if _, ok := err.(*github.RateLimitError); ok {
+				return ErrRateLimited
+			}
+
+			return wrapErr("update ruleset", org+"/"+repo, err)
+		}
+
+		cs.PrintPost()
+
+		return nil
+	})
+}
+
+func describeRuleset(rs *github.Ruleset) string {
+	include, exclude := refConditions(rs)
+
+	d := "(" + rs.Enforcement + ") on [" + strings.Join(include, ", ") + "]"
+	if len(exclude) > 0 {
+		d += " except [" + strings.Join(exclude, ", ") + "]"
+	}
+
+	return d + " with [" + strings.Join(ruleTypes(rs.Rules), ", ") + "]"
+}
+
+func refConditions(rs *github.Ruleset) ([]string, []string) {
+	if rs.Conditions == nil || rs.Conditions.RefName == nil {
+		return nil, nil
+	}
+
+	include := slices.Clone(rs.Conditions.RefName.Include)
+	slices.Sort(include)
+
+	exclude := slices.Clone(rs.Conditions.RefName.Exclude)
+	slices.Sort(exclude)
+
+	return include, exclude
+}
+
+func ruleTypes(rules []*github.RepositoryRule) []string {
+	types := []string{}
+	for _, r := range rules {
+		types = append(types, r.Type)
+	}
+
+	slices.Sort(types)
+
+	return types
+}
+
+// ruleKeys describes each rule by its type and parameters so two sets of
+// rules can be compared regardless of order.
+func ruleKeys(rules []*github.RepositoryRule) []string {
+	keys := []string{}
+	for _, r := range rules {
+		k := r.Type
+		if r.Parameters != nil {
+			k += string(*r.Parameters)
+		}
+
+		keys = append(keys, k)
+	}
+
+	slices.Sort(keys)
+
+	return keys
+}
@@ -0,0 +1,482 @@
+package client
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gomicro/concord/report"
+	"github.com/google/go-github/v56/github"
+	"golang.org/x/crypto/nacl/box"
+)
+
+// GetRepoSecrets lists the names of a repo's Actions secrets. Secret values
+// can not be read back.
+func (c *Client) GetRepoSecrets(ctx context.Context, org, repo string) ([]string, error) {
+	opts := &github.ListOptions{
+		Page:    0,
+		PerPage: 100,
+	}
+
+	var names []string
+	for {
+		c.rate.Wait(ctx) //nolint: errcheck
+		ss, resp, err := c.ghClient.Actions.ListRepoSecrets(ctx, org, repo, opts)
+		if err != nil {
+			if _, ok := err.(*github.RateLimitError); ok {
+				return nil, ErrRateLimited
+			}
+
+			if resp != nil && resp.StatusCode == http.StatusNotFound {
+				return nil, ErrRepoNotFound
+			}
+
+			return nil, wrapErr("list repo secrets", org+"/"+repo, err)
+		}
+
+		for _, s := range ss.Secrets {
+			names = append(names, s.Name)
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+
+		opts.Page = resp.NextPage
+	}
+
+	return names, nil
+}
+
+// PutRepoSecret queues setting a repo's Actions secret. The value is
+// encrypted against the repo's public key and never printed.
+func (c *Client) PutRepoSecret(ctx context.Context, org, repo, name, value string) {
+	cs := &report.ChangeSet{}
+	cs.Add("setting secret '"+name+"'", "set secret '"+name+"'")
+
+	cs.PrintPre()
+
+	c.Add(Change{Resource: "repo secret", Action: "set", Org: org, Repo: repo, Target: name}, func() error {
+		c.rate.Wait(ctx) //nolint: errcheck
+		key, _, err := c.ghClient.Actions.GetRepoPublicKey(ctx, org, repo)
+		if err != nil {
+			if _, ok := err.(*github.RateLimitError); ok {
+				return ErrRateLimited
+			}
+
+			return wrapErr("get repo public key", org+"/"+repo, err)
+		}
+
+		encrypted, err := encryptSecret(key, value)
+		if err != nil {
+			return fmt.Errorf("encrypt secret %s: %w", name, err)
+		}
+
+		c.rate.Wait(ctx) //nolint: errcheck
+		_, err = c.ghClient.Actions.CreateOrUpdateRepoSecret(ctx, org, repo, &github.EncryptedSecret{
+			Name:           name,
+			KeyID:          key.GetKeyID(),
+			EncryptedValue: encrypted,
+		})
+		if err != nil {
+			if _, ok := err.(*github.RateLimitError); ok {
+				return ErrRateLimited
+			}
+
+			return wrapErr("put repo secret", org+"/"+repo, err)
+		}
+
+		cs.PrintPost()
+
+		return nil
+	})
+}
+
+// GetRepoVariables lists a repo's Actions variables.
+func (c *Client) GetRepoVariables(ctx context.Context, org, repo string) ([]*github.ActionsVariable, error) {
+	opts := &github.ListOptions{
+		Page:    0,
+		PerPage: 100,
+	}
+
+	var vars []*github.ActionsVariable
+	for {
+		c.rate.Wait(ctx) //nolint: errcheck
+		vs, resp, err := c.ghClient.Actions.ListRepoVariables(ctx, org, repo, opts)
+		if err != nil {
+			if _, ok := err.(*github.RateLimitError); ok {
+				return nil, ErrRateLimited
+			}
+
+			if resp != nil && resp.StatusCode == http.StatusNotFound {
+				return nil, ErrRepoNotFound
+			}
+
+			return nil, wrapErr("list repo variables", org+"/"+repo, err)
+		}
+
+		vars = append(vars, vs.Variables...)
+
+		if resp.NextPage == 0 {
+			break
+		}
+
+		opts.Page = resp.NextPage
+	}
+
+	return vars, nil
+}
+
+// PutRepoVariable queues creating a repo's Actions variable, or updating it
+// when it already exists.
+func (c *Client) PutRepoVariable(ctx context.Context, org, repo, name, value string, exists bool) {
+	cs := &report.ChangeSet{}
+
+	action := "create"
+	if exists {
+		action = "update"
+		cs.Add("updating variable '"+name+"' to '"+value+"'", "updated variable '"+name+"' to '"+value+"'")
+	} else {
+		cs.Add("creating variable '"+name+"' as '"+value+"'", "created variable '"+name+"' as '"+value+"'")
+	}
+
+	cs.PrintPre()
+
+	c.Add(Change{Resource: "repo variable", Action: action, Org: org, Repo: repo, Target: name}, func() error {
+		v := &github.ActionsVariable{
+			Name:  name,
+			Value: value,
+		}
+
+		c.rate.Wait(ctx) //nolint: errcheck
+		var err error
+		if exists {
+			_, err = c.ghClient.Actions.UpdateRepoVariable(ctx, org, repo, v)
+		} else {
+			_, err = c.ghClient.Actions.CreateRepoVariable(ctx, org, repo, v)
+		}
+
+		if err != nil {
+			if _, ok := err.(*github.RateLimitError); ok {
+				return ErrRateLimited
+			}
+
+			return wrapErr(action+" repo variable", org+"/"+repo, err)
+		}
+
+		cs.PrintPost()
+
+		return nil
+	})
+}
+
+// encryptSecret seals a secret value for github with a libsodium sealed box
+// against the given public key.
+func encryptSecret(key *github.PublicKey, value string) (string, error) {
+	raw, err := base64.StdEncoding.DecodeString(key.GetKey())
+	if err != nil {
+		return "", fmt.Errorf("decode public key: %w", err)
+	}
+
+	if len(raw) != 32 {
+		return "", fmt.Errorf("public key is %d bytes, expected 32", len(raw))
+	}
+
+	var pk [32]byte
+	copy(pk[:], raw)
+
+	sealed, err := box.SealAnonymous(nil, []byte(value), &pk, rand.Reader)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// GetOrgSecrets lists an org's Actions secrets. Secret values can not be read
+// back.
+func (c *Client) GetOrgSecrets(ctx context.Context, org string) ([]*github.Secret, error) {
+	opts := &github.ListOptions{
+		Page:    0,
+		PerPage: 100,
+	}
+
+	var secrets []*github.Secret
+	for {
+		c.rate.Wait(ctx) //nolint: errcheck
+		ss, resp, err := c.ghClient.Actions.ListOrgSecrets(ctx, org, opts)
+		if err != nil {
+			if _, ok := err.(*github.RateLimitError); ok {
+				return nil, ErrRateLimited
+			}
+
+			if resp != nil && resp.StatusCode == http.StatusNotFound {
+				return nil, ErrOrgNotFound
+			}
+
+			return nil, wrapErr("list org secrets", org, err)
+		}
+
+		secrets = append(secrets, ss.Secrets...)
+
+		if resp.NextPage == 0 {
+			break
+		}
+
+		opts.Page = resp.NextPage
+	}
+
+	return secrets, nil
+}
+
+// ListOrgSecretRepos returns the names of the repos an org secret is visible to
+// when its visibility is selected.
+func (c *Client) ListOrgSecretRepos(ctx context.Context, org, name string) ([]string, error) {
+	opts := &github.ListOptions{
+		Page:    0,
+		PerPage: 100,
+	}
+
+	var names []string
+	for {
+		c.rate.Wait(ctx) //nolint: errcheck
+		rs, resp, err := c.ghClient.Actions.ListSelectedReposForOrgSecret(ctx, org, name, opts)
+		if err != nil {
+			if _, ok := err.(*github.RateLimitError); ok {
+				return nil, ErrRateLimited
+			}
+
+			if resp != nil && resp.StatusCode == http.StatusNotFound {
+				return nil, ErrOrgNotFound
+			}
+
+			return nil, wrapErr("list org secret "+name+" repos", org, err)
+		}
+
+		for _, r := range rs.Repositories {
+			names = append(names, r.GetName())
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+
+		opts.Page = resp.NextPage
+	}
+
+	return names, nil
+}
+
+// PutOrgSecret queues setting an org's Actions secret, visible to all repos,
+// private repos, or the selected repos. The value is never printed.
+func (c *Client) PutOrgSecret(ctx context.Context, org, name, value, visibility string, repos []string) {
+	scope := describeScope(visibility, repos)
+
+	cs := &report.ChangeSet{}
+	cs.Add("setting secret '"+name+"' visible to "+scope, "set secret '"+name+"' visible to "+scope)
+
+	cs.PrintPre()
+
+	c.Add(Change{Resource: "org secret", Action: "set", Org: org, Target: name}, func() error {
+		ids, err := c.repoIDs(ctx, org, visibility, repos)
+		if err != nil {
+			return err
+		}
+
+		c.rate.Wait(ctx) //nolint: errcheck
+		key, _, err := c.ghClient.Actions.GetOrgPublicKey(ctx, org)
+		if err != nil {
+			if _, ok := err.(*github.RateLimitError); ok {
+				return ErrRateLimited
+			}
+
+			return wrapErr("get org public key", org, err)
+		}
+
+		encrypted, err := encryptSecret(key, value)
+		if err != nil {
+			return fmt.Errorf("encrypt secret %s: %w", name, err)
+		}
+
+		c.rate.Wait(ctx) //nolint: errcheck
+		_, err = c.ghClient.Actions.CreateOrUpdateOrgSecret(ctx, org, &github.EncryptedSecret{
+			Name:                  name,
+			KeyID:                 key.GetKeyID(),
+			EncryptedValue:        encrypted,
+			Visibility:            visibility,
+			SelectedRepositoryIDs: ids,
+		})
+		if err != nil {
+			if _, ok := err.(*github.RateLimitError); ok {
+				return ErrRateLimited
+			}
+
+			return wrapErr("put org secret", org, err)
+		}
+
+		cs.PrintPost()
+
+		return nil
+	})
+}
+
+// GetOrgVariables lists an org's Actions variables.
+func (c *Client) GetOrgVariables(ctx context.Context, org string) ([]*github.ActionsVariable, error) {
+	opts := &github.ListOptions{
+		Page:    0,
+		PerPage: 100,
+	}
+
+	var vars []*github.ActionsVariable
+	for {
+		c.rate.Wait(ctx) //nolint: errcheck
+		vs, resp, err := c.ghClient.Actions.ListOrgVariables(ctx, org, opts)
+		if err != nil {
+			if _, ok := err.(*github.RateLimitError); ok {
+				return nil, ErrRateLimited
+			}
+
+			if resp != nil && resp.StatusCode == http.StatusNotFound {
+				return nil, ErrOrgNotFound
+			}
+
+			return nil, wrapErr("list org variables", org, err)
+		}
+
+		vars = append(vars, vs.Variables...)
+
+		if resp.NextPage == 0 {
+			break
+		}
+
+		opts.Page = resp.NextPage
+	}
+
+	return vars, nil
+}
+
+// ListOrgVariableRepos returns the names of the repos an org variable is visible to
+// when its visibility is selected.
+func (c *Client) ListOrgVariableRepos(ctx context.Context, org, name string) ([]string, error) {
+	opts := &github.ListOptions{
+		Page:    0,
+		PerPage: 100,
+	}
+
+	var names []string
+	for {
+		c.rate.Wait(ctx) //nolint: errcheck
+		rs, resp, err := c.ghClient.Actions.ListSelectedReposForOrgVariable(ctx, org, name, opts)
+		if err != nil {
+			if _, ok := err.(*github.RateLimitError); ok {
+				return nil, ErrRateLimited
+			}
+
+			if resp != nil && resp.StatusCode == http.StatusNotFound {
+				return nil, ErrOrgNotFound
+			}
+
+			return nil, wrapErr("list org variable "+name+" repos", org, err)
+		}
+
+		for _, r := range rs.Repositories {
+			names = append(names, r.GetName())
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+
+		opts.Page = resp.NextPage
+	}
+
+	return names, nil
+}
+
+// PutOrgVariable queues creating an org's Actions variable, or updating it
+// when it already exists. Values are masked in the output.
+func (c *Client) PutOrgVariable(ctx context.Context, org, name, value, visibility string, repos []string, exists bool) {
+	scope := describeScope(visibility, repos)
+
+	cs := &report.ChangeSet{}
+
+	action := "create"
+	if exists {
+		action = "update"
+		cs.Add("updating variable '"+name+"' visible to "+scope, "updated variable '"+name+"' visible to "+scope)
+	} else {
+		cs.Add("creating variable '"+name+"' visible to "+scope, "created variable '"+name+"' visible to "+scope)
+	}
+
+	cs.PrintPre()
+
+	c.Add(Change{Resource: "org variable", Action: action, Org: org, Target: name}, func() error {
+		ids, err := c.repoIDs(ctx, org, visibility, repos)
+		if err != nil {
+			return err
+		}
+
+		v := &github.ActionsVariable{
+			Name:       name,
+			Value:      value,
+			Visibility: github.String(visibility),
+		}
+
+		if visibility == "selected" {
+			v.SelectedRepositoryIDs = &ids
+		}
+
+		c.rate.Wait(ctx) //nolint: errcheck
+		if exists {
+			_, err = c.ghClient.Actions.UpdateOrgVariable(ctx, org, v)
+		} else {
+			_, err = c.ghClient.Actions.CreateOrgVariable(ctx, org, v)
+		}
+
+		if err != nil {
+			if _, ok := err.(*github.RateLimitError); ok {
+				return ErrRateLimited
+			}
+
+			return wrapErr(action+" org variable", org, err)
+		}
+
+		cs.PrintPost()
+
+		return nil
+	})
+}
+
+// repoIDs looks up the ids of the repos selected for an org secret or
+// variable. It runs at apply time so repos created in the same run resolve.
+func (c *Client) repoIDs(ctx context.Context, org, visibility string, repos []string) (github.SelectedRepoIDs, error) {
+	if visibility != "selected" {
+		return nil, nil
+	}
+
+	ids := github.SelectedRepoIDs{}
+	for _, r := range repos {
+		ghr, err := c.GetRepo(ctx, org, r)
+		if err != nil {
+			return nil, fmt.Errorf("selected repo %s: %w", r, err)
+		}
+
+		ids = append(ids, ghr.GetID())
+	}
+
+	return ids, nil
+}
+
+func describeScope(visibility string, repos []string) string {
+	switch visibility {
+	case "all":
+		return "all repos"
+	case "private":
+		return "private repos"
+	}
+
+	return "selected repos [" + strings.Join(repos, ", ") + "]"
+}
@@ -0,0 +1,143 @@
+package client
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gomicro/concord/report"
+	"github.com/google/go-github/v56/github"
+)
+
+func (c *Client) GetVulnerabilityAlerts(ctx context.Context, org, repo string) (bool, error) {
+	c.rate.Wait(ctx) //nolint: errcheck
+	enabled, resp, err := c.ghClient.Repositories.GetVulnerabilityAlerts(ctx, org, repo)
+	if err != nil {
+		if _, ok := err.(*github.RateLimitError); ok {
+			return false, ErrRateLimited
+		}
+
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return false, ErrRepoNotFound
+		}
+
+		return false, wrapErr("get vulnerability alerts", org+"/"+repo, err)
+	}
+
+	return enabled, nil
+}
+
+func (c *Client) SetVulnerabilityAlerts(ctx context.Context, org, repo string, enabled bool) {
+	c.setSecurityFeature(ctx, org, repo, "vulnerability alerts", enabled, func() (*github.Response, error) {
+		if enabled {
+			return c.ghClient.Repositories.EnableVulnerabilityAlerts(ctx, org, repo)
+		}
+
+		return c.ghClient.Repositories.DisableVulnerabilityAlerts(ctx, org, repo)
+	})
+}
+
+func (c *Client) GetAutomatedSecurityFixes(ctx context.Context, org, repo string) (bool, error) {
+	c.rate.Wait(ctx) //nolint: errcheck
+	fixes, resp, err := c.ghClient.Repositories.GetAutomatedSecurityFixes(ctx, org, repo)
+	if err != nil {
+		if _, ok := err.(*github.RateLimitError); ok {
+			return false, ErrRateLimited
+		}
+
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return false, ErrRepoNotFound
+		}
+
+		return false, wrapErr("get automated security fixes", org+"/"+repo, err)
+	}
+
+	return fixes.GetEnabled(), nil
+}
+
+func (c *Client) SetAutomatedSecurityFixes(ctx context.Context, org, repo string, enabled bool) {
+	c.setSecurityFeature(ctx, org, repo, "automated security fixes", enabled, func() (*github.Response, error) {
+		if enabled {
+			return c.ghClient.Repositories.EnableAutomatedSecurityFixes(ctx, org, repo)
+		}
+
+		return c.ghClient.Repositories.DisableAutomatedSecurityFixes(ctx, org, repo)
+	})
+}
+
+// GetSecretScanning reports whether secret scanning is enabled on a repo. It
+// is part of the repo returned by GetRepo, except for repos read over GraphQL,
+// which are read again over REST for it.
+func (c *Client) GetSecretScanning(ctx context.Context, org, repo string) (bool, error) {
+	r, err := c.GetRepo(ctx, org, repo)
+	if err != nil {
+		return false, err
+	}
+
+	if r.SecurityAndAnalysis == nil && c.graphql {
+		c.rate.Wait(ctx) //nolint: errcheck
+		var resp *github.Response
+		r, resp, err = c.ghClient.Repositories.Get(ctx, org, repo)
+		if err != nil {
+			if _, ok := err.(*github.RateLimitError); ok {
+				return false, ErrRateLimited
+			}
+
+			if resp != nil && resp.StatusCode == http.StatusNotFound {
+				return false, ErrRepoNotFound
+			}
+
+			return false, wrapErr("get secret scanning", org+"/"+repo, err)
+		}
+
+		c.cache.setRepo(org, repo, r)
+	}
+
+	return r.GetSecurityAndAnalysis().GetSecretScanning().GetStatus() == "enabled", nil
+}
+
+// SetSecretScanning queues turning secret scanning on or off. Its current
+// state is read with GetSecretScanning.
+func (c *Client) SetSecretScanning(ctx context.Context, org, repo string, enabled bool) {
+	status := "disabled"
+	if enabled {
+		status = "enabled"
+	}
+
+	c.setSecurityFeature(ctx, org, repo, "secret scanning", enabled, func() (*github.Response, error) {
+		_, resp, err := c.ghClient.Repositories.Edit(ctx, org, repo, &github.Repository{
+			SecurityAndAnalysis: &github.SecurityAndAnalysis{
+				SecretScanning: &github.SecretScanning{Status: github.String(status)},
+			},
+		})
+
+		return resp, err
+	})
+}
+
+func (c *Client) setSecurityFeature(ctx context.Context, org, repo, feature string, enabled bool, fn func() (*github.Response, error)) {
+	action := "disable"
+	if enabled {
+		action = "enable"
+	}
+
+	cs := &report.ChangeSet{}
+	cs.Add(action[:len(action)-1]+"ing "+feature, action+"d "+feature)
+
+	cs.PrintPre()
+
+	c.Add(Change{Resource: "repo security", Action: action, Org: org, Repo: repo, Target: feature}, func() error {
+		c.rate.Wait(ctx) //nolint: errcheck
+		_, err := fn()
+		if err != nil {
+			if _, ok := err.(*github.RateLimitError); ok {
+				return ErrRateLimited
+			}
+
+			return wrapErr("set "+feature, org+"/"+repo, err)
+		}
+
+		cs.PrintPost()
+
+		return nil
+	})
+}
@@ -0,0 +1,64 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/gomicro/concord/report"
+	"github.com/google/go-github/v56/github"
+)
+
+// GetRepoSubscription returns the authenticated user's subscription to the
+// repo. A nil subscription is returned when the user is not watching it.
+func (c *Client) GetRepoSubscription(ctx context.Context, org, repo string) (*github.Subscription, error) {
+	c.rate.Wait(ctx) //nolint: errcheck
+	sub, _, err := c.ghClient.Activity.GetRepositorySubscription(ctx, org, repo)
+	if err != nil {
+		if _, ok := err.(*github.RateLimitError); ok {
+			return nil, ErrRateLimited
+		}
+
+		return nil, wrapErr("get repo subscription", org+"/"+repo, err)
+	}
+
+	return sub, nil
+}
+
+// SetRepoWatched watches or unwatches the repo for the authenticated user.
+func (c *Client) SetRepoWatched(ctx context.Context, org, repo string, watch bool) {
+	cs := &report.ChangeSet{}
+	cs.Add(fmt.Sprintf("setting watch repo to '%t'", watch), fmt.Sprintf("set watch repo to '%t'", watch))
+
+	cs.PrintPre()
+
+	c.Add(Change{Resource: "repo subscription", Action: "set", Org: org, Repo: repo}, func() error {
+		c.rate.Wait(ctx) //nolint: errcheck
+
+		var resp *github.Response
+		var err error
+		if watch {
+			_, resp, err = c.ghClient.Activity.SetRepositorySubscription(ctx, org, repo, &github.Subscription{
+				Subscribed: github.Bool(true),
+			})
+		} else {
+			resp, err = c.ghClient.Activity.DeleteRepositorySubscription(ctx, org, repo)
+		}
+
+		if err != nil {
+			if _, ok := err.(*github.RateLimitError); ok {
+				return ErrRateLimited
+			}
+
+			if resp != nil && resp.StatusCode == http.StatusNotFound {
+				return ErrRepoNotFound
+			}
+
+			return wrapErr("set repo subscription", org+"/"+repo, err)
+		}
+
+		cs.PrintPost()
+
+		return nil
+	})
+}
@@ -0,0 +1,32 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestSetRepoWatchedOff(t *testing.T) {
+	var got []string
+
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = append(got, r.Method+" "+r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	c.SetRepoWatched(context.Background(), "acme", "widgets", false)
+
+	if len(got) != 0 {
+		t.Fatalf("made requests %v before applying", got)
+	}
+
+	err := c.Flush(context.Background())
+	if err != nil {
+		t.Fatalf("flush: %v", err)
+	}
+
+	want := "DELETE /api/v3/repos/acme/widgets/subscription"
+	if len(got) != 1 || got[0] != want {
+		t.Errorf("requests = %v, want [%s]", got, want)
+	}
+}
@@ -0,0 +1,76 @@
+package client
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gomicro/concord/report"
+	"github.com/google/go-github/v56/github"
+)
+
+// ListTagProtection lists the repo's protected tag patterns.
+func (c *Client) ListTagProtection(ctx context.Context, org, repo string) ([]*github.TagProtection, error) {
+	c.rate.Wait(ctx) //nolint: errcheck
+	tps, resp, err := c.ghClient.Repositories.ListTagProtection(ctx, org, repo)
+	if err != nil {
+		if _, ok := err.(*github.RateLimitError); ok {
+			return nil, ErrRateLimited
+		}
+
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return nil, ErrRepoNotFound
+		}
+
+		return nil, wrapErr("list tag protection", org+"/"+repo, err)
+	}
+
+	return tps, nil
+}
+
+func (c *Client) ProtectTag(ctx context.Context, org, repo, pattern string) {
+	cs := &report.ChangeSet{}
+	cs.Add("protecting tags '"+pattern+"'", "protected tags '"+pattern+"'")
+
+	cs.PrintPre()
+
+	c.Add(Change{Resource: "tag protection", Action: "create", Org: org, Repo: repo, Target: pattern}, func() error {
+		c.rate.Wait(ctx) //nolint: errcheck
+		_, _, err := c.ghClient.Repositories.CreateTagProtection(ctx, org, repo, pattern)
+		if err != nil {
+			if _, ok := err.(*github.RateLimitError); ok {
+				return ErrRateLimited
+			}
+
+			return wrapErr("create tag protection", org+"/"+repo, err)
+		}
+
+		cs.PrintPost()
+
+		return nil
+	})
+}
+
+func (c *Client) UnprotectTag(ctx context.Context, org, repo string, tp *github.TagProtection) {
+	pattern := tp.GetPattern()
+
+	cs := &report.ChangeSet{}
+	cs.Add("removing tag protection '"+pattern+"'", "removed tag protection '"+pattern+"'")
+
+	cs.PrintPre()
+
+	c.Add(Change{Resource: "tag protection", Action: "delete", Org: org, Repo: repo, Target: pattern}, func() error {
+		c.rate.Wait(ctx) //nolint: errcheck
+		_, err := c.ghClient.Repositories.DeleteTagProtection(ctx, org, repo, tp.GetID())
+		if err != nil {
+			if _, ok := err.(*github.RateLimitError); ok {
+				return ErrRateLimited
+			}
+
+			return wrapErr("delete tag protection", org+"/"+repo, err)
+		}
+
+		cs.PrintPost()
+
+		return nil
+	})
+}
@@ -2,32 +2,78 @@ package client
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
 
 	"github.com/gomicro/concord/report"
 	"github.com/google/go-github/v56/github"
 )
 
+var (
+	ErrTeamNotFound = errors.New("team not found")
+)
+
 func (c *Client) GetTeams(ctx context.Context, orgName string) ([]*github.Team, error) {
-	teams, _, err := c.ghClient.Teams.ListTeams(ctx, orgName, nil)
-	if err != nil {
-		if _, ok := err.(*github.RateLimitError); ok {
+	opts := &github.ListOptions{
+		Page:    0,
+		PerPage: 100,
+	}
+
+	var teams []*github.Team
+	for {
+		c.rate.Wait(ctx) //nolint: errcheck
+		ts, resp, err := c.ghClient.Teams.ListTeams(ctx, orgName, opts)
+		if err != nil {
+			if _, ok := err.(*github.RateLimitError); ok {
+				return nil, ErrRateLimited
+			}
+
 			return nil, err
 		}
 
-		return nil, err
+		teams = append(teams, ts...)
+
+		if resp.NextPage == 0 {
+			break
+		}
+
+		opts.Page = resp.NextPage
 	}
 
 	return teams, nil
 }
 
-func (c *Client) CreateTeam(ctx context.Context, orgName, teamName string) {
-	report.PrintAdd("create team " + teamName)
-	report.Println()
+// CreateTeam queues creating a team, nested under the parent team when one is
+// given. The parent is looked up when the change is applied, so it must be
+// created first.
+func (c *Client) CreateTeam(ctx context.Context, orgName, teamName, parent string) {
+	cs := &report.ChangeSet{}
+	if parent != "" {
+		cs.Add("create team "+teamName+" under "+parent, "created team "+teamName+" under "+parent)
+	} else {
+		cs.Add("create team "+teamName, "created team "+teamName)
+	}
 
-	c.Add(func() error {
-		team, _, err := c.ghClient.Teams.CreateTeam(ctx, orgName, github.NewTeam{
+	cs.PrintPre()
+
+	c.Add(Change{Resource: "team", Action: "create", Org: orgName, Target: teamName}, func() error {
+		nt := github.NewTeam{
 			Name: teamName,
-		})
+		}
+
+		if parent != "" {
+			id, err := c.teamID(ctx, orgName, parent)
+			if err != nil {
+				return err
+			}
+
+			nt.ParentTeamID = &id
+		}
+
+		c.rate.Wait(ctx) //nolint: errcheck
+		team, _, err := c.ghClient.Teams.CreateTeam(ctx, orgName, nt)
 		if err != nil {
 			if _, ok := err.(*github.RateLimitError); ok {
 				return err
@@ -46,7 +92,7 @@ func (c *Client) CreateTeam(ctx context.Context, orgName, teamName string) {
 			return err
 		}
 
-		err = c.RemoveTeamMember(ctx, team.GetOrganization().GetID(), team.GetID(), *user.Login)
+		err = c.RemoveTeamMember(ctx, team.GetOrganization().GetID(), team.GetID(), user.GetLogin())
 		if err != nil {
 			if _, ok := err.(*github.RateLimitError); ok {
 				return err
@@ -55,18 +101,36 @@ func (c *Client) CreateTeam(ctx context.Context, orgName, teamName string) {
 			return err
 		}
 
-		report.PrintSuccess("created team " + teamName)
-		report.Println()
+		cs.PrintPost()
 
 		return nil
 	})
 }
 
+// teamID finds the id of the org's team with the given name.
+func (c *Client) teamID(ctx context.Context, orgName, name string) (int64, error) {
+	teams, err := c.GetTeams(ctx, orgName)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, t := range teams {
+		if strings.EqualFold(t.GetName(), name) {
+			return t.GetID(), nil
+		}
+	}
+
+	return 0, fmt.Errorf("%w: %s", ErrTeamNotFound, name)
+}
+
 func (c *Client) InviteTeamMember(ctx context.Context, org, team, user string) {
-	report.PrintAdd("invite " + user + " to team " + team)
-	report.Println()
+	cs := &report.ChangeSet{}
+	cs.Add("invite "+user+" to team "+team, "invited "+user+" to team "+team)
 
-	c.Add(func() error {
+	cs.PrintPre()
+
+	c.Add(Change{Resource: "team member", Action: "invite", Org: org, Target: team + "/" + user}, func() error {
+		c.rate.Wait(ctx) //nolint: errcheck
 		_, _, err := c.ghClient.Teams.AddTeamMembershipBySlug(ctx, org, team, user, nil)
 		if err != nil {
 			if _, ok := err.(*github.RateLimitError); ok {
@@ -76,8 +140,7 @@ func (c *Client) InviteTeamMember(ctx context.Context, org, team, user string) {
 			return err
 		}
 
-		report.PrintSuccess("invited " + user + " to team " + team)
-		report.Println()
+		cs.PrintPost()
 
 		return nil
 	})
@@ -108,3 +171,90 @@ func (c *Client) GetTeamMembers(ctx context.Context, org, team string) ([]*githu
 
 	return members, nil
 }
+
+// GetIDPGroups lists the identity provider groups teams in the org can be
+// synced from. Team sync is only available to enterprise organizations.
+func (c *Client) GetIDPGroups(ctx context.Context, org string) ([]*github.IDPGroup, error) {
+	opts := &github.ListCursorOptions{
+		PerPage: 100,
+	}
+
+	var groups []*github.IDPGroup
+	for {
+		c.rate.Wait(ctx) //nolint: errcheck
+		gs, resp, err := c.ghClient.Teams.ListIDPGroupsInOrganization(ctx, org, opts)
+		if err != nil {
+			if _, ok := err.(*github.RateLimitError); ok {
+				return nil, ErrRateLimited
+			}
+
+			return nil, wrapErr("list idp groups", org, err)
+		}
+
+		groups = append(groups, gs.Groups...)
+
+		if resp.NextPageToken == "" {
+			break
+		}
+
+		opts.Page = resp.NextPageToken
+	}
+
+	return groups, nil
+}
+
+// GetTeamIDPGroups lists the identity provider groups a team's membership is
+// synced from.
+func (c *Client) GetTeamIDPGroups(ctx context.Context, org, team string) ([]*github.IDPGroup, error) {
+	c.rate.Wait(ctx) //nolint: errcheck
+	gs, resp, err := c.ghClient.Teams.ListIDPGroupsForTeamBySlug(ctx, org, team)
+	if err != nil {
+		if _, ok := err.(*github.RateLimitError); ok {
+			return nil, ErrRateLimited
+		}
+
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return nil, ErrTeamNotFound
+		}
+
+		return nil, wrapErr("list idp groups for team "+team, org, err)
+	}
+
+	return gs.Groups, nil
+}
+
+// SetTeamIDPGroup queues syncing a team's membership from an identity
+// provider group, replacing any groups it was synced from before. Current is
+// the names of those groups, and is nil for a team being created.
+func (c *Client) SetTeamIDPGroup(ctx context.Context, org, team string, current []string, group *github.IDPGroup) {
+	cs := &report.ChangeSet{}
+	if current == nil {
+		cs.Add("syncing team "+team+" from idp group '"+group.GetGroupName()+"'", "synced team "+team+" from idp group '"+group.GetGroupName()+"'")
+	} else {
+		cs.Add(fieldChange("idp groups", "["+strings.Join(current, ", ")+"]", "["+group.GetGroupName()+"]"))
+	}
+
+	cs.PrintPre()
+
+	c.Add(Change{Resource: "team idp group", Action: "set", Org: org, Target: team}, func() error {
+		c.rate.Wait(ctx) //nolint: errcheck
+		_, resp, err := c.ghClient.Teams.CreateOrUpdateIDPGroupConnectionsBySlug(ctx, org, team, github.IDPGroupList{
+			Groups: []*github.IDPGroup{group},
+		})
+		if err != nil {
+			if _, ok := err.(*github.RateLimitError); ok {
+				return ErrRateLimited
+			}
+
+			if resp != nil && resp.StatusCode == http.StatusNotFound {
+				return ErrTeamNotFound
+			}
+
+			return wrapErr("sync team "+team+" from idp group", org, err)
+		}
+
+		cs.PrintPost()
+
+		return nil
+	})
+}
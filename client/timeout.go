@@ -0,0 +1,79 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// ErrRequestTimeout marks a single call to github that took longer than the
+// request timeout, as opposed to the whole run running out of time.
+var ErrRequestTimeout = errors.New("github: request timed out")
+
+// timeoutTransport gives every request made through it a deadline of its own,
+// so one call that hangs fails by itself rather than stalling the whole run.
+// The deadline covers reading the response body too. A zero timeout leaves
+// requests with only the deadline of their context.
+type timeoutTransport struct {
+	next    http.RoundTripper
+	timeout time.Duration
+}
+
+func (t *timeoutTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.timeout <= 0 {
+		return t.next.RoundTrip(req)
+	}
+
+	ctx, cancel := context.WithTimeout(req.Context(), t.timeout)
+
+	resp, err := t.next.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+
+		return nil, t.timedOut(ctx, req, err)
+	}
+
+	resp.Body = &cancelBody{ReadCloser: resp.Body, cancel: cancel, t: t, ctx: ctx, req: req}
+
+	return resp, nil
+}
+
+// timedOut turns err into ErrRequestTimeout when it came from the request's
+// own deadline. The run's deadline or an interrupt are passed on as they are.
+func (t *timeoutTransport) timedOut(ctx context.Context, req *http.Request, err error) error {
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) && req.Context().Err() == nil {
+		return fmt.Errorf("%w after %s: %s %s", ErrRequestTimeout, t.timeout, req.Method, req.URL.Path)
+	}
+
+	return err
+}
+
+// cancelBody releases a request's deadline once its response body is closed,
+// reporting reads cut off by the deadline as timeouts.
+type cancelBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+
+	t   *timeoutTransport
+	ctx context.Context
+	req *http.Request
+}
+
+func (b *cancelBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	if err != nil && err != io.EOF {
+		err = b.t.timedOut(b.ctx, b.req, err)
+	}
+
+	return n, err
+}
+
+func (b *cancelBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+
+	return err
+}
@@ -0,0 +1,80 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+// slowHandler answers after delay, or gives up once the caller does. With
+// stallBody it sends the headers straight away and holds back the body.
+func slowHandler(delay time.Duration, stallBody bool) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if stallBody {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			w.(http.Flusher).Flush()
+		}
+
+		select {
+		case <-time.After(delay):
+		case <-r.Context().Done():
+			return
+		}
+
+		if !stallBody {
+			w.Header().Set("Content-Type", "application/json")
+		}
+
+		w.Write([]byte(`{"login":"acme"}`))
+	})
+}
+
+func TestRequestTimeout(t *testing.T) {
+	tests := []struct {
+		name      string
+		delay     time.Duration
+		stallBody bool
+		timeout   time.Duration
+		wantErr   error
+	}{
+		{name: "fast call", delay: 0, timeout: time.Second},
+		{name: "slow response", delay: 2 * time.Second, timeout: 50 * time.Millisecond, wantErr: ErrRequestTimeout},
+		{name: "slow body", delay: 2 * time.Second, stallBody: true, timeout: 50 * time.Millisecond, wantErr: ErrRequestTimeout},
+		{name: "no timeout", delay: 100 * time.Millisecond},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newTestClient(t, slowHandler(tt.delay, tt.stallBody), WithRequestTimeout(tt.timeout))
+
+			start := time.Now()
+			_, err := c.GetOrg(context.Background(), "acme")
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("err = %v, want %v", err, tt.wantErr)
+			}
+
+			if tt.wantErr != nil && time.Since(start) > time.Second {
+				t.Errorf("call took %s, the timeout did not cut it off", time.Since(start))
+			}
+		})
+	}
+}
+
+func TestRunDeadlineIsNotRequestTimeout(t *testing.T) {
+	c := newTestClient(t, slowHandler(2*time.Second, false), WithRequestTimeout(time.Minute))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err := c.GetOrg(ctx, "acme")
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("err = %v, want %v", err, context.DeadlineExceeded)
+	}
+
+	if errors.Is(err, ErrRequestTimeout) {
+		t.Errorf("run deadline reported as a request timeout: %v", err)
+	}
+}
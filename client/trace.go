@@ -0,0 +1,29 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gomicro/concord/report"
+)
+
+// traceTransport prints every API call made through it when output is
+// verbose.
+type traceTransport struct {
+	next http.RoundTripper
+}
+
+func (t *traceTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		report.PrintTrace(fmt.Sprintf("%s %s: %s", req.Method, req.URL, err))
+		return nil, err
+	}
+
+	report.PrintTrace(fmt.Sprintf("%s %s %d (%s)", req.Method, req.URL, resp.StatusCode, time.Since(start).Round(time.Millisecond)))
+
+	return resp, nil
+}
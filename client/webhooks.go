@@ -0,0 +1,354 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gomicro/concord/report"
+	"github.com/google/go-github/v56/github"
+	"golang.org/x/exp/slices"
+)
+
+// HookURL returns the url a webhook delivers to.
+func HookURL(h *github.Hook) string {
+	u, _ := h.Config["url"].(string)
+	return u
+}
+
+func hookContentType(h *github.Hook) string {
+	ct, _ := h.Config["content_type"].(string)
+	return ct
+}
+
+func (c *Client) ListRepoHooks(ctx context.Context, org, repo string) ([]*github.Hook, error) {
+	opts := &github.ListOptions{
+		Page:    0,
+		PerPage: 100,
+	}
+
+	var hooks []*github.Hook
+	for {
+		c.rate.Wait(ctx) //nolint: errcheck
+		hs, resp, err := c.ghClient.Repositories.ListHooks(ctx, org, repo, opts)
+		if err != nil {
+			if _, ok := err.(*github.RateLimitError); ok {
+				return nil, ErrRateLimited
+			}
+
+			if resp != nil && resp.StatusCode == http.StatusNotFound {
+				return nil, ErrRepoNotFound
+			}
+
+			return nil, wrapErr("list repo hooks", org+"/"+repo, err)
+		}
+
+		hooks = append(hooks, hs...)
+
+		if resp.NextPage == 0 {
+			break
+		}
+
+		opts.Page = resp.NextPage
+	}
+
+	return hooks, nil
+}
+
+func (c *Client) CreateRepoHook(ctx context.Context, org, repo string, hook *github.Hook) {
+	u := HookURL(hook)
+
+	cs := &report.ChangeSet{}
+	cs.Add("adding webhook '"+u+"' for ["+strings.Join(hook.Events, ", ")+"]", "added webhook '"+u+"' for ["+strings.Join(hook.Events, ", ")+"]")
+
+	cs.PrintPre()
+
+	c.Add(Change{Resource: "webhook", Action: "create", Org: org, Repo: repo, Target: u}, func() error {
+		c.rate.Wait(ctx) //nolint: errcheck
+		_, _, err := c.ghClient.Repositories.CreateHook(ctx, org, repo, hook)
+		if err != nil {
+			if _, ok := err.(*github.RateLimitError); ok {
+				return ErrRateLimited
+			}
+
+			return wrapErr("create repo hook", org+"/"+repo, err)
+		}
+
+		cs.PrintPost()
+
+		return nil
+	})
+}
+
+// EditRepoHook updates an existing webhook to the desired events, content
+// type, and active state. Nothing is queued when they already match. The
+// config is edited on its own so a secret is only ever replaced by one from
+// the manifest, and is never printed.
+func (c *Client) EditRepoHook(ctx context.Context, org, repo string, current, desired *github.Hook) {
+	u := HookURL(current)
+
+	cs := hookChanges(current, desired)
+
+	if !cs.HasChanges() {
+		report.PrintInfo("webhook '" + u + "' is up to date")
+		report.Println()
+
+		return
+	}
+
+	cs.PrintPre()
+
+	c.Add(Change{Resource: "webhook", Action: "update", Org: org, Repo: repo, Target: u}, func() error {
+		c.rate.Wait(ctx) //nolint: errcheck
+		hook, config := hookEdits(current, desired)
+
+		if hook != nil {
+			_, _, err := c.ghClient.Repositories.EditHook(ctx, org, repo, current.GetID(), hook)
+			if err != nil {
+				if _, ok := err.(*github.RateLimitError); ok {
+					return ErrRateLimited
+				}
+
+				return wrapErr("edit repo hook", org+"/"+repo, err)
+			}
+		}
+
+		if config != nil {
+			c.rate.Wait(ctx) //nolint: errcheck
+			_, _, err := c.ghClient.Repositories.EditHookConfiguration(ctx, org, repo, current.GetID(), config)
+			if err != nil {
+				if _, ok := err.(*github.RateLimitError); ok {
+					return ErrRateLimited
+				}
+
+				return wrapErr("edit repo hook config", org+"/"+repo, err)
+			}
+		}
+
+		cs.PrintPost()
+
+		return nil
+	})
+}
+
+func (c *Client) DeleteRepoHook(ctx context.Context, org, repo string, hook *github.Hook) {
+	u := HookURL(hook)
+
+	cs := &report.ChangeSet{}
+	cs.Add("removing webhook '"+u+"'", "removed webhook '"+u+"'")
+
+	cs.PrintPre()
+
+	c.Add(Change{Resource: "webhook", Action: "delete", Org: org, Repo: repo, Target: u}, func() error {
+		c.rate.Wait(ctx) //nolint: errcheck
+		_, err := c.ghClient.Repositories.DeleteHook(ctx, org, repo, hook.GetID())
+		if err != nil {
+			if _, ok := err.(*github.RateLimitError); ok {
+				return ErrRateLimited
+			}
+
+			return wrapErr("delete repo hook", org+"/"+repo, err)
+		}
+
+		cs.PrintPost()
+
+		return nil
+	})
+}
+
+// hookChanges lists the differences in events, content type, and active state
+// between a webhook and how it should be.
+func hookChanges(current, desired *github.Hook) *report.ChangeSet {
+	u := HookURL(current)
+
+	cs := &report.ChangeSet{}
+
+	ce := slices.Clone(current.Events)
+	slices.Sort(ce)
+
+	de := slices.Clone(desired.Events)
+	slices.Sort(de)
+
+	if !slices.Equal(ce, de) {
+		cs.Add("updating webhook '"+u+"' events to ["+strings.Join(de, ", ")+"]", "updated webhook '"+u+"' events to ["+strings.Join(de, ", ")+"]")
+	}
+
+	if hookContentType(current) != hookContentType(desired) {
+		cs.Add("updating webhook '"+u+"' content type to '"+hookContentType(desired)+"'", "updated webhook '"+u+"' content type to '"+hookContentType(desired)+"'")
+	}
+
+	if current.GetActive() != desired.GetActive() {
+		cs.Add(fmt.Sprintf("updating webhook '%s' active to '%t'", u, desired.GetActive()), fmt.Sprintf("updated webhook '%s' active to '%t'", u, desired.GetActive()))
+	}
+
+	return cs
+}
+
+// hookEdits splits the changes to a webhook into an edit of its events and
+// active state, and an edit of its config. Editing the hook itself replaces
+// the whole config and drops the secret, so the config only goes through the
+// config endpoint, which leaves out fields alone. The secret is only sent
+// when the manifest sets one. Either is nil when it has nothing to change.
+func hookEdits(current, desired *github.Hook) (*github.Hook, *github.HookConfig) {
+	var hook *github.Hook
+
+	ce := slices.Clone(current.Events)
+	slices.Sort(ce)
+
+	de := slices.Clone(desired.Events)
+	slices.Sort(de)
+
+	if !slices.Equal(ce, de) || current.GetActive() != desired.GetActive() {
+		hook = &github.Hook{
+			Events: desired.Events,
+			Active: desired.Active,
+		}
+	}
+
+	var config *github.HookConfig
+
+	if hookContentType(current) != hookContentType(desired) {
+		config = &github.HookConfig{
+			ContentType: github.String(hookContentType(desired)),
+		}
+
+		if secret, ok := desired.Config["secret"].(string); ok {
+			config.Secret = github.String(secret)
+		}
+	}
+
+	return hook, config
+}
+
+func (c *Client) ListOrgHooks(ctx context.Context, org string) ([]*github.Hook, error) {
+	opts := &github.ListOptions{
+		Page:    0,
+		PerPage: 100,
+	}
+
+	var hooks []*github.Hook
+	for {
+		c.rate.Wait(ctx) //nolint: errcheck
+		hs, resp, err := c.ghClient.Organizations.ListHooks(ctx, org, opts)
+		if err != nil {
+			if _, ok := err.(*github.RateLimitError); ok {
+				return nil, ErrRateLimited
+			}
+
+			if resp != nil && resp.StatusCode == http.StatusNotFound {
+				return nil, ErrOrgNotFound
+			}
+
+			return nil, wrapErr("list org hooks", org, err)
+		}
+
+		hooks = append(hooks, hs...)
+
+		if resp.NextPage == 0 {
+			break
+		}
+
+		opts.Page = resp.NextPage
+	}
+
+	return hooks, nil
+}
+
+func (c *Client) CreateOrgHook(ctx context.Context, org string, hook *github.Hook) {
+	u := HookURL(hook)
+
+	cs := &report.ChangeSet{}
+	cs.Add("adding org webhook '"+u+"' for ["+strings.Join(hook.Events, ", ")+"]", "added org webhook '"+u+"' for ["+strings.Join(hook.Events, ", ")+"]")
+
+	cs.PrintPre()
+
+	c.Add(Change{Resource: "org webhook", Action: "create", Org: org, Target: u}, func() error {
+		c.rate.Wait(ctx) //nolint: errcheck
+		_, _, err := c.ghClient.Organizations.CreateHook(ctx, org, hook)
+		if err != nil {
+			if _, ok := err.(*github.RateLimitError); ok {
+				return ErrRateLimited
+			}
+
+			return wrapErr("create org hook", org, err)
+		}
+
+		cs.PrintPost()
+
+		return nil
+	})
+}
+
+// EditOrgHook is EditRepoHook for a webhook on the org itself.
+func (c *Client) EditOrgHook(ctx context.Context, org string, current, desired *github.Hook) {
+	u := HookURL(current)
+
+	cs := hookChanges(current, desired)
+
+	if !cs.HasChanges() {
+		report.PrintInfo("org webhook '" + u + "' is up to date")
+		report.Println()
+
+		return
+	}
+
+	cs.PrintPre()
+
+	c.Add(Change{Resource: "org webhook", Action: "update", Org: org, Target: u}, func() error {
+		c.rate.Wait(ctx) //nolint: errcheck
+		hook, config := hookEdits(current, desired)
+
+		if hook != nil {
+			_, _, err := c.ghClient.Organizations.EditHook(ctx, org, current.GetID(), hook)
+			if err != nil {
+				if _, ok := err.(*github.RateLimitError); ok {
+					return ErrRateLimited
+				}
+
+				return wrapErr("edit org hook", org, err)
+			}
+		}
+
+		if config != nil {
+			c.rate.Wait(ctx) //nolint: errcheck
+			_, _, err := c.ghClient.Organizations.EditHookConfiguration(ctx, org, current.GetID(), config)
+			if err != nil {
+				if _, ok := err.(*github.RateLimitError); ok {
+					return ErrRateLimited
+				}
+
+				return wrapErr("edit org hook config", org, err)
+			}
+		}
+
+		cs.PrintPost()
+
+		return nil
+	})
+}
+
+func (c *Client) DeleteOrgHook(ctx context.Context, org string, hook *github.Hook) {
+	u := HookURL(hook)
+
+	cs := &report.ChangeSet{}
+	cs.Add("removing org webhook '"+u+"'", "removed org webhook '"+u+"'")
+
+	cs.PrintPre()
+
+	c.Add(Change{Resource: "org webhook", Action: "delete", Org: org, Target: u}, func() error {
+		c.rate.Wait(ctx) //nolint: errcheck
+		_, err := c.ghClient.Organizations.DeleteHook(ctx, org, hook.GetID())
+		if err != nil {
+			if _, ok := err.(*github.RateLimitError); ok {
+				return ErrRateLimited
+			}
+
+			return wrapErr("delete org hook", org, err)
+		}
+
+		cs.PrintPost()
+
+		return nil
+	})
+}
@@ -0,0 +1,86 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/google/go-github/v56/github"
+)
+
+func TestHookEdits(t *testing.T) {
+	current := &github.Hook{
+		Events: []string{"push", "pull_request"},
+		Active: github.Bool(true),
+		Config: map[string]interface{}{
+			"url":          "https://example.com/hook",
+			"content_type": "json",
+			"secret":       "********",
+		},
+	}
+
+	tests := []struct {
+		name       string
+		desired    *github.Hook
+		hook       bool
+		config     bool
+		secretSent bool
+	}{
+		{
+			name: "events only leaves the config alone",
+			desired: &github.Hook{
+				Events: []string{"push"},
+				Active: github.Bool(true),
+				Config: map[string]interface{}{"url": "https://example.com/hook", "content_type": "json"},
+			},
+			hook: true,
+		},
+		{
+			name: "content type without a secret keeps the secret",
+			desired: &github.Hook{
+				Events: []string{"pull_request", "push"},
+				Active: github.Bool(true),
+				Config: map[string]interface{}{"url": "https://example.com/hook", "content_type": "form"},
+			},
+			config: true,
+		},
+		{
+			name: "content type with a secret sends it",
+			desired: &github.Hook{
+				Events: []string{"pull_request", "push"},
+				Active: github.Bool(true),
+				Config: map[string]interface{}{"url": "https://example.com/hook", "content_type": "form", "secret": "s3cret"},
+			},
+			config:     true,
+			secretSent: true,
+		},
+		{
+			name: "nothing changed",
+			desired: &github.Hook{
+				Events: []string{"pull_request", "push"},
+				Active: github.Bool(true),
+				Config: map[string]interface{}{"url": "https://example.com/hook", "content_type": "json", "secret": "s3cret"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hook, config := hookEdits(current, tt.desired)
+
+			if (hook != nil) != tt.hook {
+				t.Fatalf("hook edit = %v, want %v", hook != nil, tt.hook)
+			}
+
+			if hook != nil && hook.Config != nil {
+				t.Errorf("hook edit sends a config, which would drop the secret: %v", hook.Config)
+			}
+
+			if (config != nil) != tt.config {
+				t.Fatalf("config edit = %v, want %v", config != nil, tt.config)
+			}
+
+			if config != nil && (config.Secret != nil) != tt.secretSent {
+				t.Errorf("secret sent = %v, want %v", config.Secret != nil, tt.secretSent)
+			}
+		})
+	}
+}
@@ -1,10 +1,13 @@
 package cmd
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	"io"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/gomicro/concord/client"
 	"github.com/gomicro/concord/manifest"
@@ -14,82 +17,261 @@ import (
 
 var applyCmd = NewApplyCmd(os.Stdout)
 
+// requestsPerChange is roughly the most requests a single queued change
+// makes, such as fetching a public key before setting a secret.
+const requestsPerChange = 3
+
+// verifyAttempts is how many times --verify compares against github after
+// applying, waiting verifyBackoff longer each time, before giving up.
+const (
+	verifyAttempts = 3
+	verifyBackoff  = 2 * time.Second
+)
+
 func init() {
 	rootCmd.AddCommand(applyCmd)
 }
 
 func NewApplyCmd(out io.Writer) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "apply",
-		Short: "Apply an org configuration",
-		Long:  `Apply an org configuration against github`,
-		RunE:  applyRun,
+		Use:               "apply",
+		Short:             "Apply an org configuration",
+		Long:              `Apply an org configuration against github`,
+		PersistentPreRunE: setupClient,
+		RunE:              applyRun,
 	}
 
+	cmd.Flags().String("plan", "", "apply a plan written by the plan command instead of a manifest, failing if github has changed since")
+
+	cmd.Flags().StringArray("target", nil, "only reconcile the resource addressed as org, member/<login>, team/<name>, or repo/<name>, can be repeated")
+
+	cmd.Flags().Bool("verify", false, "after applying, compare the manifest against github again and report any changes that did not take effect")
+
+	cmd.Flags().Bool("report-unmanaged", false, "list the repos, teams, members, and protected branches in github that are not in the manifest")
+
 	cmd.SetOut(out)
 
 	return cmd
 }
 
 func applyRun(cmd *cobra.Command, args []string) error {
-	file := cmd.Flags().Lookup("file").Value.String()
-	cmd.SetContext(manifest.WithManifest(cmd.Context(), file))
+	var p *plan
 
-	dry := strings.EqualFold(cmd.Flags().Lookup("dry").Value.String(), "true")
+	planFile := cmd.Flags().Lookup("plan").Value.String()
+	if planFile != "" {
+		var err error
+		p, err = readPlan(planFile)
+		if err != nil {
+			return handleError(cmd, err)
+		}
 
-	ctx := cmd.Context()
+		// prune changes what is planned, so apply the plan the way it was made
+		if p.Prune {
+			cmd.Flags().Set("prune", "true") //nolint: errcheck
+		}
 
-	org, err := manifest.OrgFromContext(ctx)
-	if err != nil {
-		return handleError(cmd, err)
+		cmd.SetContext(manifest.WithOrg(cmd.Context(), p.org))
+	} else {
+		file := cmd.Flags().Lookup("file").Value.String()
+		ctx, err := manifest.WithManifest(cmd.Context(), file)
+		if err != nil {
+			return handleError(cmd, err)
+		}
+
+		cmd.SetContext(ctx)
 	}
 
+	ctx := cmd.Context()
+	dry := dryRun(cmd)
+
 	clt, err := client.ClientFromContext(ctx)
 	if err != nil {
 		return handleError(cmd, err)
 	}
 
-	exists, err := clt.OrgExists(ctx, org.Name)
-	if err != nil {
-		return handleError(cmd, err)
+	reposErr := reconcile(cmd, args)
+	if reposErr != nil && !errors.Is(reposErr, errReposFailed) {
+		return handleError(cmd, reposErr)
+	}
+
+	if strings.EqualFold(cmd.Flags().Lookup("report-unmanaged").Value.String(), "true") {
+		err = reportUnmanaged(cmd)
+		if err != nil {
+			return handleError(cmd, err)
+		}
+	}
+
+	if p != nil {
+		err = p.verify(clt.Pending())
+		if err != nil {
+			return handleError(cmd, err)
+		}
+	}
+
+	if !dry {
+		err = checkRateBudget(ctx, clt)
+		if err != nil {
+			return handleError(cmd, err)
+		}
+	}
+
+	if dry {
+		err = clt.RecordDryRun()
+		if err != nil {
+			return handleError(cmd, err)
+		}
+	}
+
+	if !dry {
+		if !confirmDestructive(cmd, clt.Pending()) {
+			return handleError(cmd, reposErr)
+		}
+
+		err = clt.Flush(ctx)
+		if err != nil {
+			return handleError(cmd, errors.Join(reposErr, err))
+		}
 	}
 
-	if !exists {
-		return handleError(cmd, errors.New("organization does not exist"))
+	report.PrintSummary(dry)
+
+	if !dry && strings.EqualFold(cmd.Flags().Lookup("verify").Value.String(), "true") {
+		err = verifyApplied(cmd, args, clt)
+		if err != nil {
+			return handleError(cmd, errors.Join(reposErr, err))
+		}
 	}
 
-	report.PrintHeader("Org")
+	return handleError(cmd, reposErr)
+}
+
+// verifyApplied reconciles the manifest against github again after applying,
+// quietly, and reports the changes still needed. Github is eventually
+// consistent, so it retries a few times before deciding they did not apply.
+func verifyApplied(cmd *cobra.Command, args []string, clt client.GitHubClient) error {
+	ctx := cmd.Context()
+
+	report.Println()
+	report.PrintHeader("Verifying")
 	report.Println()
 
-	err = orgRun(cmd, args)
-	if err != nil {
-		return handleError(cmd, err)
+	var pending []client.Change
+	for attempt := 1; attempt <= verifyAttempts; attempt++ {
+		clt.Reset()
+
+		report.SetOutput(io.Discard)
+		err := reconcile(cmd, args)
+		report.SetOutput(cmd.OutOrStdout())
+
+		pending = clt.Pending()
+		clt.Reset()
+
+		if err != nil && !errors.Is(err, errReposFailed) {
+			return err
+		}
+
+		if len(pending) == 0 {
+			report.PrintSuccess("github matches the manifest")
+			report.Println()
+
+			return nil
+		}
+
+		if attempt == verifyAttempts {
+			break
+		}
+
+		report.PrintTrace(fmt.Sprintf("%d changes still pending, checking again", len(pending)))
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(time.Duration(attempt) * verifyBackoff):
+		}
 	}
 
-	err = membersRun(cmd, args)
-	if err != nil {
-		return handleError(cmd, err)
+	for _, c := range pending {
+		report.PrintError("did not take effect: " + describeChange(c))
+		report.Println()
 	}
 
-	err = teamsRun(cmd, args)
+	return fmt.Errorf("%d changes did not take effect", len(pending))
+}
+
+// reconcile compares the whole manifest in the command's context against
+// github and queues the changes on the client without applying them. Failed
+// repos are returned as errReposFailed so the rest can still be applied.
+func reconcile(cmd *cobra.Command, args []string) error {
+	orgs, err := manifest.OrgsFromContext(cmd.Context())
 	if err != nil {
-		return handleError(cmd, err)
+		return err
 	}
 
-	err = reposRun(cmd, args)
+	err = validateTargets(cmd, orgs)
 	if err != nil {
-		return handleError(cmd, err)
+		return err
 	}
 
-	if !dry {
-		if !confirm(cmd, "Apply changes? (y/n): ") {
-			return nil
+	sections := []struct {
+		kind string
+		run  func(*cobra.Command, []string) error
+	}{
+		{"org", orgRun},
+		{"member", membersRun},
+		{"team", teamsRun},
+		{"repo", reposRun},
+	}
+
+	return eachOrg(cmd, func() error {
+		for _, s := range sections {
+			names, targeted := targetsFor(cmd, s.kind)
+			if targeted && len(names) == 0 {
+				report.Println()
+				report.PrintInfo("skipping " + s.kind + " settings, none were targeted")
+				report.Println()
+
+				continue
+			}
+
+			// repos already reconcile only the names they are given
+			sargs := args
+			if s.kind == "repo" && targeted {
+				sargs = names
+			}
+
+			err := s.run(cmd, sargs)
+			if err != nil {
+				return err
+			}
 		}
 
-		err = clt.Apply()
-		if err != nil {
-			return handleError(cmd, err)
+		return nil
+	})
+}
+
+// checkRateBudget warns before applying when the remaining API budget looks
+// too small for the queued changes, which take up to a few requests each.
+func checkRateBudget(ctx context.Context, clt client.GitHubClient) error {
+	changes := len(clt.Pending())
+	if changes == 0 {
+		return nil
+	}
+
+	status, err := clt.RateStatus(ctx)
+	if err != nil {
+		if errors.Is(err, client.ErrRateLimitDisabled) {
+			return nil
 		}
+
+		return err
+	}
+
+	report.PrintTrace(fmt.Sprintf("%d of %d requests remaining until %s", status.Remaining, status.Limit, status.Reset.Format(time.Kitchen)))
+
+	if status.Remaining < changes*requestsPerChange {
+		report.Println()
+		report.PrintWarn(fmt.Sprintf("%d changes may need more than the %d requests remaining, the limit resets at %s", changes, status.Remaining, status.Reset.Format(time.Kitchen)))
+		report.Println()
 	}
 
 	return nil
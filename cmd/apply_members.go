@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"errors"
+	"fmt"
 	"io"
 	"os"
 	"strings"
@@ -12,6 +13,7 @@ import (
 	"github.com/gomicro/concord/report"
 	"github.com/google/go-github/v56/github"
 	"github.com/spf13/cobra"
+	"golang.org/x/exp/slices"
 )
 
 func init() {
@@ -33,56 +35,55 @@ func NewApplyMembersCmd(out io.Writer) *cobra.Command {
 
 func applyMembersRun(cmd *cobra.Command, args []string) error {
 	file := cmd.Flags().Lookup("file").Value.String()
-	cmd.SetContext(manifest.WithManifest(cmd.Context(), file))
-
-	dry := strings.EqualFold(cmd.Flags().Lookup("dry").Value.String(), "true")
-
-	ctx := cmd.Context()
-
-	org, err := manifest.OrgFromContext(ctx)
+	ctx, err := manifest.WithManifest(cmd.Context(), file)
 	if err != nil {
 		return handleError(cmd, err)
 	}
 
+	cmd.SetContext(ctx)
+
+	dry := dryRun(cmd)
+
 	clt, err := client.ClientFromContext(ctx)
 	if err != nil {
 		return handleError(cmd, err)
 	}
 
-	exists, err := clt.OrgExists(ctx, org.Name)
+	err = eachOrg(cmd, func() error { return membersRun(cmd, args) })
 	if err != nil {
 		return handleError(cmd, err)
 	}
 
-	if !exists {
-		return handleError(cmd, errors.New("organization does not exist"))
-	}
-
-	report.PrintHeader("Org")
-	report.Println()
-
-	err = membersRun(cmd, args)
-	if err != nil {
-		return handleError(cmd, err)
+	if dry {
+		err = clt.RecordDryRun()
+		if err != nil {
+			return handleError(cmd, err)
+		}
 	}
 
 	if !dry {
-		if !confirm(cmd, "Apply changes? (y/n): ") {
+		if !confirmDestructive(cmd, clt.Pending()) {
 			return nil
 		}
 
-		err = clt.Apply()
+		err = clt.Flush(ctx)
 		if err != nil {
 			return handleError(cmd, err)
 		}
 	}
 
+	report.PrintSummary(dry)
+
 	return nil
 }
 
 func membersRun(cmd *cobra.Command, args []string) error {
 	ctx := cmd.Context()
 
+	if !manages(ctx, "members") {
+		return nil
+	}
+
 	org, err := manifest.OrgFromContext(ctx)
 	if err != nil {
 		return handleError(cmd, err)
@@ -102,12 +103,61 @@ func membersRun(cmd *cobra.Command, args []string) error {
 		return handleError(cmd, err)
 	}
 
+	// without owner access invites go out as they did before invitations
+	// were checked, and none are cancelled
+	invites, err := clt.ListPendingInvitations(ctx, org.Name)
+	if err != nil {
+		if !errors.Is(err, client.ErrInvitationsNotReadable) {
+			return handleError(cmd, err)
+		}
+
+		report.PrintWarn("can not read pending invitations, inviting missing members without checking for them")
+		report.Println()
+	}
+
 	missing, managed, unmanaged := getMemberBreakdown(org.People, ms)
 
+	// only the targeted members are looked at, the rest are neither
+	// reported nor pruned
+	names, targeted := targetsFor(cmd, "member")
+	if targeted {
+		missing = onlyTargeted(missing, names)
+		managed = onlyTargeted(managed, names)
+		unmanaged = nil
+	}
+
+	invited := 0
 	for _, m := range missing {
+		if hasPendingInvite(invites, m) {
+			report.PrintInfo(m + " already invited")
+			report.Println()
+
+			invited++
+
+			continue
+		}
+
 		clt.InviteMember(ctx, org.Name, m)
 	}
 
+	stale := 0
+	for _, i := range invites {
+		if targeted || i.GetLogin() == "" || slices.ContainsFunc(org.People, func(p *gh_pb.People) bool {
+			return strings.EqualFold(p.Username, i.GetLogin())
+		}) {
+			continue
+		}
+
+		stale++
+
+		if prune(cmd) {
+			clt.CancelInvitation(ctx, org.Name, i)
+		} else {
+			report.PrintWarn(i.GetLogin() + " has a pending invitation but is not in manifest")
+			report.Println()
+		}
+	}
+
 	for _, m := range managed {
 		report.PrintInfo(m + " exists in github")
 		report.Println()
@@ -118,15 +168,43 @@ func membersRun(cmd *cobra.Command, args []string) error {
 		report.Println()
 	}
 
+	report.Count("Members", report.Invited, len(missing)-invited)
+	report.Count("Members", report.Unchanged, len(managed)+invited)
+	report.Count("Members", report.Unmanaged, len(unmanaged))
+
+	if prune(cmd) {
+		report.Count("Members", report.Removed, stale)
+	}
+
 	return nil
 }
 
+func hasPendingInvite(invites []*github.Invitation, username string) bool {
+	for _, i := range invites {
+		if strings.EqualFold(i.GetLogin(), username) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// getMemberBreakdown splits the manifest's people and the org's members into
+// those missing from github, those in both, and those only in github. Members
+// github returns without a login can not be matched and are skipped.
 func getMemberBreakdown(people []*gh_pb.People, members []*github.User) (missing []string, managed []string, unmanaged []string) {
 	for _, m := range members {
+		if m.GetLogin() == "" {
+			report.PrintWarn(fmt.Sprintf("skipping member %d, github returned no login for it", m.GetID()))
+			report.Println()
+
+			continue
+		}
+
 		if managedMember(people, m) {
-			managed = append(managed, *m.Login)
+			managed = append(managed, m.GetLogin())
 		} else {
-			unmanaged = append(unmanaged, *m.Login)
+			unmanaged = append(unmanaged, m.GetLogin())
 		}
 	}
 
@@ -149,7 +227,7 @@ func getMemberBreakdown(people []*gh_pb.People, members []*github.User) (missing
 
 func managedMember(manifestMembers []*gh_pb.People, member *github.User) bool {
 	for _, mm := range manifestMembers {
-		if strings.EqualFold(mm.Username, *member.Login) {
+		if strings.EqualFold(mm.Username, member.GetLogin()) {
 			return true
 		}
 	}
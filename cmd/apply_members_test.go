@@ -0,0 +1,194 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/gomicro/concord/client"
+	"github.com/gomicro/concord/client/fakeclient"
+	gh_pb "github.com/gomicro/concord/github/v1"
+	"github.com/google/go-github/v56/github"
+	"github.com/spf13/cobra"
+)
+
+func TestMembersRun(t *testing.T) {
+	fc := fakeclient.New()
+	fc.Members = []*github.User{
+		{Login: github.String("octocat")},
+		{Login: github.String("hubot")},
+	}
+	fc.Invitations = []*github.Invitation{
+		{Login: github.String("monalisa")},
+	}
+
+	org := &gh_pb.Organization{
+		Name: "acme",
+		People: []*gh_pb.People{
+			{Name: "The Octocat", Username: "OctoCat"},
+			{Name: "Mona Lisa", Username: "monalisa"},
+			{Name: "New Hire", Username: "newhire"},
+		},
+	}
+
+	out, err := runFake(t, fc, org, membersRun)
+	if err != nil {
+		t.Fatalf("members run: %v", err)
+	}
+
+	invites := fc.CallsTo("InviteMember")
+	if len(invites) != 1 || invites[0].Args[0] != "newhire" {
+		t.Errorf("invited %v, want only newhire", invites)
+	}
+
+	if len(fc.Calls) != 1 {
+		t.Errorf("made writes %v, want only the invite", fc.Methods())
+	}
+
+	for _, want := range []string{"monalisa already invited", "hubot exists in github but not in manifest"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output is missing %q:\n%s", want, out)
+		}
+	}
+}
+
+func TestMembersRunPrunesStaleInvitations(t *testing.T) {
+	fc := fakeclient.New()
+	fc.Invitations = []*github.Invitation{
+		{Login: github.String("leaver")},
+	}
+
+	org := &gh_pb.Organization{Name: "acme"}
+
+	_, err := runFake(t, fc, org, membersRun, "--prune")
+	if err != nil {
+		t.Fatalf("members run: %v", err)
+	}
+
+	cancels := fc.CallsTo("CancelInvitation")
+	if len(cancels) != 1 {
+		t.Fatalf("cancelled %d invitations, want 1", len(cancels))
+	}
+}
+
+func TestMembersRunWithoutInvitationAccess(t *testing.T) {
+	fc := fakeclient.New()
+	fc.Invitations = []*github.Invitation{
+		{Login: github.String("leaver")},
+	}
+	fc.Errs = map[string]error{
+		"ListPendingInvitations": client.ErrInvitationsNotReadable,
+	}
+
+	org := &gh_pb.Organization{
+		Name: "acme",
+		People: []*gh_pb.People{
+			{Username: "newhire"},
+		},
+	}
+
+	out, err := runFake(t, fc, org, membersRun, "--prune")
+	if err != nil {
+		t.Fatalf("members run: %v", err)
+	}
+
+	if !strings.Contains(out, "can not read pending invitations") {
+		t.Errorf("output is missing the warning:\n%s", out)
+	}
+
+	if got := fc.Methods(); len(got) != 1 || got[0] != "InviteMember" {
+		t.Errorf("made writes %v, want only the invite", got)
+	}
+}
+
+func TestMembersRunInvitesByUsername(t *testing.T) {
+	captureReport(t)
+
+	var invitee map[string]interface{}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v3/orgs/acme/members", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[]`)) //nolint: errcheck
+	})
+	mux.HandleFunc("/api/v3/orgs/acme/invitations", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			w.Write([]byte(`[]`)) //nolint: errcheck
+			return
+		}
+
+		json.NewDecoder(r.Body).Decode(&invitee) //nolint: errcheck
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"id": 1}`)) //nolint: errcheck
+	})
+	mux.HandleFunc("/api/v3/users/monalisa", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"id": 7, "login": "monalisa", "name": "Mona Lisa Octocat"}`)) //nolint: errcheck
+	})
+
+	ctx := testCtx(t, mux, "organization:\n  name: acme\n  people:\n    - name: Mona Lisa Octocat\n      username: monalisa\n")
+
+	err := executeTestCmd(t, ctx, &cobra.Command{Use: "run", RunE: membersRun})
+	if err != nil {
+		t.Fatalf("members run: %v", err)
+	}
+
+	clt, _ := client.ClientFromContext(ctx)
+
+	err = clt.Flush(context.Background())
+	if err != nil {
+		t.Fatalf("flush: %v", err)
+	}
+
+	if invitee["invitee_id"] != float64(7) {
+		t.Errorf("invited %v, want monalisa by username", invitee)
+	}
+}
+
+func TestGetMemberBreakdownNilLogin(t *testing.T) {
+	out := captureReport(t)
+
+	people := []*gh_pb.People{{Username: "octocat"}, {Username: "newhire"}}
+	members := []*github.User{
+		{ID: github.Int64(42)},
+		{Login: github.String("octocat")},
+		{Login: github.String("hubot")},
+	}
+
+	missing, managed, unmanaged := getMemberBreakdown(people, members)
+
+	if strings.Join(missing, ",") != "newhire" {
+		t.Errorf("missing = %v, want [newhire]", missing)
+	}
+
+	if strings.Join(managed, ",") != "octocat" {
+		t.Errorf("managed = %v, want [octocat]", managed)
+	}
+
+	if strings.Join(unmanaged, ",") != "hubot" {
+		t.Errorf("unmanaged = %v, want [hubot]", unmanaged)
+	}
+
+	if !strings.Contains(out.String(), "skipping member 42, github returned no login for it") {
+		t.Errorf("no warning for the member without a login:\n%s", out)
+	}
+}
+
+func TestMembersRunNilLogin(t *testing.T) {
+	fc := fakeclient.New()
+	fc.Members = []*github.User{{}, {Login: github.String("octocat")}}
+
+	org := &gh_pb.Organization{
+		Name:   "acme",
+		People: []*gh_pb.People{{Username: "octocat"}},
+	}
+
+	_, err := runFake(t, fc, org, membersRun)
+	if err != nil {
+		t.Fatalf("members run: %v", err)
+	}
+
+	if len(fc.Calls) != 0 {
+		t.Errorf("made writes %v, want none", fc.Methods())
+	}
+}
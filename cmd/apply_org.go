@@ -1,7 +1,7 @@
 package cmd
 
 import (
-	"errors"
+	"context"
 	"io"
 	"os"
 	"strings"
@@ -12,6 +12,7 @@ import (
 	"github.com/gomicro/concord/report"
 	"github.com/google/go-github/v56/github"
 	"github.com/spf13/cobra"
+	"golang.org/x/exp/slices"
 )
 
 func init() {
@@ -33,10 +34,49 @@ func NewApplyOrgCmd(out io.Writer) *cobra.Command {
 
 func applyOrgRun(cmd *cobra.Command, args []string) error {
 	file := cmd.Flags().Lookup("file").Value.String()
-	cmd.SetContext(manifest.WithManifest(cmd.Context(), file))
+	ctx, err := manifest.WithManifest(cmd.Context(), file)
+	if err != nil {
+		return handleError(cmd, err)
+	}
+
+	cmd.SetContext(ctx)
+
+	dry := dryRun(cmd)
+
+	clt, err := client.ClientFromContext(ctx)
+	if err != nil {
+		return handleError(cmd, err)
+	}
+
+	err = eachOrg(cmd, func() error { return orgRun(cmd, args) })
+	if err != nil {
+		return handleError(cmd, err)
+	}
+
+	if dry {
+		err = clt.RecordDryRun()
+		if err != nil {
+			return handleError(cmd, err)
+		}
+	}
+
+	if !dry {
+		if !confirmDestructive(cmd, clt.Pending()) {
+			return nil
+		}
 
-	dry := strings.EqualFold(cmd.Flags().Lookup("dry").Value.String(), "true")
+		err = clt.Flush(ctx)
+		if err != nil {
+			return handleError(cmd, err)
+		}
+	}
 
+	report.PrintSummary(dry)
+
+	return nil
+}
+
+func orgRun(cmd *cobra.Command, args []string) error {
 	ctx := cmd.Context()
 
 	org, err := manifest.OrgFromContext(ctx)
@@ -49,62 +89,341 @@ func applyOrgRun(cmd *cobra.Command, args []string) error {
 		return handleError(cmd, err)
 	}
 
-	exists, err := clt.OrgExists(ctx, org.Name)
+	if org.Profile != nil && manages(ctx, "org.profile") {
+		report.Println()
+		report.PrintHeader("Profile")
+		report.Println()
+
+		planned := report.Planned()
+
+		err = clt.UpdateOrg(ctx, org.Name, buildOrgProfile(org.Profile))
+		if err != nil {
+			return handleError(cmd, err)
+		}
+
+		if report.Planned() > planned {
+			report.Count("Profile", report.Updated, 1)
+		} else {
+			report.Count("Profile", report.Unchanged, 1)
+		}
+	}
+
+	if org.Permissions != nil && manages(ctx, "org.permissions") {
+		report.Println()
+		report.PrintHeader("Permissions")
+		report.Println()
+
+		planned := report.Planned()
+
+		err = clt.UpdateOrg(ctx, org.Name, buildOrgState(org))
+		if err != nil {
+			return handleError(cmd, err)
+		}
+
+		if report.Planned() > planned {
+			report.Count("Permissions", report.Updated, 1)
+		} else {
+			report.Count("Permissions", report.Unchanged, 1)
+		}
+	}
+
+	err = ensureOrgSecrets(ctx, clt, org)
 	if err != nil {
 		return handleError(cmd, err)
 	}
 
-	if !exists {
-		return handleError(cmd, errors.New("organization does not exist"))
+	err = ensureOrgVariables(ctx, clt, org)
+	if err != nil {
+		return handleError(cmd, err)
 	}
 
-	report.PrintHeader("Org")
-	report.Println()
+	err = ensureOrgWebhooks(ctx, clt, org, prune(cmd))
+	if err != nil {
+		return handleError(cmd, err)
+	}
 
-	err = orgRun(cmd, args)
+	err = ensureOrgActionsPermissions(ctx, clt, org)
 	if err != nil {
 		return handleError(cmd, err)
 	}
 
-	if !dry {
-		if !confirm(cmd, "Apply changes? (y/n): ") {
-			return nil
+	return nil
+}
+
+// ensureOrgSecrets sets any of the manifest's org secrets that are missing,
+// or visible to other repos than the manifest's. Values can not be read back
+// from github, so existing secrets scoped as the manifest says are left as
+// they are.
+func ensureOrgSecrets(ctx context.Context, clt client.GitHubClient, org *gh_pb.Organization) error {
+	if len(org.Secrets) == 0 {
+		return nil
+	}
+
+	if !manages(ctx, "org.secrets") {
+		return nil
+	}
+
+	report.Println()
+	report.PrintHeader("Secrets")
+	report.Println()
+
+	existing, err := clt.GetOrgSecrets(ctx, org.Name)
+	if err != nil {
+		return err
+	}
+
+	for _, s := range org.Secrets {
+		i := slices.IndexFunc(existing, func(es *github.Secret) bool {
+			return strings.EqualFold(es.Name, s.Name)
+		})
+
+		if i >= 0 {
+			es := existing[i]
+
+			same, err := sameScope(es.Visibility, s.Visibility, s.SelectedRepositories, func() ([]string, error) {
+				return clt.ListOrgSecretRepos(ctx, org.Name, es.Name)
+			})
+			if err != nil {
+				return err
+			}
+
+			if same {
+				report.PrintInfo("secret '" + s.Name + "' exists")
+				report.Println()
+
+				continue
+			}
 		}
 
-		err = clt.Apply()
+		v, err := secretValue(s.Name, s.GetFromEnv(), s.GetFromFile())
 		if err != nil {
-			return handleError(cmd, err)
+			return err
 		}
+
+		clt.PutOrgSecret(ctx, org.Name, s.Name, v, s.Visibility, s.SelectedRepositories)
 	}
 
 	return nil
 }
 
-func orgRun(cmd *cobra.Command, args []string) error {
-	ctx := cmd.Context()
+// ensureOrgVariables creates or updates the org's Actions variables to match
+// the manifest, in value and in the repos they are visible to. Values are
+// compared but never printed.
+func ensureOrgVariables(ctx context.Context, clt client.GitHubClient, org *gh_pb.Organization) error {
+	if len(org.Variables) == 0 {
+		return nil
+	}
 
-	org, err := manifest.OrgFromContext(ctx)
+	if !manages(ctx, "org.variables") {
+		return nil
+	}
+
+	report.Println()
+	report.PrintHeader("Variables")
+	report.Println()
+
+	vars, err := clt.GetOrgVariables(ctx, org.Name)
 	if err != nil {
-		return handleError(cmd, err)
+		return err
 	}
 
-	clt, err := client.ClientFromContext(ctx)
+	existing := map[string]*github.ActionsVariable{}
+	for _, v := range vars {
+		existing[strings.ToUpper(v.Name)] = v
+	}
+
+	for _, v := range org.Variables {
+		ev, found := existing[strings.ToUpper(v.Name)]
+		if found && ev.Value == v.Value {
+			same, err := sameScope(ev.GetVisibility(), v.Visibility, v.SelectedRepositories, func() ([]string, error) {
+				return clt.ListOrgVariableRepos(ctx, org.Name, ev.Name)
+			})
+			if err != nil {
+				return err
+			}
+
+			if same {
+				report.PrintInfo("variable '" + v.Name + "' is up to date")
+				report.Println()
+
+				continue
+			}
+		}
+
+		clt.PutOrgVariable(ctx, org.Name, v.Name, v.Value, v.Visibility, v.SelectedRepositories, found)
+	}
+
+	return nil
+}
+
+// sameScope reports whether an org secret or variable with the visibility
+// given is already scoped as the manifest wants. The repos it is visible to
+// are only listed when both are selected, and compared ignoring case and
+// order.
+func sameScope(visibility, want string, wantRepos []string, listRepos func() ([]string, error)) (bool, error) {
+	if !strings.EqualFold(visibility, want) {
+		return false, nil
+	}
+
+	if want != "selected" {
+		return true, nil
+	}
+
+	repos, err := listRepos()
 	if err != nil {
-		return handleError(cmd, err)
+		return false, err
+	}
+
+	byName := func(a, b string) int {
+		return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+	}
+
+	repos = slices.Clone(repos)
+	slices.SortFunc(repos, byName)
+
+	wantRepos = slices.Clone(wantRepos)
+	slices.SortFunc(wantRepos, byName)
+
+	return slices.EqualFunc(repos, wantRepos, strings.EqualFold), nil
+}
+
+// ensureOrgWebhooks matches the org's webhooks against the manifest by url,
+// the same way ensureWebhooks does for a repo's, only removing unlisted hooks
+// when pruning.
+func ensureOrgWebhooks(ctx context.Context, clt client.GitHubClient, org *gh_pb.Organization, prune bool) error {
+	if len(org.Webhooks) == 0 {
+		return nil
+	}
+
+	if !manages(ctx, "org.webhooks") {
+		return nil
 	}
 
 	report.Println()
-	report.PrintHeader("Permissions")
+	report.PrintHeader("Webhooks")
 	report.Println()
 
-	err = clt.SetOrgPrivileges(ctx, org.Name, buildOrgState(org))
+	existing, err := clt.ListOrgHooks(ctx, org.Name)
 	if err != nil {
-		return handleError(cmd, err)
+		return err
+	}
+
+	managed := []string{}
+	for _, w := range org.Webhooks {
+		managed = append(managed, w.Url)
+
+		desired, err := buildWebhook(w)
+		if err != nil {
+			return err
+		}
+
+		idx := slices.IndexFunc(existing, func(h *github.Hook) bool {
+			return client.HookURL(h) == w.Url
+		})
+
+		if idx < 0 {
+			clt.CreateOrgHook(ctx, org.Name, desired)
+			continue
+		}
+
+		clt.EditOrgHook(ctx, org.Name, existing[idx], desired)
+	}
+
+	for _, h := range existing {
+		if slices.Contains(managed, client.HookURL(h)) {
+			continue
+		}
+
+		if prune {
+			clt.DeleteOrgHook(ctx, org.Name, h)
+		} else {
+			report.PrintWarn("org webhook '" + client.HookURL(h) + "' exists in github but not in manifest")
+			report.Println()
+		}
 	}
 
 	return nil
 }
 
+// ensureOrgActionsPermissions sets which of the org's repos may use GitHub
+// Actions and which actions they may run. The selected repos and actions are
+// only set when the org is, or is about to be, limited to them.
+func ensureOrgActionsPermissions(ctx context.Context, clt client.GitHubClient, org *gh_pb.Organization) error {
+	ap := org.ActionsPermissions
+	if ap == nil {
+		return nil
+	}
+
+	if !manages(ctx, "org.actions_permissions") {
+		return nil
+	}
+
+	report.Println()
+	report.PrintHeader("Actions")
+	report.Println()
+
+	current, err := clt.GetOrgActionsPermissions(ctx, org.Name)
+	if err != nil {
+		return err
+	}
+
+	clt.SetOrgActionsPermissions(ctx, org.Name, current, &github.ActionsPermissions{
+		EnabledRepositories: ap.EnabledRepositories,
+		AllowedActions:      ap.AllowedActions,
+	})
+
+	scope := current.GetEnabledRepositories()
+	if ap.EnabledRepositories != nil {
+		scope = ap.GetEnabledRepositories()
+	}
+
+	// the repos are left as they are when none are listed
+	if scope == "selected" && len(ap.SelectedRepositories) > 0 {
+		var repos []string
+		if current.GetEnabledRepositories() == "selected" {
+			repos, err = clt.ListOrgActionsRepos(ctx, org.Name)
+			if err != nil {
+				return err
+			}
+		}
+
+		clt.SetOrgActionsRepos(ctx, org.Name, repos, ap.SelectedRepositories)
+	}
+
+	policy := current.GetAllowedActions()
+	if ap.AllowedActions != nil {
+		policy = ap.GetAllowedActions()
+	}
+
+	if policy != "selected" {
+		return nil
+	}
+
+	// github only has selected actions to read once the org is limited to
+	// them
+	allowed := &github.ActionsAllowed{}
+	if current.GetAllowedActions() == "selected" {
+		allowed, err = clt.GetOrgActionsAllowed(ctx, org.Name)
+		if err != nil {
+			return err
+		}
+	}
+
+	clt.SetOrgActionsAllowed(ctx, org.Name, allowed, buildActionsAllowed(ap.GithubOwnedAllowed, ap.VerifiedAllowed, ap.PatternsAllowed))
+
+	return nil
+}
+
+func buildOrgProfile(p *gh_pb.Profile) *github.Organization {
+	return &github.Organization{
+		Description:  p.Description,
+		BillingEmail: p.BillingEmail,
+		Company:      p.Company,
+		Location:     p.Location,
+		Blog:         p.Blog,
+	}
+}
+
 func buildOrgState(org *gh_pb.Organization) *github.Organization {
 	state := &github.Organization{}
 
@@ -120,6 +439,14 @@ func buildOrgState(org *gh_pb.Organization) *github.Organization {
 		if org.Permissions.CreatePublicRepos != nil {
 			state.MembersCanCreatePublicRepos = org.Permissions.CreatePublicRepos
 		}
+
+		if org.Permissions.CreateInternalRepos != nil {
+			state.MembersCanCreateInternalRepos = org.Permissions.CreateInternalRepos
+		}
+
+		if org.Permissions.CreatePages != nil {
+			state.MembersCanCreatePages = org.Permissions.CreatePages
+		}
 	}
 
 	return state
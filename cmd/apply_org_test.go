@@ -0,0 +1,217 @@
+package cmd
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/gomicro/concord/client/fakeclient"
+	gh_pb "github.com/gomicro/concord/github/v1"
+	"github.com/google/go-github/v56/github"
+	"golang.org/x/exp/slices"
+)
+
+func TestEnsureOrgActionsPermissions(t *testing.T) {
+	tests := []struct {
+		name    string
+		ap      *gh_pb.OrgActionsPermissions
+		scope   string
+		policy  string
+		managed []string
+		reads   []string
+		writes  []string
+	}{
+		{name: "no block"},
+		{
+			name:   "all repos and actions",
+			ap:     &gh_pb.OrgActionsPermissions{EnabledRepositories: github.String("all"), AllowedActions: github.String("all")},
+			scope:  "none",
+			policy: "local_only",
+			reads:  []string{"GetOrgActionsPermissions"},
+			writes: []string{"SetOrgActionsPermissions"},
+		},
+		{
+			name:   "newly selected repos",
+			ap:     &gh_pb.OrgActionsPermissions{EnabledRepositories: github.String("selected"), SelectedRepositories: []string{"widgets"}},
+			scope:  "all",
+			reads:  []string{"GetOrgActionsPermissions"},
+			writes: []string{"SetOrgActionsPermissions", "SetOrgActionsRepos"},
+		},
+		{
+			name:   "already selected repos",
+			ap:     &gh_pb.OrgActionsPermissions{SelectedRepositories: []string{"widgets"}},
+			scope:  "selected",
+			reads:  []string{"GetOrgActionsPermissions", "ListOrgActionsRepos"},
+			writes: []string{"SetOrgActionsPermissions", "SetOrgActionsRepos"},
+		},
+		{
+			name:   "selected without repos keeps them",
+			ap:     &gh_pb.OrgActionsPermissions{EnabledRepositories: github.String("selected")},
+			scope:  "selected",
+			reads:  []string{"GetOrgActionsPermissions"},
+			writes: []string{"SetOrgActionsPermissions"},
+		},
+		{
+			name:   "already selected actions",
+			ap:     &gh_pb.OrgActionsPermissions{PatternsAllowed: []string{"acme/*"}},
+			scope:  "all",
+			policy: "selected",
+			reads:  []string{"GetOrgActionsPermissions", "GetOrgActionsAllowed"},
+			writes: []string{"SetOrgActionsPermissions", "SetOrgActionsAllowed"},
+		},
+		{
+			name:    "unmanaged",
+			ap:      &gh_pb.OrgActionsPermissions{EnabledRepositories: github.String("all")},
+			managed: []string{"org.profile"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			captureReport(t)
+
+			fc := fakeclient.New()
+			fc.OrgActionsPermissions = &github.ActionsPermissions{
+				EnabledRepositories: github.String(tt.scope),
+				AllowedActions:      github.String(tt.policy),
+			}
+
+			org := &gh_pb.Organization{Name: "acme", ManagedFields: tt.managed, ActionsPermissions: tt.ap}
+
+			// reads are not recorded, so failing them shows which were made
+			errRead := errors.New("read")
+			var reads []string
+			for _, m := range []string{"GetOrgActionsPermissions", "ListOrgActionsRepos", "GetOrgActionsAllowed"} {
+				fc.Errs = map[string]error{m: errRead}
+
+				err := ensureOrgActionsPermissions(fakeCtx(fc, org), fc, org)
+				if errors.Is(err, errRead) {
+					reads = append(reads, m)
+				}
+			}
+
+			if !slices.Equal(reads, tt.reads) {
+				t.Errorf("reads = %v, want %v", reads, tt.reads)
+			}
+
+			fc.Errs = nil
+			fc.Calls = nil
+
+			err := ensureOrgActionsPermissions(fakeCtx(fc, org), fc, org)
+			if err != nil {
+				t.Fatalf("ensure org actions permissions: %v", err)
+			}
+
+			if !slices.Equal(fc.Methods(), tt.writes) {
+				t.Errorf("writes = %v, want %v", fc.Methods(), tt.writes)
+			}
+
+			for _, c := range fc.CallsTo("SetOrgActionsRepos") {
+				repos := c.Args[1].([]string)
+				if strings.Join(repos, ",") != "widgets" {
+					t.Errorf("selected repos = %v, want [widgets]", repos)
+				}
+			}
+		})
+	}
+}
+
+func TestEnsureOrgSecrets(t *testing.T) {
+	tests := []struct {
+		name       string
+		visibility string
+		repos      []string
+		current    string
+		selected   []string
+		put        bool
+	}{
+		{name: "same visibility", visibility: "private", current: "private"},
+		{name: "changed visibility", visibility: "all", current: "private", put: true},
+		{name: "same selected repos", visibility: "selected", repos: []string{"Widgets", "gadgets"}, current: "selected", selected: []string{"gadgets", "widgets"}},
+		{name: "changed selected repos", visibility: "selected", repos: []string{"widgets", "gadgets"}, current: "selected", selected: []string{"widgets"}, put: true},
+		{name: "newly selected", visibility: "selected", repos: []string{"widgets"}, current: "all", put: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			captureReport(t)
+			t.Setenv("DEPLOY_TOKEN", "s3cret")
+
+			fc := fakeclient.New()
+			fc.OrgSecrets = []*github.Secret{{Name: "DEPLOY_TOKEN", Visibility: tt.current}}
+			fc.OrgSecretRepos = map[string][]string{"DEPLOY_TOKEN": tt.selected}
+
+			org := &gh_pb.Organization{
+				Name: "acme",
+				Secrets: []*gh_pb.OrgSecret{{
+					Name:                 "deploy_token",
+					Value:                &gh_pb.OrgSecret_FromEnv{FromEnv: "DEPLOY_TOKEN"},
+					Visibility:           tt.visibility,
+					SelectedRepositories: tt.repos,
+				}},
+			}
+
+			err := ensureOrgSecrets(fakeCtx(fc, org), fc, org)
+			if err != nil {
+				t.Fatalf("ensure org secrets: %v", err)
+			}
+
+			puts := fc.CallsTo("PutOrgSecret")
+			if (len(puts) > 0) != tt.put {
+				t.Fatalf("writes = %v, want put %v", fc.Methods(), tt.put)
+			}
+
+			if tt.put && (puts[0].Args[1] != "s3cret" || puts[0].Args[2] != tt.visibility) {
+				t.Errorf("put %v, want the value visible to %s", puts[0].Args, tt.visibility)
+			}
+		})
+	}
+}
+
+func TestEnsureOrgVariables(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		repos    []string
+		selected []string
+		put      bool
+	}{
+		{name: "up to date", value: "prod", repos: []string{"widgets"}, selected: []string{"Widgets"}},
+		{name: "changed value", value: "staging", repos: []string{"widgets"}, selected: []string{"widgets"}, put: true},
+		{name: "changed selected repos", value: "prod", repos: []string{"widgets", "gadgets"}, selected: []string{"widgets"}, put: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			captureReport(t)
+
+			fc := fakeclient.New()
+			fc.OrgVariables = []*github.ActionsVariable{{Name: "ENV", Value: "prod", Visibility: github.String("selected")}}
+			fc.OrgVariableRepos = map[string][]string{"ENV": tt.selected}
+
+			org := &gh_pb.Organization{
+				Name: "acme",
+				Variables: []*gh_pb.OrgVariable{{
+					Name:                 "env",
+					Value:                tt.value,
+					Visibility:           "selected",
+					SelectedRepositories: tt.repos,
+				}},
+			}
+
+			err := ensureOrgVariables(fakeCtx(fc, org), fc, org)
+			if err != nil {
+				t.Fatalf("ensure org variables: %v", err)
+			}
+
+			puts := fc.CallsTo("PutOrgVariable")
+			if (len(puts) > 0) != tt.put {
+				t.Fatalf("writes = %v, want put %v", fc.Methods(), tt.put)
+			}
+
+			if tt.put && puts[0].Args[4] != true {
+				t.Errorf("put %v, want the existing variable updated", puts[0].Args)
+			}
+		})
+	}
+}
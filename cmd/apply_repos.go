@@ -6,7 +6,9 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"path"
 	"strings"
+	"time"
 
 	"github.com/gomicro/concord/client"
 	gh_pb "github.com/gomicro/concord/github/v1"
@@ -15,6 +17,17 @@ import (
 	"github.com/google/go-github/v56/github"
 	"github.com/spf13/cobra"
 	"golang.org/x/exp/slices"
+	"google.golang.org/protobuf/proto"
+)
+
+var (
+	// errReposFailed marks a run where some repos failed to reconcile but the
+	// rest were planned, so their changes can still be applied.
+	errReposFailed = errors.New("repos failed")
+
+	// errAmbiguousRename marks a repo whose old and new names both exist, so
+	// it is unclear which one the manifest means.
+	errAmbiguousRename = errors.New("ambiguous rename")
 )
 
 func init() {
@@ -36,51 +49,46 @@ func NewApplyReposCmd(out io.Writer) *cobra.Command {
 
 func applyReposRun(cmd *cobra.Command, args []string) error {
 	file := cmd.Flags().Lookup("file").Value.String()
-	cmd.SetContext(manifest.WithManifest(cmd.Context(), file))
-
-	dry := strings.EqualFold(cmd.Flags().Lookup("dry").Value.String(), "true")
-
-	ctx := cmd.Context()
-
-	org, err := manifest.OrgFromContext(ctx)
+	ctx, err := manifest.WithManifest(cmd.Context(), file)
 	if err != nil {
 		return handleError(cmd, err)
 	}
 
-	clt, err := client.ClientFromContext(ctx)
-	if err != nil {
-		return handleError(cmd, err)
-	}
+	cmd.SetContext(ctx)
 
-	exists, err := clt.OrgExists(ctx, org.Name)
+	dry := dryRun(cmd)
+
+	clt, err := client.ClientFromContext(ctx)
 	if err != nil {
 		return handleError(cmd, err)
 	}
 
-	if !exists {
-		return handleError(cmd, errors.New("organization does not exist"))
+	reposErr := eachOrg(cmd, func() error { return reposRun(cmd, args) })
+	if reposErr != nil && !errors.Is(reposErr, errReposFailed) {
+		return handleError(cmd, reposErr)
 	}
 
-	report.PrintHeader("Org")
-	report.Println()
-
-	err = reposRun(cmd, args)
-	if err != nil {
-		return handleError(cmd, err)
+	if dry {
+		err = clt.RecordDryRun()
+		if err != nil {
+			return handleError(cmd, err)
+		}
 	}
 
 	if !dry {
-		if !confirm(cmd, "Apply changes? (y/n): ") {
-			return nil
+		if !confirmDestructive(cmd, clt.Pending()) {
+			return handleError(cmd, reposErr)
 		}
 
-		err = clt.Apply()
+		err = clt.Flush(ctx)
 		if err != nil {
-			return handleError(cmd, err)
+			return handleError(cmd, errors.Join(reposErr, err))
 		}
 	}
 
-	return nil
+	report.PrintSummary(dry)
+
+	return handleError(cmd, reposErr)
 }
 
 func reposRun(cmd *cobra.Command, args []string) error {
@@ -105,7 +113,23 @@ func reposRun(cmd *cobra.Command, args []string) error {
 		return handleError(cmd, err)
 	}
 
-	unmanaged := getUnmanagedRepos(org.Repositories, repos)
+	filter := cmd.Flags().Lookup("filter").Value.String()
+	_, err = path.Match(filter, "")
+	if err != nil {
+		return handleError(cmd, fmt.Errorf("filter '%s': %w", filter, err))
+	}
+
+	changedSince, err := cmd.Flags().GetDuration("changed-since")
+	if err != nil {
+		return handleError(cmd, err)
+	}
+
+	unmanaged := []string{}
+	for _, r := range getUnmanagedRepos(org.Repositories, repos) {
+		if matchesFilter(filter, r) {
+			unmanaged = append(unmanaged, r)
+		}
+	}
 
 	targetMap := map[string]struct{}{}
 	if len(args) > 0 {
@@ -118,15 +142,63 @@ func reposRun(cmd *cobra.Command, args []string) error {
 		}
 	}
 
+	listed, err := reposFrom(cmd, org.Repositories)
+	if err != nil {
+		return handleError(cmd, err)
+	}
+
+	// only the listed repos are looked at, the rest are not reported
+	if listed != nil {
+		for name := range targetMap {
+			if !slices.Contains(listed, name) {
+				delete(targetMap, name)
+			}
+		}
+
+		unmanaged = nil
+	}
+
+	var errs []error
 	for _, r := range org.Repositories {
 		if _, found := targetMap[r.Name]; found {
+			if !matchesFilter(filter, r.Name) {
+				report.PrintTrace("skipping repo " + r.Name + ", it does not match the filter")
+				continue
+			}
+
+			if changedSince > 0 && !changedWithin(repos, r.Name, changedSince) {
+				report.PrintTrace("skipping repo " + r.Name + ", it has not changed in " + changedSince.String())
+				continue
+			}
+
 			report.Println()
-			report.PrintHeader(r.Name)
+			report.PrintSection(r.Name)
 			report.Println()
 
-			err := ensureRepo(ctx, org.Name, r)
+			planned := report.Planned()
+
+			err := ensureRepo(ctx, clt, org.Name, r, prune(cmd))
+			if err == nil && report.Planned() == planned {
+				report.PrintInfo("no changes")
+				report.Println()
+			}
+
 			if err != nil {
-				return handleError(cmd, err)
+				if !continueOnError(cmd) || client.IsRateLimited(err) || ctx.Err() != nil {
+					return handleError(cmd, err)
+				}
+
+				report.PrintError(err.Error())
+				report.Println()
+
+				report.Count("Repos", report.Failed, 1)
+
+				errs = append(errs, fmt.Errorf("%s: %w", r.Name, err))
+			}
+
+			rerr := recordCompliance(ctx, clt, org.Name, r.Name, report.Planned() != planned, err)
+			if rerr != nil {
+				return handleError(cmd, rerr)
 			}
 		}
 	}
@@ -134,21 +206,90 @@ func reposRun(cmd *cobra.Command, args []string) error {
 	if len(args) == 0 {
 		for _, mr := range unmanaged {
 			report.Println()
-			report.PrintHeader(mr)
+			report.PrintSection(mr)
 			report.Println()
 
 			report.PrintWarn("repo exists in github but not in manifest")
 			report.Println()
 		}
+
+		report.Count("Repos", report.Unmanaged, len(unmanaged))
+	}
+
+	if len(errs) > 0 {
+		return handleError(cmd, fmt.Errorf("%d %w: %w", len(errs), errReposFailed, errors.Join(errs...)))
 	}
 
 	return nil
 }
 
+// reposFrom reads the repo names in the file given with the repos-from flag,
+// one per line, warning about any the manifest does not have. Blank lines and
+// lines starting with # are skipped. It returns nil when no file was given.
+func reposFrom(cmd *cobra.Command, repos []*gh_pb.Repository) ([]string, error) {
+	file := cmd.Flags().Lookup("repos-from").Value.String()
+	if file == "" {
+		return nil, nil
+	}
+
+	b, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("repos from: %w", err)
+	}
+
+	names := []string{}
+	for _, l := range strings.Split(string(b), "\n") {
+		name := strings.TrimSpace(l)
+		if name == "" || strings.HasPrefix(name, "#") {
+			continue
+		}
+
+		if !slices.ContainsFunc(repos, func(r *gh_pb.Repository) bool { return r.Name == name }) {
+			report.PrintWarn("repo " + name + " is listed in " + file + " but not in the manifest")
+			report.Println()
+
+			continue
+		}
+
+		names = append(names, name)
+	}
+
+	return names, nil
+}
+
+// matchesFilter reports whether a repo name matches the glob given with the
+// filter flag. Every repo matches an empty filter.
+func matchesFilter(filter, name string) bool {
+	if filter == "" {
+		return true
+	}
+
+	ok, _ := path.Match(filter, name)
+	return ok
+}
+
+// changedWithin reports whether the named repo was pushed to or updated in
+// github within the duration. Repos not in github yet count as changed so they
+// are still created.
+func changedWithin(repos []*github.Repository, name string, d time.Duration) bool {
+	i := slices.IndexFunc(repos, func(r *github.Repository) bool { return strings.EqualFold(r.GetName(), name) })
+	if i < 0 {
+		return true
+	}
+
+	cutoff := time.Now().Add(-d)
+
+	return repos[i].GetPushedAt().After(cutoff) || repos[i].GetUpdatedAt().After(cutoff)
+}
+
 func getUnmanagedRepos(manifest []*gh_pb.Repository, repos []*github.Repository) []string {
 	managed := []string{}
 	for _, r := range manifest {
 		managed = append(managed, r.Name)
+
+		if r.RenameFrom != nil {
+			managed = append(managed, r.GetRenameFrom())
+		}
 	}
 
 	unmanaged := []string{}
@@ -161,212 +302,1590 @@ func getUnmanagedRepos(manifest []*gh_pb.Repository, repos []*github.Repository)
 	return unmanaged
 }
 
-func ensureRepo(ctx context.Context, org string, repo *gh_pb.Repository) error {
-	clt, err := client.ClientFromContext(ctx)
-	if err != nil {
-		return err
-	}
-
+func ensureRepo(ctx context.Context, clt client.GitHubClient, org string, repo *gh_pb.Repository, prune bool) error {
 	ghr, err := clt.GetRepo(ctx, org, repo.Name)
 	if err != nil && !errors.Is(err, client.ErrRepoNotFound) {
 		return err
 	}
 
-	fresh := false
-	if errors.Is(err, client.ErrRepoNotFound) {
-		clt.CreateRepo(ctx, org, buildRepoState(repo))
-		fresh = true
+	if repo.RenameFrom != nil {
+		renamed, err := renameRepo(ctx, clt, org, repo, ghr)
+		if err != nil || renamed {
+			return err
+		}
 	}
 
-	clt.UpdateRepo(ctx, org, repo.Name, buildRepoEdits(repo, ghr, fresh))
+	if ghr == nil {
+		err := createRepo(ctx, clt, org, repo)
+		if err != nil {
+			return err
+		}
+
+		report.Count("Repos", report.Created, 1)
 
-	if len(repo.Labels) > 0 {
-		var ghl []string
+		return nil
+	}
 
-		if ghr != nil {
-			ghl = ghr.Topics
-			slices.Sort(ghl)
-		}
+	// archived repos reject most edits, so one staying archived is left
+	// alone, and one changing is unarchived first or archived last
+	if ghr.GetArchived() && (repo.Archived == nil || repo.GetArchived()) {
+		report.PrintInfo("repo is archived, leaving its settings alone")
+		report.Println()
 
-		l := repo.Labels
-		slices.Sort(l)
+		report.Count("Repos", report.Unchanged, 1)
 
-		if !slices.Equal(ghl, l) {
-			clt.SetRepoTopics(ctx, org, repo.Name, l)
-		} else {
-			report.PrintInfo("labels are [" + strings.Join(l, ", ") + "]")
-			report.Println()
-		}
+		return nil
 	}
 
-	for _, pb := range repo.ProtectedBranches {
-		err := setBranchProtection(ctx, org, repo, pb)
+	planned := report.Planned()
+
+	edits := buildRepoEdits(repo, ghr)
+	dropUnmanagedEdits(ctx, edits)
+
+	archive := edits.Archived
+	edits.Archived = nil
+
+	if archive != nil && !*archive {
+		report.PrintInfo("repo is archived, unarchiving it before the other edits")
+		report.Println()
+
+		err = clt.UpdateRepo(ctx, org, repo.Name, ghr, &github.Repository{Archived: archive})
 		if err != nil {
 			return err
 		}
 	}
 
-	// if repo is fresh, we can't do anything with teams yet
-	if !fresh {
-		err = setTeamPermissions(ctx, org, repo, ghr)
-		if err != nil {
-			return err
-		}
+	err = ensureDefaultBranch(ctx, clt, org, repo, ghr)
+	if err != nil {
+		return err
 	}
 
-	err = ensureFiles(ctx, org, repo, ghr)
+	err = clt.UpdateRepo(ctx, org, repo.Name, ghr, edits)
 	if err != nil {
 		return err
 	}
 
-	return nil
-}
+	ensureTopics(ctx, clt, org, repo, ghr)
 
-func buildRepoEdits(repo *gh_pb.Repository, ghr *github.Repository, fresh bool) *github.Repository {
-	edits := &github.Repository{}
+	branches, err := expandProtectedBranches(ctx, clt, org, repo)
+	if err != nil {
+		return err
+	}
 
-	if !fresh && repo.Description != nil && !strings.EqualFold(ghr.GetDescription(), *repo.Description) {
-		edits.Description = repo.Description
+	defaultBranch := repo.GetDefaultBranch()
+	if defaultBranch == "" {
+		defaultBranch = ghr.GetDefaultBranch()
 	}
 
-	if !fresh && repo.Archived != nil && ghr.GetArchived() != *repo.Archived {
-		edits.Archived = repo.Archived
+	for _, pb := range branches {
+		err := setBranchProtection(ctx, clt, org, repo, pb, defaultBranch, false)
+		if err != nil {
+			return err
+		}
+
+		err = checkCodeowners(ctx, clt, org, repo.Name, pb.Name)
+		if err != nil {
+			return err
+		}
 	}
 
-	if !fresh && repo.Private != nil && ghr.GetPrivate() != *repo.Private {
-		edits.Private = repo.Private
+	err = setTeamPermissions(ctx, clt, org, repo, ghr)
+	if err != nil {
+		return err
 	}
 
-	if !fresh && repo.DefaultBranch != nil && !strings.EqualFold(ghr.GetDefaultBranch(), *repo.DefaultBranch) {
-		edits.DefaultBranch = repo.DefaultBranch
+	err = ensurePreReceiveHooks(ctx, clt, org, repo)
+	if err != nil {
+		return err
 	}
 
-	if repo.AutoDeleteHeadBranches != nil && ghr.GetDeleteBranchOnMerge() != *repo.AutoDeleteHeadBranches {
-		edits.DeleteBranchOnMerge = repo.AutoDeleteHeadBranches
+	err = ensureSecrets(ctx, clt, org, repo, false)
+	if err != nil {
+		return err
 	}
 
-	if repo.AllowAutoMerge != nil && ghr.GetAllowAutoMerge() != *repo.AllowAutoMerge {
-		edits.AllowAutoMerge = repo.AllowAutoMerge
+	err = ensureVariables(ctx, clt, org, repo, false)
+	if err != nil {
+		return err
 	}
 
-	return edits
-}
+	err = ensureWebhooks(ctx, clt, org, repo, false, prune)
+	if err != nil {
+		return err
+	}
 
-func buildRepoState(repo *gh_pb.Repository) *github.Repository {
-	state := &github.Repository{
-		Name: &repo.Name,
+	err = ensureSecurity(ctx, clt, org, repo, ghr)
+	if err != nil {
+		return err
 	}
 
-	if repo.Description != nil {
-		state.Description = repo.Description
+	err = ensurePages(ctx, clt, org, repo, false)
+	if err != nil {
+		return err
 	}
 
-	if repo.Archived != nil {
-		state.Archived = repo.Archived
+	err = ensureRulesets(ctx, clt, org, repo, false)
+	if err != nil {
+		return err
 	}
 
-	if repo.Private != nil {
-		state.Private = repo.Private
+	err = ensureProtectedTags(ctx, clt, org, repo, false)
+	if err != nil {
+		return err
 	}
 
-	if repo.DefaultBranch != nil {
-		state.DefaultBranch = repo.DefaultBranch
+	err = ensureAutolinks(ctx, clt, org, repo, false)
+	if err != nil {
+		return err
 	}
 
-	return state
-}
+	err = ensureCollaborators(ctx, clt, org, repo, false, prune)
+	if err != nil {
+		return err
+	}
 
-func setTeamPermissions(ctx context.Context, org string, repo *gh_pb.Repository, ghr *github.Repository) error {
-	if len(repo.Permissions) == 0 {
-		return nil
+	err = ensureIssueLabels(ctx, clt, org, repo, false, prune)
+	if err != nil {
+		return err
 	}
 
-	clt, err := client.ClientFromContext(ctx)
+	err = ensureActionsPermissions(ctx, clt, org, repo, false)
 	if err != nil {
 		return err
 	}
 
-	for p, teams := range repo.Permissions {
-		for _, t := range teams.Teams {
-			err = clt.AddRepoToTeam(ctx, org, strings.ToLower(t), repo.Name, p)
-			if err != nil {
-				return err
-			}
-		}
+	err = ensureFiles(ctx, org, repo, ghr)
+	if err != nil {
+		return err
 	}
 
-	// should remove teams without permissions
-	managed := map[string]struct{}{}
-	for _, ts := range repo.Permissions {
-		for _, t := range ts.Teams {
-			managed[strings.ToLower(t)] = struct{}{}
-		}
+	err = ensureLicense(ctx, clt, org, repo, false)
+	if err != nil {
+		return err
 	}
 
-	gts, err := clt.GetRepoTeams(ctx, org, repo.Name)
+	err = ensureRepoWatched(ctx, clt, org, repo, false)
 	if err != nil {
-		return fmt.Errorf("remove unamaged teams: %w", err)
+		return err
 	}
 
-	for _, gt := range gts {
-		if _, ok := managed[strings.ToLower(gt.GetName())]; ok {
-			continue
+	if archive != nil && *archive {
+		err = clt.UpdateRepo(ctx, org, repo.Name, ghr, &github.Repository{Archived: archive})
+		if err != nil {
+			return err
 		}
+	}
 
-		clt.RemoveRepoFromTeam(ctx, org, gt.GetSlug(), repo.Name)
+	if report.Planned() > planned {
+		report.Count("Repos", report.Updated, 1)
+	} else {
+		report.Count("Repos", report.Unchanged, 1)
 	}
 
 	return nil
 }
 
-func ensureFiles(ctx context.Context, org string, repo *gh_pb.Repository, ghr *github.Repository) error {
-	// clone down repo
-	// copy file to expected location in repo
-	// if diff, commit and push PR
+// renameRepo renames the repo from its old name when only that one exists,
+// and reports whether it did. The rest of its settings are left for the next
+// run, as they cannot be read under the new name until the rename is applied.
+func renameRepo(ctx context.Context, clt client.GitHubClient, org string, repo *gh_pb.Repository, ghr *github.Repository) (bool, error) {
+	old, err := clt.GetRepo(ctx, org, repo.GetRenameFrom())
+	if err != nil {
+		if errors.Is(err, client.ErrRepoNotFound) {
+			return false, nil
+		}
 
-	return nil
+		return false, err
+	}
+
+	// github redirects the old name to the new one once renamed
+	if strings.EqualFold(old.GetName(), repo.Name) {
+		return false, nil
+	}
+
+	if ghr != nil {
+		return false, fmt.Errorf("%w: both '%s' and '%s' exist, remove rename_from or one of the repos", errAmbiguousRename, repo.GetRenameFrom(), repo.Name)
+	}
+
+	clt.RenameRepo(ctx, org, repo.GetRenameFrom(), repo.Name)
+
+	report.PrintInfo("its other settings are reconciled on the next run, once the rename has been applied")
+	report.Println()
+
+	report.Count("Repos", report.Updated, 1)
+
+	return true, nil
 }
 
-func setBranchProtection(ctx context.Context, org string, repo *gh_pb.Repository, branch *gh_pb.Branch) error {
-	state := buildBranchProtectionState(branch)
+func ensureDefaultBranch(ctx context.Context, clt client.GitHubClient, org string, repo *gh_pb.Repository, ghr *github.Repository) error {
+	if repo.DefaultBranch == nil || strings.EqualFold(ghr.GetDefaultBranch(), *repo.DefaultBranch) {
+		return nil
+	}
 
-	clt, err := client.ClientFromContext(ctx)
-	if err != nil {
-		return err
+	if !manages(ctx, "repo.default_branch") {
+		return nil
 	}
 
-	err = clt.ProtectBranch(ctx, org, repo.Name, branch.Name, state)
+	branches, err := clt.GetBranches(ctx, org, repo.Name)
 	if err != nil {
 		return err
 	}
 
-	if branch.GetProtection() != nil {
-		err = clt.SetRequireSignedCommits(ctx, org, repo.Name, branch.Name, branch.GetProtection().GetSignedCommits())
-		if err != nil {
-			return err
+	for _, b := range branches {
+		if b.GetName() == *repo.DefaultBranch {
+			return nil
 		}
 	}
 
+	if !repo.GetCreateDefaultBranch() {
+		return fmt.Errorf("default branch '%s': %w; set create_default_branch to create it from '%s'", *repo.DefaultBranch, client.ErrBranchNotFound, ghr.GetDefaultBranch())
+	}
+
+	clt.CreateBranch(ctx, org, repo.Name, *repo.DefaultBranch, ghr.GetDefaultBranch())
+
 	return nil
 }
 
-func buildBranchProtectionState(branch *gh_pb.Branch) *github.ProtectionRequest {
-	state := &github.ProtectionRequest{}
+// ensureTopics sets the repo's topics to the manifest's. Existing topics are
+// only cleared when the manifest asks for it explicitly.
+func ensureTopics(ctx context.Context, clt client.GitHubClient, org string, repo *gh_pb.Repository, ghr *github.Repository) {
+	if len(repo.Topics) == 0 && !repo.GetClearTopics() {
+		return
+	}
 
-	if branch.Protection.RequirePr != nil && *branch.Protection.RequirePr {
-		state.RequiredPullRequestReviews = &github.PullRequestReviewsEnforcementRequest{}
+	if !manages(ctx, "repo.topics") {
+		return
 	}
 
-	if branch.Protection.ChecksMustPass != nil && *branch.Protection.ChecksMustPass {
-		state.RequiredStatusChecks = &github.RequiredStatusChecks{
-			Checks: []*github.RequiredStatusCheck{},
-		}
+	ght := normalizeTopics(ghr.Topics)
+	t := normalizeTopics(repo.Topics)
 
-		if len(branch.Protection.RequiredChecks) > 0 {
-			for _, c := range branch.Protection.RequiredChecks {
-				state.RequiredStatusChecks.Checks = append(state.RequiredStatusChecks.Checks, &github.RequiredStatusCheck{
-					Context: c,
-				})
+	if !slices.Equal(ght, t) {
+		clt.SetRepoTopics(ctx, org, repo.Name, ght, t)
+	} else {
+		report.PrintInfo("topics are [" + strings.Join(t, ", ") + "]")
+		report.Println()
+	}
+}
+
+// normalizeTopics returns a sorted, lowercased copy of topics, matching how
+// github stores them.
+func normalizeTopics(topics []string) []string {
+	n := make([]string, len(topics))
+	for i := range topics {
+		n[i] = strings.ToLower(topics[i])
+	}
+
+	slices.Sort(n)
+
+	return n
+}
+
+// createRepo plans the creation of a repo that does not exist yet. Nothing is
+// diffed against live state here; settings that depend on the repo already
+// existing, such as team access, are picked up on the next run.
+func createRepo(ctx context.Context, clt client.GitHubClient, org string, repo *gh_pb.Repository) error {
+	clt.CreateRepo(ctx, org, buildRepoState(repo))
+
+	if len(repo.Topics) > 0 {
+		clt.SetRepoTopics(ctx, org, repo.Name, nil, normalizeTopics(repo.Topics))
+	}
+
+	// a repo created without auto init has no branches to protect yet
+	if !repo.GetAutoInit() && len(repo.ProtectedBranches) > 0 {
+		report.PrintWarn("repo will be created empty, skipping branch protection; set auto_init or apply again once it has commits")
+		report.Println()
+	} else {
+		for _, pb := range repo.ProtectedBranches {
+			// there are no branches to match until the repo exists
+			if pb.Pattern != "" {
+				report.PrintInfo("branches matching '" + pb.Pattern + "' will be protected on the next run")
+				report.Println()
+
+				continue
+			}
+
+			err := setBranchProtection(ctx, clt, org, repo, pb, defaultBranchName(repo), true)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	err := ensureSecrets(ctx, clt, org, repo, true)
+	if err != nil {
+		return err
+	}
+
+	err = ensureVariables(ctx, clt, org, repo, true)
+	if err != nil {
+		return err
+	}
+
+	err = ensureWebhooks(ctx, clt, org, repo, true, false)
+	if err != nil {
+		return err
+	}
+
+	err = ensureSecurity(ctx, clt, org, repo, nil)
+	if err != nil {
+		return err
+	}
+
+	err = ensurePages(ctx, clt, org, repo, true)
+	if err != nil {
+		return err
+	}
+
+	err = ensureRulesets(ctx, clt, org, repo, true)
+	if err != nil {
+		return err
+	}
+
+	err = ensureProtectedTags(ctx, clt, org, repo, true)
+	if err != nil {
+		return err
+	}
+
+	err = ensureAutolinks(ctx, clt, org, repo, true)
+	if err != nil {
+		return err
+	}
+
+	err = ensureCollaborators(ctx, clt, org, repo, true, false)
+	if err != nil {
+		return err
+	}
+
+	err = ensureIssueLabels(ctx, clt, org, repo, true, false)
+	if err != nil {
+		return err
+	}
+
+	err = ensureActionsPermissions(ctx, clt, org, repo, true)
+	if err != nil {
+		return err
+	}
+
+	err = ensureLicense(ctx, clt, org, repo, true)
+	if err != nil {
+		return err
+	}
+
+	return ensureRepoWatched(ctx, clt, org, repo, true)
+}
+
+// ensureSecrets sets any of the manifest's secrets missing from the repo.
+// Values can not be read back from github, so existing secrets are left as
+// they are.
+func ensureSecrets(ctx context.Context, clt client.GitHubClient, org string, repo *gh_pb.Repository, fresh bool) error {
+	if len(repo.Secrets) == 0 {
+		return nil
+	}
+
+	if !manages(ctx, "repo.secrets") {
+		return nil
+	}
+
+	var existing []string
+	if !fresh {
+		var err error
+		existing, err = clt.GetRepoSecrets(ctx, org, repo.Name)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, s := range repo.Secrets {
+		found := slices.ContainsFunc(existing, func(name string) bool {
+			return strings.EqualFold(name, s.Name)
+		})
+
+		if found {
+			report.PrintInfo("secret '" + s.Name + "' exists")
+			report.Println()
+
+			continue
+		}
+
+		v, err := secretValue(s.Name, s.GetFromEnv(), s.GetFromFile())
+		if err != nil {
+			return err
+		}
+
+		clt.PutRepoSecret(ctx, org, repo.Name, s.Name, v)
+	}
+
+	return nil
+}
+
+// secretValue reads a secret's value from the environment variable or file
+// the manifest points to.
+func secretValue(name, fromEnv, fromFile string) (string, error) {
+	if fromEnv != "" {
+		val, ok := os.LookupEnv(fromEnv)
+		if !ok {
+			return "", fmt.Errorf("secret %s: environment variable %s is not set", name, fromEnv)
+		}
+
+		return val, nil
+	}
+
+	if fromFile != "" {
+		b, err := os.ReadFile(fromFile)
+		if err != nil {
+			return "", fmt.Errorf("secret %s: %w", name, err)
+		}
+
+		return strings.TrimSuffix(string(b), "\n"), nil
+	}
+
+	return "", fmt.Errorf("secret %s: no value source", name)
+}
+
+// ensureVariables creates or updates the repo's Actions variables to match
+// the manifest.
+func ensureVariables(ctx context.Context, clt client.GitHubClient, org string, repo *gh_pb.Repository, fresh bool) error {
+	if len(repo.Variables) == 0 {
+		return nil
+	}
+
+	if !manages(ctx, "repo.variables") {
+		return nil
+	}
+
+	existing := map[string]string{}
+	if !fresh {
+		vars, err := clt.GetRepoVariables(ctx, org, repo.Name)
+		if err != nil {
+			return err
+		}
+
+		for _, v := range vars {
+			existing[strings.ToUpper(v.Name)] = v.Value
+		}
+	}
+
+	for _, v := range repo.Variables {
+		val, found := existing[strings.ToUpper(v.Name)]
+		if found && val == v.Value {
+			report.PrintInfo("variable '" + v.Name + "' is '" + v.Value + "'")
+			report.Println()
+
+			continue
+		}
+
+		clt.PutRepoVariable(ctx, org, repo.Name, v.Name, v.Value, found)
+	}
+
+	return nil
+}
+
+// ensureWebhooks matches the repo's webhooks against the manifest by url,
+// adding and updating them. Hooks in github that are not listed are removed
+// when pruning and warned about otherwise.
+func ensureWebhooks(ctx context.Context, clt client.GitHubClient, org string, repo *gh_pb.Repository, fresh, prune bool) error {
+	if len(repo.Webhooks) == 0 {
+		return nil
+	}
+
+	if !manages(ctx, "repo.webhooks") {
+		return nil
+	}
+
+	var existing []*github.Hook
+	if !fresh {
+		var err error
+		existing, err = clt.ListRepoHooks(ctx, org, repo.Name)
+		if err != nil {
+			return err
+		}
+	}
+
+	managed := []string{}
+	for _, w := range repo.Webhooks {
+		managed = append(managed, w.Url)
+
+		desired, err := buildWebhook(w)
+		if err != nil {
+			return err
+		}
+
+		idx := slices.IndexFunc(existing, func(h *github.Hook) bool {
+			return client.HookURL(h) == w.Url
+		})
+
+		if idx < 0 {
+			clt.CreateRepoHook(ctx, org, repo.Name, desired)
+			continue
+		}
+
+		clt.EditRepoHook(ctx, org, repo.Name, existing[idx], desired)
+	}
+
+	for _, h := range existing {
+		if slices.Contains(managed, client.HookURL(h)) {
+			continue
+		}
+
+		if prune {
+			clt.DeleteRepoHook(ctx, org, repo.Name, h)
+		} else {
+			report.PrintWarn("webhook '" + client.HookURL(h) + "' exists in github but not in manifest")
+			report.Println()
+		}
+	}
+
+	return nil
+}
+
+// ensureSecurity toggles the repo's security features where they differ from
+// the manifest. A nil ghr means the repo is being created and every feature
+// set in the manifest is applied.
+func ensureSecurity(ctx context.Context, clt client.GitHubClient, org string, repo *gh_pb.Repository, ghr *github.Repository) error {
+	if !manages(ctx, "repo.security") {
+		return nil
+	}
+
+	if repo.VulnerabilityAlerts != nil {
+		enabled := false
+		if ghr != nil {
+			var err error
+			enabled, err = clt.GetVulnerabilityAlerts(ctx, org, repo.Name)
+			if err != nil {
+				return err
+			}
+		}
+
+		if ghr == nil || enabled != *repo.VulnerabilityAlerts {
+			clt.SetVulnerabilityAlerts(ctx, org, repo.Name, *repo.VulnerabilityAlerts)
+		} else {
+			report.PrintInfo(fmt.Sprintf("vulnerability alerts is '%t'", enabled))
+			report.Println()
+		}
+	}
+
+	if repo.AutomatedSecurityFixes != nil {
+		enabled := false
+		if ghr != nil {
+			var err error
+			enabled, err = clt.GetAutomatedSecurityFixes(ctx, org, repo.Name)
+			if err != nil {
+				return err
+			}
+		}
+
+		if ghr == nil || enabled != *repo.AutomatedSecurityFixes {
+			clt.SetAutomatedSecurityFixes(ctx, org, repo.Name, *repo.AutomatedSecurityFixes)
+		} else {
+			report.PrintInfo(fmt.Sprintf("automated security fixes is '%t'", enabled))
+			report.Println()
+		}
+	}
+
+	if repo.SecretScanning != nil {
+		enabled := false
+		if ghr != nil {
+			var err error
+			enabled, err = clt.GetSecretScanning(ctx, org, repo.Name)
+			if err != nil {
+				return err
+			}
+		}
+
+		if ghr == nil || enabled != *repo.SecretScanning {
+			clt.SetSecretScanning(ctx, org, repo.Name, *repo.SecretScanning)
+		} else {
+			report.PrintInfo(fmt.Sprintf("secret scanning is '%t'", enabled))
+			report.Println()
+		}
+	}
+
+	return nil
+}
+
+// ensurePages enables the repo's GitHub Pages site when the manifest has one,
+// or brings the existing site in line with it.
+func ensurePages(ctx context.Context, clt client.GitHubClient, org string, repo *gh_pb.Repository, fresh bool) error {
+	if repo.Pages == nil {
+		return nil
+	}
+
+	if !manages(ctx, "repo.pages") {
+		return nil
+	}
+
+	path := "/"
+	if repo.Pages.Path != nil {
+		path = repo.Pages.GetPath()
+	}
+
+	desired := &github.PagesUpdate{
+		Source: &github.PagesSource{
+			Branch: github.String(repo.Pages.Branch),
+			Path:   github.String(path),
+		},
+		CNAME:         repo.Pages.CustomDomain,
+		HTTPSEnforced: repo.Pages.EnforceHttps,
+	}
+
+	var current *github.Pages
+	if !fresh {
+		var err error
+		current, err = clt.GetPages(ctx, org, repo.Name)
+		if err != nil {
+			return err
+		}
+	}
+
+	if current == nil {
+		clt.EnablePages(ctx, org, repo.Name, desired)
+		return nil
+	}
+
+	clt.UpdatePages(ctx, org, repo.Name, current, desired)
+
+	return nil
+}
+
+// ensureRulesets creates or updates the repo's rulesets to match the manifest
+// by name. Rulesets only found in github are warned about.
+func ensureRulesets(ctx context.Context, clt client.GitHubClient, org string, repo *gh_pb.Repository, fresh bool) error {
+	if len(repo.Rulesets) == 0 {
+		return nil
+	}
+
+	if !manages(ctx, "repo.rulesets") {
+		return nil
+	}
+
+	var existing []*github.Ruleset
+	if !fresh {
+		var err error
+		existing, err = clt.ListRulesets(ctx, org, repo.Name)
+		if err != nil {
+			return err
+		}
+	}
+
+	managed := []string{}
+	for _, r := range repo.Rulesets {
+		managed = append(managed, r.Name)
+
+		desired := buildRuleset(r)
+
+		idx := slices.IndexFunc(existing, func(rs *github.Ruleset) bool {
+			return rs.Name == r.Name
+		})
+
+		if idx < 0 {
+			clt.CreateRuleset(ctx, org, repo.Name, desired)
+			continue
+		}
+
+		clt.UpdateRuleset(ctx, org, repo.Name, existing[idx], desired)
+	}
+
+	for _, rs := range existing {
+		if !slices.Contains(managed, rs.Name) {
+			report.PrintWarn("ruleset '" + rs.Name + "' exists in github but not in manifest")
+			report.Println()
+		}
+	}
+
+	return nil
+}
+
+// ensureProtectedTags matches the repo's protected tag patterns to the
+// manifest, protecting missing patterns and removing ones not listed.
+func ensureProtectedTags(ctx context.Context, clt client.GitHubClient, org string, repo *gh_pb.Repository, fresh bool) error {
+	if len(repo.ProtectedTags) == 0 {
+		return nil
+	}
+
+	if !manages(ctx, "repo.protected_tags") {
+		return nil
+	}
+
+	var existing []*github.TagProtection
+	if !fresh {
+		var err error
+		existing, err = clt.ListTagProtection(ctx, org, repo.Name)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, p := range repo.ProtectedTags {
+		if slices.ContainsFunc(existing, func(tp *github.TagProtection) bool { return tp.GetPattern() == p }) {
+			report.PrintInfo("tags '" + p + "' protected")
+			report.Println()
+
+			continue
+		}
+
+		clt.ProtectTag(ctx, org, repo.Name, p)
+	}
+
+	for _, tp := range existing {
+		if !slices.Contains(repo.ProtectedTags, tp.GetPattern()) {
+			clt.UnprotectTag(ctx, org, repo.Name, tp)
+		}
+	}
+
+	return nil
+}
+
+// ensureAutolinks adds the manifest's autolinks missing from the repo and
+// removes the ones with other key prefixes. Github can not update autolinks,
+// so changed ones are removed and added again.
+func ensureAutolinks(ctx context.Context, clt client.GitHubClient, org string, repo *gh_pb.Repository, fresh bool) error {
+	if len(repo.Autolinks) == 0 {
+		return nil
+	}
+
+	if !manages(ctx, "repo.autolinks") {
+		return nil
+	}
+
+	var existing []*github.Autolink
+	if !fresh {
+		var err error
+		existing, err = clt.ListAutolinks(ctx, org, repo.Name)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, l := range repo.Autolinks {
+		alphanumeric := l.IsAlphanumeric == nil || l.GetIsAlphanumeric()
+
+		i := slices.IndexFunc(existing, func(al *github.Autolink) bool { return al.GetKeyPrefix() == l.KeyPrefix })
+		if i >= 0 {
+			al := existing[i]
+			if al.GetURLTemplate() == l.UrlTemplate && al.GetIsAlphanumeric() == alphanumeric {
+				report.PrintInfo("autolink '" + l.KeyPrefix + "' exists")
+				report.Println()
+
+				continue
+			}
+
+			clt.DeleteAutolink(ctx, org, repo.Name, al)
+		}
+
+		clt.AddAutolink(ctx, org, repo.Name, &github.AutolinkOptions{
+			KeyPrefix:      github.String(l.KeyPrefix),
+			URLTemplate:    github.String(l.UrlTemplate),
+			IsAlphanumeric: github.Bool(alphanumeric),
+		})
+	}
+
+	for _, al := range existing {
+		if !slices.ContainsFunc(repo.Autolinks, func(l *gh_pb.Autolink) bool { return l.KeyPrefix == al.GetKeyPrefix() }) {
+			clt.DeleteAutolink(ctx, org, repo.Name, al)
+		}
+	}
+
+	return nil
+}
+
+// ensureActionsPermissions sets whether GitHub Actions is enabled on the repo
+// and which actions it may run. The selected actions are only set when the
+// repo is, or is about to be, limited to selected actions.
+func ensureActionsPermissions(ctx context.Context, clt client.GitHubClient, org string, repo *gh_pb.Repository, fresh bool) error {
+	ap := repo.ActionsPermissions
+	if ap == nil {
+		return nil
+	}
+
+	if !manages(ctx, "repo.actions_permissions") {
+		return nil
+	}
+
+	current := &github.ActionsPermissionsRepository{}
+	if !fresh {
+		var err error
+		current, err = clt.GetActionsPermissions(ctx, org, repo.Name)
+		if err != nil {
+			return err
+		}
+	}
+
+	clt.SetActionsPermissions(ctx, org, repo.Name, current, &github.ActionsPermissionsRepository{
+		Enabled:        ap.Enabled,
+		AllowedActions: ap.AllowedActions,
+	})
+
+	policy := current.GetAllowedActions()
+	if ap.AllowedActions != nil {
+		policy = ap.GetAllowedActions()
+	}
+
+	if policy != "selected" {
+		return nil
+	}
+
+	// github only has selected actions to read once the repo is limited to
+	// them
+	allowed := &github.ActionsAllowed{}
+	if current.GetAllowedActions() == "selected" {
+		var err error
+		allowed, err = clt.GetActionsAllowed(ctx, org, repo.Name)
+		if err != nil {
+			return err
+		}
+	}
+
+	clt.SetActionsAllowed(ctx, org, repo.Name, allowed, buildActionsAllowed(ap.GithubOwnedAllowed, ap.VerifiedAllowed, ap.PatternsAllowed))
+
+	return nil
+}
+
+// buildActionsAllowed turns the selected actions of a manifest into what
+// github takes, leaving the patterns unset when none are listed so the ones in
+// github are kept.
+func buildActionsAllowed(githubOwned, verified *bool, patterns []string) *github.ActionsAllowed {
+	a := &github.ActionsAllowed{
+		GithubOwnedAllowed: githubOwned,
+		VerifiedAllowed:    verified,
+	}
+
+	if len(patterns) > 0 {
+		a.PatternsAllowed = patterns
+	}
+
+	return a
+}
+
+// ensureIssueLabels creates the manifest's issue labels missing from the repo
+// and updates the color and description of those that differ. Labels in
+// github that are not listed are removed when pruning and warned about
+// otherwise.
+func ensureIssueLabels(ctx context.Context, clt client.GitHubClient, org string, repo *gh_pb.Repository, fresh, prune bool) error {
+	if len(repo.IssueLabels) == 0 {
+		return nil
+	}
+
+	if !manages(ctx, "repo.issue_labels") {
+		return nil
+	}
+
+	var existing []*github.Label
+	if !fresh {
+		var err error
+		existing, err = clt.ListLabels(ctx, org, repo.Name)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, ml := range repo.IssueLabels {
+		desired := &github.Label{
+			Name:        github.String(ml.Name),
+			Color:       github.String(strings.ToLower(strings.TrimPrefix(ml.Color, "#"))),
+			Description: ml.Description,
+		}
+
+		idx := slices.IndexFunc(existing, func(l *github.Label) bool {
+			return strings.EqualFold(l.GetName(), ml.Name)
+		})
+
+		if idx < 0 {
+			clt.CreateLabel(ctx, org, repo.Name, desired)
+
+			continue
+		}
+
+		current := existing[idx]
+		if strings.EqualFold(current.GetColor(), desired.GetColor()) &&
+			(desired.Description == nil || current.GetDescription() == desired.GetDescription()) {
+			report.PrintInfo("label '" + ml.Name + "' exists")
+			report.Println()
+
+			continue
+		}
+
+		clt.EditLabel(ctx, org, repo.Name, current, desired)
+	}
+
+	for _, l := range existing {
+		if slices.ContainsFunc(repo.IssueLabels, func(ml *gh_pb.IssueLabel) bool {
+			return strings.EqualFold(ml.Name, l.GetName())
+		}) {
+			continue
+		}
+
+		if prune {
+			clt.DeleteLabel(ctx, org, repo.Name, l.GetName())
+		} else {
+			report.PrintWarn("label '" + l.GetName() + "' exists in github but not in manifest")
+			report.Println()
+		}
+	}
+
+	return nil
+}
+
+// ensureCollaborators gives the manifest's collaborators their permission on
+// the repo. Collaborators in github that are not listed are warned about, or
+// removed when pruning.
+func ensureCollaborators(ctx context.Context, clt client.GitHubClient, org string, repo *gh_pb.Repository, fresh, prune bool) error {
+	if len(repo.Collaborators) == 0 {
+		return nil
+	}
+
+	if !manages(ctx, "repo.collaborators") {
+		return nil
+	}
+
+	var existing []*github.User
+	var invites []*github.RepositoryInvitation
+	if !fresh {
+		var err error
+		existing, err = clt.ListCollaborators(ctx, org, repo.Name)
+		if err != nil {
+			return err
+		}
+
+		invites, err = clt.ListRepoInvitations(ctx, org, repo.Name)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, mc := range repo.Collaborators {
+		idx := slices.IndexFunc(existing, func(u *github.User) bool {
+			return strings.EqualFold(u.GetLogin(), mc.Username)
+		})
+
+		if idx >= 0 {
+			current := existing[idx].GetRoleName()
+			if current == mc.Permission {
+				report.PrintInfo("collaborator '" + mc.Username + "' has permission '" + mc.Permission + "'")
+				report.Println()
+
+				continue
+			}
+
+			clt.AddCollaborator(ctx, org, repo.Name, mc.Username, mc.Permission, current)
+
+			continue
+		}
+
+		if slices.ContainsFunc(invites, func(i *github.RepositoryInvitation) bool {
+			return strings.EqualFold(i.GetInvitee().GetLogin(), mc.Username)
+		}) {
+			report.PrintInfo("collaborator '" + mc.Username + "' already invited")
+			report.Println()
+
+			continue
+		}
+
+		clt.AddCollaborator(ctx, org, repo.Name, mc.Username, mc.Permission, "")
+	}
+
+	for _, u := range existing {
+		if slices.ContainsFunc(repo.Collaborators, func(mc *gh_pb.Collaborator) bool {
+			return strings.EqualFold(mc.Username, u.GetLogin())
+		}) {
+			continue
+		}
+
+		if prune {
+			clt.RemoveCollaborator(ctx, org, repo.Name, u.GetLogin())
+		} else {
+			report.PrintWarn("collaborator '" + u.GetLogin() + "' exists in github but not in manifest")
+			report.Println()
+		}
+	}
+
+	return nil
+}
+
+func buildRuleset(r *gh_pb.Ruleset) *github.Ruleset {
+	target := "branch"
+	if r.Target != nil {
+		target = r.GetTarget()
+	}
+
+	include := r.Include
+	if include == nil {
+		include = []string{}
+	}
+
+	exclude := r.Exclude
+	if exclude == nil {
+		exclude = []string{}
+	}
+
+	rs := &github.Ruleset{
+		Name:        r.Name,
+		Target:      github.String(target),
+		Enforcement: r.Enforcement,
+		Conditions: &github.RulesetConditions{
+			RefName: &github.RulesetRefConditionParameters{
+				Include: include,
+				Exclude: exclude,
+			},
+		},
+		Rules: []*github.RepositoryRule{},
+	}
+
+	rules := r.GetRules()
+
+	if rules.GetCreation() {
+		rs.Rules = append(rs.Rules, github.NewCreationRule())
+	}
+
+	if rules.GetDeletion() {
+		rs.Rules = append(rs.Rules, github.NewDeletionRule())
+	}
+
+	if rules.GetNonFastForward() {
+		rs.Rules = append(rs.Rules, github.NewNonFastForwardRule())
+	}
+
+	if rules.GetRequiredLinearHistory() {
+		rs.Rules = append(rs.Rules, github.NewRequiredLinearHistoryRule())
+	}
+
+	if rules.GetRequiredSignatures() {
+		rs.Rules = append(rs.Rules, github.NewRequiredSignaturesRule())
+	}
+
+	if pr := rules.GetPullRequest(); pr != nil {
+		rs.Rules = append(rs.Rules, github.NewPullRequestRule(&github.PullRequestRuleParameters{
+			DismissStaleReviewsOnPush:      pr.DismissStaleReviewsOnPush,
+			RequireCodeOwnerReview:         pr.RequireCodeOwnerReview,
+			RequireLastPushApproval:        pr.RequireLastPushApproval,
+			RequiredApprovingReviewCount:   int(pr.RequiredApprovingReviewCount),
+			RequiredReviewThreadResolution: pr.RequiredReviewThreadResolution,
+		}))
+	}
+
+	if len(rules.GetRequiredStatusChecks()) > 0 {
+		checks := []github.RuleRequiredStatusChecks{}
+		for _, c := range rules.GetRequiredStatusChecks() {
+			checks = append(checks, github.RuleRequiredStatusChecks{Context: c})
+		}
+
+		rs.Rules = append(rs.Rules, github.NewRequiredStatusChecksRule(&github.RequiredStatusChecksRuleParameters{
+			RequiredStatusChecks:             checks,
+			StrictRequiredStatusChecksPolicy: rules.GetStrictStatusChecks(),
+		}))
+	}
+
+	return rs
+}
+
+func buildWebhook(w *gh_pb.Webhook) (*github.Hook, error) {
+	config := map[string]interface{}{
+		"url":          w.Url,
+		"content_type": "json",
+	}
+
+	if w.ContentType != nil {
+		config["content_type"] = *w.ContentType
+	}
+
+	if w.SecretFromEnv != nil {
+		secret, err := secretValue("webhook "+w.Url, *w.SecretFromEnv, "")
+		if err != nil {
+			return nil, err
+		}
+
+		config["secret"] = secret
+	}
+
+	events := w.Events
+	if len(events) == 0 {
+		events = []string{"push"}
+	}
+
+	active := true
+	if w.Active != nil {
+		active = *w.Active
+	}
+
+	return &github.Hook{
+		Config: config,
+		Events: events,
+		Active: &active,
+	}, nil
+}
+
+func ensureRepoWatched(ctx context.Context, clt client.GitHubClient, org string, repo *gh_pb.Repository, fresh bool) error {
+	o, err := manifest.OrgFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	if o.WatchRepositories == nil {
+		return nil
+	}
+
+	if !manages(ctx, "repo.watch") {
+		return nil
+	}
+
+	// creating a repo subscribes the creator to it
+	watching := true
+	if !fresh {
+		sub, err := clt.GetRepoSubscription(ctx, org, repo.Name)
+		if err != nil {
+			return err
+		}
+
+		watching = sub.GetSubscribed()
+	}
+
+	if watching == *o.WatchRepositories {
+		report.PrintInfo(fmt.Sprintf("watch repo is '%t'", watching))
+		report.Println()
+		return nil
+	}
+
+	clt.SetRepoWatched(ctx, org, repo.Name, *o.WatchRepositories)
+
+	return nil
+}
+
+func buildRepoEdits(repo *gh_pb.Repository, ghr *github.Repository) *github.Repository {
+	edits := &github.Repository{}
+
+	if repo.Description != nil && !strings.EqualFold(ghr.GetDescription(), *repo.Description) {
+		edits.Description = repo.Description
+	}
+
+	if repo.Homepage != nil && ghr.GetHomepage() != *repo.Homepage {
+		edits.Homepage = repo.Homepage
+	}
+
+	if repo.Archived != nil && ghr.GetArchived() != *repo.Archived {
+		edits.Archived = repo.Archived
+	}
+
+	if repo.Visibility != nil {
+		if !strings.EqualFold(ghr.GetVisibility(), *repo.Visibility) {
+			edits.Visibility = repo.Visibility
+		}
+	} else if repo.Private != nil && ghr.GetPrivate() != *repo.Private {
+		edits.Private = repo.Private
+	}
+
+	if repo.DefaultBranch != nil && !strings.EqualFold(ghr.GetDefaultBranch(), *repo.DefaultBranch) {
+		edits.DefaultBranch = repo.DefaultBranch
+	}
+
+	if repo.HasIssues != nil && ghr.GetHasIssues() != *repo.HasIssues {
+		edits.HasIssues = repo.HasIssues
+	}
+
+	if repo.HasProjects != nil && ghr.GetHasProjects() != *repo.HasProjects {
+		edits.HasProjects = repo.HasProjects
+	}
+
+	if repo.HasWiki != nil && ghr.GetHasWiki() != *repo.HasWiki {
+		edits.HasWiki = repo.HasWiki
+	}
+
+	if repo.HasDiscussions != nil && ghr.GetHasDiscussions() != *repo.HasDiscussions {
+		edits.HasDiscussions = repo.HasDiscussions
+	}
+
+	if repo.AutoDeleteHeadBranches != nil && ghr.GetDeleteBranchOnMerge() != *repo.AutoDeleteHeadBranches {
+		edits.DeleteBranchOnMerge = repo.AutoDeleteHeadBranches
+	}
+
+	if repo.AllowAutoMerge != nil && ghr.GetAllowAutoMerge() != *repo.AllowAutoMerge {
+		edits.AllowAutoMerge = repo.AllowAutoMerge
+	}
+
+	return edits
+}
+
+func buildRepoState(repo *gh_pb.Repository) *github.Repository {
+	state := &github.Repository{
+		Name: &repo.Name,
+	}
+
+	if repo.Description != nil {
+		state.Description = repo.Description
+	}
+
+	if repo.Homepage != nil {
+		state.Homepage = repo.Homepage
+	}
+
+	if repo.Archived != nil {
+		state.Archived = repo.Archived
+	}
+
+	if repo.Visibility != nil {
+		state.Visibility = repo.Visibility
+	} else if repo.Private != nil {
+		state.Private = repo.Private
+	}
+
+	if repo.DefaultBranch != nil {
+		state.DefaultBranch = repo.DefaultBranch
+	}
+
+	if repo.HasIssues != nil {
+		state.HasIssues = repo.HasIssues
+	}
+
+	if repo.HasProjects != nil {
+		state.HasProjects = repo.HasProjects
+	}
+
+	if repo.HasWiki != nil {
+		state.HasWiki = repo.HasWiki
+	}
+
+	if repo.HasDiscussions != nil {
+		state.HasDiscussions = repo.HasDiscussions
+	}
+
+	if repo.AutoDeleteHeadBranches != nil {
+		state.DeleteBranchOnMerge = repo.AutoDeleteHeadBranches
+	}
+
+	if repo.AllowAutoMerge != nil {
+		state.AllowAutoMerge = repo.AllowAutoMerge
+	}
+
+	if repo.AutoInit != nil {
+		state.AutoInit = repo.AutoInit
+	}
+
+	if repo.LicenseTemplate != nil {
+		state.LicenseTemplate = repo.LicenseTemplate
+	}
+
+	if repo.GitignoreTemplate != nil {
+		state.GitignoreTemplate = repo.GitignoreTemplate
+	}
+
+	return state
+}
+
+func setTeamPermissions(ctx context.Context, clt client.GitHubClient, org string, repo *gh_pb.Repository, ghr *github.Repository) error {
+	if len(repo.Permissions) == 0 {
+		return nil
+	}
+
+	if !manages(ctx, "repo.teams") {
+		return nil
+	}
+
+	for p, teams := range repo.Permissions {
+		for _, t := range teams.Teams {
+			err := clt.AddRepoToTeam(ctx, org, strings.ToLower(t), repo.Name, p)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	// should remove teams without permissions
+	managed := map[string]struct{}{}
+	for _, ts := range repo.Permissions {
+		for _, t := range ts.Teams {
+			managed[strings.ToLower(t)] = struct{}{}
+		}
+	}
+
+	gts, err := clt.GetRepoTeams(ctx, org, repo.Name)
+	if err != nil {
+		return fmt.Errorf("remove unamaged teams: %w", err)
+	}
+
+	for _, gt := range gts {
+		if _, ok := managed[strings.ToLower(gt.GetName())]; ok {
+			continue
+		}
+
+		clt.RemoveRepoFromTeam(ctx, org, gt.GetSlug(), repo.Name)
+	}
+
+	return nil
+}
+
+func ensurePreReceiveHooks(ctx context.Context, clt client.GitHubClient, org string, repo *gh_pb.Repository) error {
+	if len(repo.PreReceiveHooks) == 0 {
+		return nil
+	}
+
+	if !manages(ctx, "repo.pre_receive_hooks") {
+		return nil
+	}
+
+	if !clt.IsEnterprise() {
+		report.PrintInfo("pre-receive hooks are only available on GitHub Enterprise Server, skipping")
+		report.Println()
+		return nil
+	}
+
+	ghhs, err := clt.GetPreReceiveHooks(ctx, org, repo.Name)
+	if err != nil {
+		return err
+	}
+
+	for _, h := range repo.PreReceiveHooks {
+		var ghh *github.PreReceiveHook
+		for _, gh := range ghhs {
+			if strings.EqualFold(gh.GetName(), h.Name) {
+				ghh = gh
+				break
+			}
+		}
+
+		if ghh == nil {
+			return fmt.Errorf("pre-receive hook '%s': %w", h.Name, client.ErrPreReceiveHookNotFound)
+		}
+
+		if strings.EqualFold(ghh.GetEnforcement(), h.Enforcement) {
+			report.PrintInfo("pre-receive hook '" + h.Name + "' enforcement is '" + h.Enforcement + "'")
+			report.Println()
+			continue
+		}
+
+		clt.SetPreReceiveHookEnforcement(ctx, org, repo.Name, ghh, h.Enforcement)
+	}
+
+	return nil
+}
+
+func ensureFiles(ctx context.Context, org string, repo *gh_pb.Repository, ghr *github.Repository) error {
+	// clone down repo
+	// copy file to expected location in repo
+	// if diff, commit and push PR
+
+	return nil
+}
+
+// ensureLicense commits the manifest's license when github detects a
+// different one in the repo, or none. Fresh repos created from the same
+// license template already have it.
+func ensureLicense(ctx context.Context, clt client.GitHubClient, org string, repo *gh_pb.Repository, fresh bool) error {
+	if repo.License == nil {
+		return nil
+	}
+
+	if !manages(ctx, "repo.license") {
+		return nil
+	}
+
+	var current *github.RepositoryLicense
+	if fresh {
+		if strings.EqualFold(repo.GetLicenseTemplate(), repo.GetLicense()) {
+			return nil
+		}
+	} else {
+		var err error
+		current, err = clt.GetLicense(ctx, org, repo.Name)
+		if err != nil {
+			return err
+		}
+	}
+
+	if current != nil && strings.EqualFold(current.GetLicense().GetSPDXID(), repo.GetLicense()) {
+		report.PrintInfo("license is '" + current.GetLicense().GetSPDXID() + "'")
+		report.Println()
+
+		return nil
+	}
+
+	clt.SetLicense(ctx, org, repo.Name, current, repo.GetLicense())
+
+	return nil
+}
+
+// expandProtectedBranches returns the repo's protected branch entries with
+// each pattern replaced by an entry for every branch in github matching it.
+// Branches named exactly keep their own entry over any pattern they match.
+func expandProtectedBranches(ctx context.Context, clt client.GitHubClient, org string, repo *gh_pb.Repository) ([]*gh_pb.Branch, error) {
+	var branches []*gh_pb.Branch
+	var ghbs []*github.Branch
+
+	for _, pb := range repo.ProtectedBranches {
+		if pb.Pattern == "" {
+			branches = append(branches, pb)
+			continue
+		}
+
+		if ghbs == nil {
+			var err error
+			ghbs, err = clt.GetBranches(ctx, org, repo.Name)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		matched := []string{}
+		for _, ghb := range ghbs {
+			ok, _ := path.Match(pb.Pattern, ghb.GetName())
+			if !ok {
+				continue
+			}
+
+			matched = append(matched, ghb.GetName())
+
+			if slices.ContainsFunc(branches, func(b *gh_pb.Branch) bool { return b.Name == ghb.GetName() }) ||
+				slices.ContainsFunc(repo.ProtectedBranches, func(b *gh_pb.Branch) bool { return b.Name == ghb.GetName() }) {
+				continue
+			}
+
+			branches = append(branches, &gh_pb.Branch{
+				Name:       ghb.GetName(),
+				Protection: pb.Protection,
+			})
+		}
+
+		report.PrintInfo("pattern '" + pb.Pattern + "' matches [" + strings.Join(matched, ", ") + "]")
+		report.Println()
+	}
+
+	return branches, nil
+}
+
+// setBranchProtection plans the branch's protection and signed commits. The
+// current protection is read once for both, unless the repo is fresh and so
+// has none to read.
+func setBranchProtection(ctx context.Context, clt client.GitHubClient, org string, repo *gh_pb.Repository, branch *gh_pb.Branch, defaultBranch string, fresh bool) error {
+	branch = withDefaultChecks(ctx, branch, defaultBranch)
+
+	requirePR := manages(ctx, "branch_protection.require_pr")
+	checks := manages(ctx, "branch_protection.checks_must_pass")
+	signed := branch.GetProtection() != nil && manages(ctx, "branch_protection.signed_commits")
+
+	if !requirePR && !checks && !signed {
+		return nil
+	}
+
+	var current *github.Protection
+	if !fresh {
+		var err error
+		current, err = clt.GetBranchProtection(ctx, org, repo.Name, branch.Name)
+		if err != nil && !errors.Is(err, client.ErrBranchProtectionNotFound) {
+			return err
+		}
+	}
+
+	// with neither managed the branch is left alone rather than protected
+	if requirePR || checks {
+		state := buildBranchProtectionState(branch)
+		keepUnmanagedProtection(current, state, requirePR, checks)
+
+		clt.ProtectBranch(ctx, org, repo.Name, branch.Name, current, state)
+	}
+
+	if signed {
+		clt.SetRequireSignedCommits(ctx, org, repo.Name, branch.Name, current, branch.GetProtection().GetSignedCommits())
+	}
+
+	return nil
+}
+
+// defaultBranchName is the repo's default branch, or main, github's default,
+// when the manifest leaves it out. An org that changed its default branch
+// name gets the org's default checks on the next run instead.
+func defaultBranchName(r *gh_pb.Repository) string {
+	if r.GetDefaultBranch() != "" {
+		return r.GetDefaultBranch()
+	}
+
+	return "main"
+}
+
+// withDefaultChecks adds the org's default required checks to the protection
+// of the repo's default branch, skipping any it already lists, and prints
+// which checks came from where. The manifest's branch is left as it is, as
+// protections can be shared between branches.
+func withDefaultChecks(ctx context.Context, branch *gh_pb.Branch, defaultBranch string) *gh_pb.Branch {
+	org, err := manifest.OrgFromContext(ctx)
+	if err != nil {
+		return branch
+	}
+
+	defaults := org.GetDefaults().GetRequiredChecks()
+	if len(defaults) == 0 || !strings.EqualFold(branch.Name, defaultBranch) {
+		return branch
+	}
+
+	if p := branch.GetProtection(); p.ChecksMustPass != nil && !p.GetChecksMustPass() {
+		return branch
+	}
+
+	b := proto.Clone(branch).(*gh_pb.Branch)
+	b.Protection.ChecksMustPass = github.Bool(true)
+
+	fromRepo := slices.Clone(b.Protection.RequiredChecks)
+	fromDefaults := []string{}
+	for _, c := range defaults {
+		if slices.ContainsFunc(b.Protection.RequiredChecks, func(rc string) bool { return strings.EqualFold(rc, c) }) {
+			continue
+		}
+
+		b.Protection.RequiredChecks = append(b.Protection.RequiredChecks, c)
+		fromDefaults = append(fromDefaults, c)
+	}
+
+	slices.Sort(fromRepo)
+	slices.Sort(fromDefaults)
+
+	report.PrintInfo("required checks on " + branch.Name + " from defaults [" + strings.Join(fromDefaults, ", ") + "], from repo [" + strings.Join(fromRepo, ", ") + "]")
+	report.Println()
+
+	return b
+}
+
+// keepUnmanagedProtection carries whether pull requests and status checks are
+// required over from the branch's current protection when those settings are
+// not managed, so the update leaves them as they are.
+func keepUnmanagedProtection(current *github.Protection, state *github.ProtectionRequest, requirePR, checks bool) {
+	if !requirePR {
+		state.RequiredPullRequestReviews = nil
+		if current.GetRequiredPullRequestReviews() != nil {
+			state.RequiredPullRequestReviews = &github.PullRequestReviewsEnforcementRequest{}
+		}
+	}
+
+	if !checks {
+		state.RequiredStatusChecks = nil
+		if rc := current.GetRequiredStatusChecks(); rc != nil {
+			state.RequiredStatusChecks = &github.RequiredStatusChecks{
+				Strict: rc.Strict,
+				Checks: rc.Checks,
+			}
+		}
+	}
+}
+
+func buildBranchProtectionState(branch *gh_pb.Branch) *github.ProtectionRequest {
+	state := &github.ProtectionRequest{}
+
+	if branch.Protection.RequirePr != nil && *branch.Protection.RequirePr {
+		state.RequiredPullRequestReviews = &github.PullRequestReviewsEnforcementRequest{}
+	}
+
+	if branch.Protection.ChecksMustPass != nil && *branch.Protection.ChecksMustPass {
+		state.RequiredStatusChecks = &github.RequiredStatusChecks{
+			Checks: []*github.RequiredStatusCheck{},
+		}
+
+		if len(branch.Protection.RequiredChecks) > 0 {
+			for _, c := range branch.Protection.RequiredChecks {
+				check := &github.RequiredStatusCheck{
+					Context: c,
+				}
+
+				if id, ok := branch.Protection.CheckAppIds[c]; ok {
+					check.AppID = github.Int64(id)
+				}
+
+				state.RequiredStatusChecks.Checks = append(state.RequiredStatusChecks.Checks, check)
 			}
 		}
 	}
@@ -0,0 +1,792 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/gomicro/concord/client"
+	"github.com/gomicro/concord/client/fakeclient"
+	gh_pb "github.com/gomicro/concord/github/v1"
+	"github.com/google/go-github/v56/github"
+	"github.com/spf13/cobra"
+	"golang.org/x/exp/slices"
+)
+
+func TestCreateRepoEmpty(t *testing.T) {
+	tests := []struct {
+		name     string
+		autoInit bool
+		want     []string
+	}{
+		{name: "empty repo skips protection"},
+		{name: "initialized repo is protected", autoInit: true, want: []string{"main"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out := captureReport(t)
+
+			// a repo being created has no protection to read
+			fc := fakeclient.New()
+			fc.Errs = map[string]error{"GetBranchProtection": errors.New("read protection of a repo being created")}
+
+			org := &gh_pb.Organization{Name: "acme"}
+			repo := &gh_pb.Repository{
+				Name:     "widgets",
+				AutoInit: github.Bool(tt.autoInit),
+				ProtectedBranches: []*gh_pb.Branch{
+					{Name: "main", Protection: &gh_pb.Protection{RequirePr: github.Bool(true)}},
+				},
+			}
+
+			err := createRepo(fakeCtx(fc, org), fc, org.Name, repo)
+			if err != nil {
+				t.Fatalf("create repo: %v", err)
+			}
+
+			if m := fc.Methods(); len(m) == 0 || m[0] != "CreateRepo" {
+				t.Errorf("writes = %v, want the repo created first", m)
+			}
+
+			var got []string
+			for _, c := range fc.CallsTo("ProtectBranch") {
+				got = append(got, c.Args[0].(string))
+			}
+
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("protected %v, want %v", got, tt.want)
+			}
+
+			warned := strings.Contains(out.String(), "repo will be created empty")
+			if warned == tt.autoInit {
+				t.Errorf("warned about an empty repo = %v, want %v:\n%s", warned, !tt.autoInit, out)
+			}
+		})
+	}
+}
+
+func TestApplyReposDryRunReadsNothingFromNewRepo(t *testing.T) {
+	out := captureReport(t)
+
+	var reads []string
+
+	clt := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("dry run sent %s %s", r.Method, r.URL.Path)
+		}
+
+		switch {
+		case r.URL.Path == "/api/v3/orgs/acme":
+			w.Write([]byte(`{"login": "acme", "public_repos": 1}`)) //nolint: errcheck
+		case r.URL.Path == "/api/v3/orgs/acme/repos":
+			w.Write([]byte(`[{"name": "gadgets"}]`)) //nolint: errcheck
+		case strings.HasPrefix(r.URL.Path, "/api/v3/repos/acme/widgets/"):
+			// past looking the repo up, nothing can be read from one that
+			// does not exist
+			reads = append(reads, r.URL.Path)
+			http.NotFound(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+
+	file := writeTestManifest(t, `organization:
+  name: acme
+  repositories:
+    - name: widgets
+      auto_init: true
+      topics:
+        - go
+      protected_branches:
+        - name: main
+          protection:
+            require_pr: true
+            signed_commits: true
+`)
+
+	ctx := client.WithGitHubClient(context.Background(), clt)
+
+	err := executeTestCmd(t, ctx, NewApplyReposCmd(io.Discard), "--file", file, "--dry")
+	if err != nil {
+		t.Fatalf("apply repos: %v", err)
+	}
+
+	if len(reads) != 0 {
+		t.Errorf("read %v from a repo being created", reads)
+	}
+
+	for _, want := range []string{"protecting branch main", "setting require signed commits to 'true'"} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("output is missing %q:\n%s", want, out)
+		}
+	}
+}
+
+func TestEnsureTopics(t *testing.T) {
+	tests := []struct {
+		name    string
+		current []string
+		topics  []string
+		clear   bool
+		want    []string
+		set     bool
+	}{
+		{
+			name:    "unset leaves topics alone",
+			current: []string{"go", "backend"},
+		},
+		{
+			name:    "explicitly cleared removes them",
+			current: []string{"go", "backend"},
+			clear:   true,
+			want:    []string{},
+			set:     true,
+		},
+		{
+			name:    "cleared with none left is unchanged",
+			current: nil,
+			clear:   true,
+		},
+		{
+			name:    "mixed case topics match what github stores",
+			current: []string{"backend", "go"},
+			topics:  []string{"Go", "Backend"},
+		},
+		{
+			name:    "mixed case topics are sent lowercased",
+			current: []string{"go"},
+			topics:  []string{"Go", "Backend"},
+			want:    []string{"backend", "go"},
+			set:     true,
+		},
+		{
+			name:    "changed topics are replaced",
+			current: []string{"go"},
+			topics:  []string{"go", "backend"},
+			want:    []string{"backend", "go"},
+			set:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			captureReport(t)
+
+			var got []string
+			set := false
+
+			clt := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPut || r.URL.Path != "/api/v3/repos/acme/widgets/topics" {
+					t.Errorf("unexpected request %v %v", r.Method, r.URL.Path)
+					http.NotFound(w, r)
+					return
+				}
+
+				var body struct {
+					Names []string `json:"names"`
+				}
+				json.NewDecoder(r.Body).Decode(&body) //nolint: errcheck
+
+				got, set = body.Names, true
+
+				w.Write([]byte(`{"names":[]}`)) //nolint: errcheck
+			}))
+
+			repo := &gh_pb.Repository{Name: "widgets", Topics: tt.topics, ClearTopics: github.Bool(tt.clear)}
+			ghr := &github.Repository{Name: github.String("widgets"), Topics: tt.current}
+
+			ensureTopics(context.Background(), clt, "acme", repo, ghr)
+
+			err := clt.Flush(context.Background())
+			if err != nil {
+				t.Fatalf("flush: %v", err)
+			}
+
+			if set != tt.set {
+				t.Fatalf("set topics = %v, want %v", set, tt.set)
+			}
+
+			if tt.set && !slices.Equal(got, tt.want) {
+				t.Errorf("set topics to %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReposRunStopsWhenCancelled(t *testing.T) {
+	fc := fakeclient.New()
+	fc.Repos = []*github.Repository{{Name: github.String("widgets")}, {Name: github.String("gadgets")}}
+	fc.Errs = map[string]error{"GetRepo": context.Canceled}
+
+	org := &gh_pb.Organization{
+		Name:         "acme",
+		Repositories: []*gh_pb.Repository{{Name: "widgets"}, {Name: "gadgets"}},
+	}
+
+	cancelled := func(cmd *cobra.Command, args []string) error {
+		ctx, cancel := context.WithCancel(cmd.Context())
+		cancel()
+		cmd.SetContext(ctx)
+
+		return reposRun(cmd, args)
+	}
+
+	// even when continuing on error, a cancelled run goes no further
+	out, err := runFake(t, fc, org, cancelled, "--continue-on-error")
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("err = %v, want %v", err, context.Canceled)
+	}
+
+	if !strings.Contains(out, "widgets") {
+		t.Errorf("widgets was not reconciled:\n%s", out)
+	}
+
+	if strings.Contains(out, "gadgets") {
+		t.Errorf("gadgets was reconciled after the run was cancelled:\n%s", out)
+	}
+}
+
+func TestEnsureRepoArchiveOrdering(t *testing.T) {
+	tests := []struct {
+		name     string
+		archived bool
+		want     *bool
+		updates  []string
+	}{
+		{name: "unarchived before edits", archived: true, want: github.Bool(false), updates: []string{"archived=false", "description"}},
+		{name: "archived after edits", want: github.Bool(true), updates: []string{"description", "archived=true"}},
+		{name: "staying archived is left alone", archived: true, want: github.Bool(true)},
+		{name: "left out is left alone", archived: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out := captureReport(t)
+
+			fc := fakeclient.New()
+			fc.Repos = []*github.Repository{{
+				Name:        github.String("widgets"),
+				Description: github.String("old"),
+				Archived:    github.Bool(tt.archived),
+			}}
+
+			org := &gh_pb.Organization{Name: "acme"}
+			repo := &gh_pb.Repository{Name: "widgets", Description: github.String("new"), Archived: tt.want}
+
+			err := ensureRepo(fakeCtx(fc, org), fc, org.Name, repo, false)
+			if err != nil {
+				t.Fatalf("ensure repo: %v", err)
+			}
+
+			var updates []string
+			for _, c := range fc.CallsTo("UpdateRepo") {
+				edits := c.Args[1].(*github.Repository)
+
+				switch {
+				case edits.Archived != nil && edits.Description != nil:
+					t.Errorf("archive sent along with other edits: %v", edits)
+				case edits.Archived != nil:
+					updates = append(updates, fmt.Sprintf("archived=%v", edits.GetArchived()))
+				case edits.Description != nil:
+					updates = append(updates, "description")
+				}
+			}
+
+			if !slices.Equal(updates, tt.updates) {
+				t.Errorf("updates = %v, want %v", updates, tt.updates)
+			}
+
+			methods := fc.Methods()
+			if len(tt.updates) == 0 && len(methods) > 0 {
+				t.Errorf("writes = %v, want none to an archived repo", methods)
+			}
+
+			if len(tt.updates) > 0 && tt.updates[0] == "archived=false" && methods[0] != "UpdateRepo" {
+				t.Errorf("writes = %v, want unarchiving first", methods)
+			}
+
+			unarchiving := strings.Contains(out.String(), "unarchiving it before the other edits")
+			if unarchiving != (len(tt.updates) > 0 && tt.updates[0] == "archived=false") {
+				t.Errorf("dry run output does not describe the unarchive step as its own:\n%s", out.String())
+			}
+
+			if len(tt.updates) > 0 && tt.updates[len(tt.updates)-1] == "archived=true" && methods[len(methods)-1] != "UpdateRepo" {
+				t.Errorf("writes = %v, want archiving last", methods)
+			}
+		})
+	}
+}
+
+func TestEnsureCollaborators(t *testing.T) {
+	tests := []struct {
+		name   string
+		fresh  bool
+		prune  bool
+		writes []string
+		warned bool
+	}{
+		{
+			name:   "existing repo",
+			writes: []string{"AddCollaborator bob admin write", "AddCollaborator dave read "},
+			warned: true,
+		},
+		{
+			name:   "pruned",
+			prune:  true,
+			writes: []string{"AddCollaborator bob admin write", "AddCollaborator dave read ", "RemoveCollaborator eve"},
+		},
+		{
+			name:   "new repo",
+			fresh:  true,
+			writes: []string{"AddCollaborator alice write ", "AddCollaborator bob admin ", "AddCollaborator carol read ", "AddCollaborator dave read "},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out := captureReport(t)
+
+			fc := fakeclient.New()
+			fc.Collaborators = map[string][]*github.User{
+				"widgets": {
+					{Login: github.String("alice"), RoleName: github.String("write")},
+					{Login: github.String("Bob"), RoleName: github.String("write")},
+					{Login: github.String("eve"), RoleName: github.String("read")},
+				},
+			}
+			fc.RepoInvitations = map[string][]*github.RepositoryInvitation{
+				"widgets": {{Invitee: &github.User{Login: github.String("carol")}}},
+			}
+
+			org := &gh_pb.Organization{Name: "acme"}
+			repo := &gh_pb.Repository{
+				Name: "widgets",
+				Collaborators: []*gh_pb.Collaborator{
+					{Username: "alice", Permission: "write"},
+					{Username: "bob", Permission: "admin"},
+					{Username: "carol", Permission: "read"},
+					{Username: "dave", Permission: "read"},
+				},
+			}
+
+			err := ensureCollaborators(fakeCtx(fc, org), fc, org.Name, repo, tt.fresh, tt.prune)
+			if err != nil {
+				t.Fatalf("ensure collaborators: %v", err)
+			}
+
+			var writes []string
+			for _, c := range fc.Calls {
+				w := c.Method
+				for _, a := range c.Args {
+					w += " " + a.(string)
+				}
+
+				writes = append(writes, w)
+			}
+
+			if !slices.Equal(writes, tt.writes) {
+				t.Errorf("writes = %q, want %q", writes, tt.writes)
+			}
+
+			warned := strings.Contains(out.String(), "collaborator 'eve' exists in github but not in manifest")
+			if warned != tt.warned {
+				t.Errorf("warned about eve = %v, want %v:\n%s", warned, tt.warned, out)
+			}
+		})
+	}
+}
+
+func TestReposRunReposFrom(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "repos.txt")
+
+	err := os.WriteFile(file, []byte("widgets\n# batch two\n\n  gizmos  \nunknown\n"), 0o600)
+	if err != nil {
+		t.Fatalf("write repos: %v", err)
+	}
+
+	fc := fakeclient.New()
+	fc.Repos = []*github.Repository{
+		{Name: github.String("widgets")},
+		{Name: github.String("gadgets")},
+		{Name: github.String("gizmos")},
+		{Name: github.String("stray")},
+	}
+
+	org := &gh_pb.Organization{
+		Name:         "acme",
+		Repositories: []*gh_pb.Repository{{Name: "widgets"}, {Name: "gadgets"}, {Name: "gizmos"}},
+	}
+
+	out, err := runFake(t, fc, org, reposRun, "--repos-from", file)
+	if err != nil {
+		t.Fatalf("repos run: %v", err)
+	}
+
+	for _, want := range []string{"widgets", "gizmos", "repo unknown is listed in " + file + " but not in the manifest"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output missing %q:\n%s", want, out)
+		}
+	}
+
+	// repos left out of the list, managed or not, are not looked at
+	for _, skipped := range []string{"gadgets", "stray"} {
+		if strings.Contains(out, skipped) {
+			t.Errorf("output mentions %s:\n%s", skipped, out)
+		}
+	}
+}
+
+func TestEnsureIssueLabels(t *testing.T) {
+	tests := []struct {
+		name   string
+		prune  bool
+		writes []string
+		warned bool
+	}{
+		{name: "existing labels", writes: []string{"EditLabel docs", "CreateLabel feature"}, warned: true},
+		{name: "pruned", prune: true, writes: []string{"EditLabel docs", "CreateLabel feature", "DeleteLabel stale"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out := captureReport(t)
+
+			fc := fakeclient.New()
+			fc.Labels = map[string][]*github.Label{
+				"widgets": {
+					{Name: github.String("bug"), Color: github.String("d73a4a"), Description: github.String("Something isn't working")},
+					{Name: github.String("Docs"), Color: github.String("0075ca")},
+					{Name: github.String("stale"), Color: github.String("ffffff")},
+				},
+			}
+
+			org := &gh_pb.Organization{Name: "acme"}
+			repo := &gh_pb.Repository{
+				Name: "widgets",
+				IssueLabels: []*gh_pb.IssueLabel{
+					// a description left out keeps github's
+					{Name: "bug", Color: "#D73A4A"},
+					{Name: "docs", Color: "0075ca", Description: github.String("Documentation")},
+					{Name: "feature", Color: "a2eeef"},
+				},
+			}
+
+			err := ensureIssueLabels(fakeCtx(fc, org), fc, org.Name, repo, false, tt.prune)
+			if err != nil {
+				t.Fatalf("ensure issue labels: %v", err)
+			}
+
+			var writes []string
+			for _, c := range fc.Calls {
+				var name string
+				switch a := c.Args[len(c.Args)-1].(type) {
+				case string:
+					name = a
+				case *github.Label:
+					name = a.GetName()
+				}
+
+				writes = append(writes, c.Method+" "+name)
+			}
+
+			if !slices.Equal(writes, tt.writes) {
+				t.Errorf("writes = %q, want %q", writes, tt.writes)
+			}
+
+			if !strings.Contains(out.String(), "label 'bug' exists") {
+				t.Errorf("unchanged label not reported:\n%s", out)
+			}
+
+			warned := strings.Contains(out.String(), "label 'stale' exists in github but not in manifest")
+			if warned != tt.warned {
+				t.Errorf("warned about stale = %v, want %v:\n%s", warned, tt.warned, out)
+			}
+		})
+	}
+}
+
+func TestWithDefaultChecks(t *testing.T) {
+	tests := []struct {
+		name     string
+		defaults []string
+		branch   *gh_pb.Branch
+		want     []string
+		report   string
+	}{
+		{
+			name:     "merged without duplicates",
+			defaults: []string{"ci/test", "ci/build"},
+			branch:   &gh_pb.Branch{Name: "main", Protection: &gh_pb.Protection{RequiredChecks: []string{"ci/lint", "CI/Build"}}},
+			want:     []string{"CI/Build", "ci/lint", "ci/test"},
+			report:   "required checks on main from defaults [ci/test], from repo [CI/Build, ci/lint]",
+		},
+		{
+			name:     "in any order",
+			defaults: []string{"ci/build", "ci/test"},
+			branch:   &gh_pb.Branch{Name: "main", Protection: &gh_pb.Protection{RequiredChecks: []string{"CI/Build", "ci/lint"}}},
+			want:     []string{"CI/Build", "ci/lint", "ci/test"},
+			report:   "required checks on main from defaults [ci/test], from repo [CI/Build, ci/lint]",
+		},
+		{
+			name:     "none in the repo",
+			defaults: []string{"ci/build"},
+			branch:   &gh_pb.Branch{Name: "main", Protection: &gh_pb.Protection{RequirePr: github.Bool(true)}},
+			want:     []string{"ci/build"},
+			report:   "required checks on main from defaults [ci/build], from repo []",
+		},
+		{
+			name:     "other branches",
+			defaults: []string{"ci/build"},
+			branch:   &gh_pb.Branch{Name: "develop", Protection: &gh_pb.Protection{}},
+		},
+		{
+			name:     "checks opted out",
+			defaults: []string{"ci/build"},
+			branch:   &gh_pb.Branch{Name: "main", Protection: &gh_pb.Protection{ChecksMustPass: github.Bool(false)}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out := captureReport(t)
+
+			org := &gh_pb.Organization{Name: "acme", Defaults: &gh_pb.Defaults{RequiredChecks: tt.defaults}}
+			before := slices.Clone(tt.branch.Protection.RequiredChecks)
+
+			got := withDefaultChecks(fakeCtx(fakeclient.New(), org), tt.branch, "main")
+
+			checks := slices.Clone(got.Protection.RequiredChecks)
+			slices.Sort(checks)
+
+			if !slices.Equal(checks, tt.want) && len(checks)+len(tt.want) > 0 {
+				t.Errorf("checks = %v, want %v", checks, tt.want)
+			}
+
+			if tt.want != nil && !got.Protection.GetChecksMustPass() {
+				t.Error("checks are not required to pass")
+			}
+
+			if !strings.Contains(out.String(), tt.report) {
+				t.Errorf("output missing %q:\n%s", tt.report, out)
+			}
+
+			if !slices.Equal(tt.branch.Protection.RequiredChecks, before) {
+				t.Errorf("manifest branch changed to %v", tt.branch.Protection.RequiredChecks)
+			}
+		})
+	}
+}
+
+func TestDefaultBranchName(t *testing.T) {
+	if got := defaultBranchName(&gh_pb.Repository{Name: "widgets"}); got != "main" {
+		t.Errorf("default branch = %s, want main when left out", got)
+	}
+
+	if got := defaultBranchName(&gh_pb.Repository{Name: "widgets", DefaultBranch: github.String("trunk")}); got != "trunk" {
+		t.Errorf("default branch = %s, want trunk", got)
+	}
+}
+
+func TestEnsureActionsPermissions(t *testing.T) {
+	tests := []struct {
+		name    string
+		ap      *gh_pb.ActionsPermissions
+		current string
+		fresh   bool
+		managed []string
+		reads   []string
+		writes  []string
+	}{
+		{name: "no block"},
+		{
+			name:    "all",
+			ap:      &gh_pb.ActionsPermissions{AllowedActions: github.String("all")},
+			current: "selected",
+			reads:   []string{"GetActionsPermissions"},
+			writes:  []string{"SetActionsPermissions"},
+		},
+		{
+			name:    "newly selected",
+			ap:      &gh_pb.ActionsPermissions{AllowedActions: github.String("selected"), PatternsAllowed: []string{"acme/*"}},
+			current: "all",
+			reads:   []string{"GetActionsPermissions"},
+			writes:  []string{"SetActionsPermissions", "SetActionsAllowed"},
+		},
+		{
+			name:    "already selected",
+			ap:      &gh_pb.ActionsPermissions{VerifiedAllowed: github.Bool(true)},
+			current: "selected",
+			reads:   []string{"GetActionsPermissions", "GetActionsAllowed"},
+			writes:  []string{"SetActionsPermissions", "SetActionsAllowed"},
+		},
+		{
+			name:   "fresh repo",
+			ap:     &gh_pb.ActionsPermissions{Enabled: github.Bool(true), AllowedActions: github.String("selected")},
+			fresh:  true,
+			writes: []string{"SetActionsPermissions", "SetActionsAllowed"},
+		},
+		{
+			name:    "unmanaged",
+			ap:      &gh_pb.ActionsPermissions{AllowedActions: github.String("all")},
+			managed: []string{"repo.description"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fc := fakeclient.New()
+			fc.ActionsPermissions = map[string]*github.ActionsPermissionsRepository{
+				"widgets": {Enabled: github.Bool(true), AllowedActions: github.String(tt.current)},
+			}
+
+			org := &gh_pb.Organization{Name: "acme", ManagedFields: tt.managed}
+			repo := &gh_pb.Repository{Name: "widgets", ActionsPermissions: tt.ap}
+
+			// reads are not recorded, so failing them shows which were made
+			errRead := errors.New("read")
+			var reads []string
+			for _, m := range []string{"GetActionsPermissions", "GetActionsAllowed"} {
+				fc.Errs = map[string]error{m: errRead}
+
+				err := ensureActionsPermissions(fakeCtx(fc, org), fc, org.Name, repo, tt.fresh)
+				if errors.Is(err, errRead) {
+					reads = append(reads, m)
+				}
+			}
+
+			if !slices.Equal(reads, tt.reads) {
+				t.Errorf("reads = %v, want %v", reads, tt.reads)
+			}
+
+			fc.Errs = nil
+			fc.Calls = nil
+
+			err := ensureActionsPermissions(fakeCtx(fc, org), fc, org.Name, repo, tt.fresh)
+			if err != nil {
+				t.Fatalf("ensure actions permissions: %v", err)
+			}
+
+			if !slices.Equal(fc.Methods(), tt.writes) {
+				t.Errorf("writes = %v, want %v", fc.Methods(), tt.writes)
+			}
+
+			for _, c := range fc.CallsTo("SetActionsAllowed") {
+				desired := c.Args[1].(*github.ActionsAllowed)
+				if !slices.Equal(desired.PatternsAllowed, tt.ap.PatternsAllowed) {
+					t.Errorf("patterns = %v, want %v", desired.PatternsAllowed, tt.ap.PatternsAllowed)
+				}
+			}
+		})
+	}
+}
+
+func TestEnsureRepoWatched(t *testing.T) {
+	tests := []struct {
+		name     string
+		fresh    bool
+		watching bool
+		calls    int
+	}{
+		{name: "stops watching a watched repo", watching: true, calls: 1},
+		{name: "leaves an unwatched repo alone", watching: false, calls: 0},
+		{name: "stops watching a repo it creates", fresh: true, calls: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			captureReport(t)
+
+			fc := fakeclient.New()
+			fc.Subscriptions = map[string]*github.Subscription{
+				"widgets": {Subscribed: github.Bool(tt.watching)},
+			}
+
+			org := &gh_pb.Organization{Name: "acme", WatchRepositories: github.Bool(false)}
+			repo := &gh_pb.Repository{Name: "widgets"}
+
+			err := ensureRepoWatched(fakeCtx(fc, org), fc, org.Name, repo, tt.fresh)
+			if err != nil {
+				t.Fatalf("ensure watched: %v", err)
+			}
+
+			calls := fc.CallsTo("SetRepoWatched")
+			if len(calls) != tt.calls {
+				t.Fatalf("set watched %d times, want %d", len(calls), tt.calls)
+			}
+
+			if len(calls) > 0 && calls[0].Args[0] != false {
+				t.Errorf("set watched to %v, want false", calls[0].Args[0])
+			}
+		})
+	}
+}
+
+func TestEnsurePreReceiveHooks(t *testing.T) {
+	tests := []struct {
+		name       string
+		enterprise bool
+		hooks      []*gh_pb.PreReceiveHook
+		want       []string
+		wantErr    error
+	}{
+		{
+			name:  "skipped on github.com",
+			hooks: []*gh_pb.PreReceiveHook{{Name: "no-secrets", Enforcement: "enabled"}},
+		},
+		{
+			name:       "sets changed enforcement by hook name",
+			enterprise: true,
+			hooks: []*gh_pb.PreReceiveHook{
+				{Name: "No-Secrets", Enforcement: "testing"},
+				{Name: "signed-off", Enforcement: "enabled"},
+			},
+			want: []string{"no-secrets=testing"},
+		},
+		{
+			name:       "unknown hook",
+			enterprise: true,
+			hooks:      []*gh_pb.PreReceiveHook{{Name: "missing", Enforcement: "enabled"}},
+			wantErr:    client.ErrPreReceiveHookNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			captureReport(t)
+
+			fc := fakeclient.New()
+			fc.Enterprise = tt.enterprise
+			fc.PreReceiveHooks = map[string][]*github.PreReceiveHook{
+				"widgets": {
+					{ID: github.Int64(42), Name: github.String("no-secrets"), Enforcement: github.String("disabled")},
+					{ID: github.Int64(43), Name: github.String("signed-off"), Enforcement: github.String("enabled")},
+				},
+			}
+
+			org := &gh_pb.Organization{Name: "acme"}
+			repo := &gh_pb.Repository{Name: "widgets", PreReceiveHooks: tt.hooks}
+
+			err := ensurePreReceiveHooks(fakeCtx(fc, org), fc, org.Name, repo)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("err = %v, want %v", err, tt.wantErr)
+			}
+
+			var got []string
+			for _, c := range fc.CallsTo("SetPreReceiveHookEnforcement") {
+				got = append(got, c.Args[0].(*github.PreReceiveHook).GetName()+"="+c.Args[1].(string))
+			}
+
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("set %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
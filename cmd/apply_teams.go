@@ -1,7 +1,9 @@
 package cmd
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	"io"
 	"os"
 	"strings"
@@ -12,6 +14,7 @@ import (
 	"github.com/gomicro/concord/report"
 	"github.com/google/go-github/v56/github"
 	"github.com/spf13/cobra"
+	"golang.org/x/exp/slices"
 )
 
 func init() {
@@ -33,56 +36,55 @@ func NewApplyTeamsCmd(out io.Writer) *cobra.Command {
 
 func applyTeamsRun(cmd *cobra.Command, args []string) error {
 	file := cmd.Flags().Lookup("file").Value.String()
-	cmd.SetContext(manifest.WithManifest(cmd.Context(), file))
-
-	dry := strings.EqualFold(cmd.Flags().Lookup("dry").Value.String(), "true")
-
-	ctx := cmd.Context()
-
-	org, err := manifest.OrgFromContext(ctx)
+	ctx, err := manifest.WithManifest(cmd.Context(), file)
 	if err != nil {
 		return handleError(cmd, err)
 	}
 
+	cmd.SetContext(ctx)
+
+	dry := dryRun(cmd)
+
 	clt, err := client.ClientFromContext(ctx)
 	if err != nil {
 		return handleError(cmd, err)
 	}
 
-	exists, err := clt.OrgExists(ctx, org.Name)
+	err = eachOrg(cmd, func() error { return teamsRun(cmd, args) })
 	if err != nil {
 		return handleError(cmd, err)
 	}
 
-	if !exists {
-		return handleError(cmd, errors.New("organization does not exist"))
-	}
-
-	report.PrintHeader("Org")
-	report.Println()
-
-	err = teamsRun(cmd, args)
-	if err != nil {
-		return handleError(cmd, err)
+	if dry {
+		err = clt.RecordDryRun()
+		if err != nil {
+			return handleError(cmd, err)
+		}
 	}
 
 	if !dry {
-		if !confirm(cmd, "Apply changes? (y/n): ") {
+		if !confirmDestructive(cmd, clt.Pending()) {
 			return nil
 		}
 
-		err = clt.Apply()
+		err = clt.Flush(ctx)
 		if err != nil {
 			return handleError(cmd, err)
 		}
 	}
 
+	report.PrintSummary(dry)
+
 	return nil
 }
 
 func teamsRun(cmd *cobra.Command, args []string) error {
 	ctx := cmd.Context()
 
+	if !manages(ctx, "teams") {
+		return nil
+	}
+
 	org, err := manifest.OrgFromContext(ctx)
 	if err != nil {
 		return handleError(cmd, err)
@@ -102,13 +104,50 @@ func teamsRun(cmd *cobra.Command, args []string) error {
 		return handleError(cmd, err)
 	}
 
-	missing, managed, unmanaged := getTeamsBreakdown(org.Teams, tms)
+	missing, managed, unmanaged := getTeamsBreakdown(teamNames(org.Teams), tms)
+
+	// only the targeted teams are looked at, the rest are not reported
+	if names, targeted := targetsFor(cmd, "team"); targeted {
+		missing = onlyTargeted(missing, names)
+		managed = onlyTargeted(managed, names)
+		unmanaged = nil
+	}
+
+	ordered, err := orderTeams(org.Teams)
+	if err != nil {
+		return handleError(cmd, err)
+	}
+
+	var groups []*github.IDPGroup
+	if slices.ContainsFunc(org.Teams, func(t *gh_pb.Team) bool { return t.IdpGroup != nil }) {
+		groups, err = clt.GetIDPGroups(ctx, org.Name)
+		if err != nil {
+			return handleError(cmd, err)
+		}
+	}
+
+	for _, t := range ordered {
+		if !managedTeam(missing, t.Name) {
+			continue
+		}
+
+		mt := t.Name
 
-	for _, mt := range missing {
 		report.PrintHeader(mt)
 		report.Println()
 
-		clt.CreateTeam(ctx, org.Name, mt)
+		clt.CreateTeam(ctx, org.Name, mt, t.GetParent())
+
+		if t.IdpGroup != nil {
+			err := ensureTeamIDPGroup(ctx, clt, org.Name, t, groups, true)
+			if err != nil {
+				return handleError(cmd, err)
+			}
+
+			report.Println()
+
+			continue
+		}
 
 		missing, _, _ := getTeamMembersBreakdown(mt, org.People, nil)
 
@@ -126,24 +165,41 @@ func teamsRun(cmd *cobra.Command, args []string) error {
 		report.PrintInfo("team exists in github")
 		report.Println()
 
-		ms, err := clt.GetTeamMembers(ctx, org.Name, mt)
-		if err != nil {
-			return handleError(cmd, err)
-		}
+		warnTeamParent(org.Teams, tms, mt)
 
-		missing, managed, unmanaged := getTeamMembersBreakdown(mt, org.People, ms)
-		for _, m := range missing {
-			clt.InviteTeamMember(ctx, org.GetName(), mt, m)
-		}
+		planned := report.Planned()
 
-		for _, m := range managed {
-			report.PrintInfo(m + " exists in team")
-			report.Println()
+		if t := manifestTeam(org.Teams, mt); t.IdpGroup != nil {
+			err := ensureTeamIDPGroup(ctx, clt, org.Name, t, groups, false)
+			if err != nil {
+				return handleError(cmd, err)
+			}
+		} else {
+			ms, err := clt.GetTeamMembers(ctx, org.Name, mt)
+			if err != nil {
+				return handleError(cmd, err)
+			}
+
+			missing, managed, unmanaged := getTeamMembersBreakdown(mt, org.People, ms)
+			for _, m := range missing {
+				clt.InviteTeamMember(ctx, org.GetName(), mt, m)
+			}
+
+			for _, m := range managed {
+				report.PrintInfo(m + " exists in team")
+				report.Println()
+			}
+
+			for _, m := range unmanaged {
+				report.PrintWarn(m + " exists in team but not in manifest")
+				report.Println()
+			}
 		}
 
-		for _, m := range unmanaged {
-			report.PrintWarn(m + " exists in team but not in manifest")
-			report.Println()
+		if report.Planned() > planned {
+			report.Count("Teams", report.Updated, 1)
+		} else {
+			report.Count("Teams", report.Unchanged, 1)
 		}
 
 		report.Println()
@@ -159,9 +215,160 @@ func teamsRun(cmd *cobra.Command, args []string) error {
 		report.Println()
 	}
 
+	report.Count("Teams", report.Created, len(missing))
+	report.Count("Teams", report.Unmanaged, len(unmanaged))
+
+	return nil
+}
+
+var (
+	errTeamCycle        = errors.New("teams have a parent cycle")
+	errIDPGroupNotFound = errors.New("idp group not found")
+)
+
+// manifestTeam finds the manifest's team with the name github has for it.
+func manifestTeam(teams []*gh_pb.Team, name string) *gh_pb.Team {
+	i := slices.IndexFunc(teams, func(t *gh_pb.Team) bool { return strings.EqualFold(t.Name, name) })
+	if i < 0 {
+		return &gh_pb.Team{Name: name}
+	}
+
+	return teams[i]
+}
+
+// ensureTeamIDPGroup syncs the team's membership from the identity provider
+// group the manifest names, reporting the groups it is synced from instead
+// when they differ. Fresh teams are being created and have none yet.
+func ensureTeamIDPGroup(ctx context.Context, clt client.GitHubClient, org string, team *gh_pb.Team, groups []*github.IDPGroup, fresh bool) error {
+	i := slices.IndexFunc(groups, func(g *github.IDPGroup) bool { return strings.EqualFold(g.GetGroupName(), team.GetIdpGroup()) })
+	if i < 0 {
+		return fmt.Errorf("team %s: %w: '%s'", team.Name, errIDPGroupNotFound, team.GetIdpGroup())
+	}
+
+	if fresh {
+		clt.SetTeamIDPGroup(ctx, org, team.Name, nil, groups[i])
+		return nil
+	}
+
+	current, err := clt.GetTeamIDPGroups(ctx, org, team.Name)
+	if err != nil {
+		return err
+	}
+
+	if len(current) == 1 && current[0].GetGroupID() == groups[i].GetGroupID() {
+		report.PrintInfo("members are synced from idp group '" + groups[i].GetGroupName() + "'")
+		report.Println()
+
+		return nil
+	}
+
+	names := []string{}
+	for _, g := range current {
+		names = append(names, g.GetGroupName())
+	}
+
+	clt.SetTeamIDPGroup(ctx, org, team.Name, names, groups[i])
+
 	return nil
 }
 
+func teamNames(teams []*gh_pb.Team) []string {
+	names := []string{}
+	for _, t := range teams {
+		names = append(names, t.Name)
+	}
+
+	return names
+}
+
+// orderTeams sorts teams so every parent comes before its children, and teams
+// at the same depth by name. Parents missing from the manifest are expected to
+// already exist in github.
+func orderTeams(teams []*gh_pb.Team) ([]*gh_pb.Team, error) {
+	byName := map[string]*gh_pb.Team{}
+	for _, t := range teams {
+		byName[strings.ToLower(t.Name)] = t
+	}
+
+	depths := map[string]int{}
+
+	var depth func(t *gh_pb.Team, path []string) (int, error)
+	depth = func(t *gh_pb.Team, path []string) (int, error) {
+		key := strings.ToLower(t.Name)
+		if d, ok := depths[key]; ok {
+			return d, nil
+		}
+
+		path = append(path, t.Name)
+		for _, p := range path[:len(path)-1] {
+			if strings.EqualFold(p, t.Name) {
+				return 0, fmt.Errorf("%w: %s", errTeamCycle, strings.Join(path, " -> "))
+			}
+		}
+
+		parent, ok := byName[strings.ToLower(t.GetParent())]
+		if t.Parent == nil || !ok {
+			depths[key] = 0
+			return 0, nil
+		}
+
+		d, err := depth(parent, path)
+		if err != nil {
+			return 0, err
+		}
+
+		depths[key] = d + 1
+
+		return d + 1, nil
+	}
+
+	for _, t := range teams {
+		_, err := depth(t, nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	ordered := slices.Clone(teams)
+	slices.SortStableFunc(ordered, func(a, b *gh_pb.Team) int {
+		da, db := depths[strings.ToLower(a.Name)], depths[strings.ToLower(b.Name)]
+		if da != db {
+			return da - db
+		}
+
+		return strings.Compare(strings.ToLower(a.Name), strings.ToLower(b.Name))
+	})
+
+	return ordered, nil
+}
+
+// warnTeamParent warns when an existing team is nested differently in github
+// than in the manifest. Parents are only set when a team is created.
+func warnTeamParent(teams []*gh_pb.Team, ghTeams []*github.Team, name string) {
+	idx := slices.IndexFunc(teams, func(t *gh_pb.Team) bool { return strings.EqualFold(t.Name, name) })
+	gidx := slices.IndexFunc(ghTeams, func(t *github.Team) bool { return strings.EqualFold(t.GetName(), name) })
+	if idx < 0 || gidx < 0 {
+		return
+	}
+
+	want := teams[idx].GetParent()
+	got := ghTeams[gidx].GetParent().GetName()
+	if strings.EqualFold(want, got) {
+		return
+	}
+
+	if got == "" {
+		got = "no parent"
+	}
+
+	if want == "" {
+		want = "no parent"
+	}
+
+	report.PrintWarn("team has parent " + got + " in github but " + want + " in manifest")
+	report.Println()
+}
+
 func getTeamsBreakdown(manifest []string, teams []*github.Team) (missing []string, managed []string, unmanaged []string) {
 	for _, t := range teams {
 		if managedTeam(manifest, t.GetName()) {
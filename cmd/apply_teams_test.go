@@ -0,0 +1,124 @@
+package cmd
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/gomicro/concord/client"
+	gh_pb "github.com/gomicro/concord/github/v1"
+	"github.com/google/go-github/v56/github"
+	"github.com/spf13/cobra"
+	"golang.org/x/exp/slices"
+)
+
+func TestOrderTeams(t *testing.T) {
+	tests := []struct {
+		name    string
+		teams   []*gh_pb.Team
+		want    []string
+		wantErr error
+	}{
+		{
+			name: "children after their parents",
+			teams: []*gh_pb.Team{
+				{Name: "backend", Parent: github.String("engineering")},
+				{Name: "api", Parent: github.String("backend")},
+				{Name: "engineering"},
+			},
+			want: []string{"engineering", "backend", "api"},
+		},
+		{
+			name: "same depth by name",
+			teams: []*gh_pb.Team{
+				{Name: "web", Parent: github.String("engineering")},
+				{Name: "Ops"},
+				{Name: "backend", Parent: github.String("engineering")},
+				{Name: "engineering"},
+			},
+			want: []string{"engineering", "Ops", "backend", "web"},
+		},
+		{
+			name: "parent already in github",
+			teams: []*gh_pb.Team{
+				{Name: "backend", Parent: github.String("engineering")},
+				{Name: "api", Parent: github.String("backend")},
+			},
+			want: []string{"backend", "api"},
+		},
+		{
+			name: "cycle",
+			teams: []*gh_pb.Team{
+				{Name: "a", Parent: github.String("c")},
+				{Name: "b", Parent: github.String("a")},
+				{Name: "c", Parent: github.String("b")},
+			},
+			wantErr: errTeamCycle,
+		},
+		{
+			name:    "own parent",
+			teams:   []*gh_pb.Team{{Name: "a", Parent: github.String("A")}},
+			wantErr: errTeamCycle,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ordered, err := orderTeams(tt.teams)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("err = %v, want %v", err, tt.wantErr)
+			}
+
+			got := teamNames(ordered)
+			if tt.wantErr == nil && !slices.Equal(got, tt.want) {
+				t.Errorf("ordered %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTeamsRunCreatesParentsFirst(t *testing.T) {
+	out := captureReport(t)
+
+	ctx := testCtx(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v3/orgs/acme/teams" {
+			http.NotFound(w, r)
+			return
+		}
+
+		w.Write([]byte(`[]`)) //nolint: errcheck
+	}), `schema_version: 2
+organization:
+  name: acme
+  teams:
+    - name: api
+      parent: backend
+    - name: backend
+      parent: engineering
+    - name: engineering
+`)
+
+	err := executeTestCmd(t, ctx, &cobra.Command{Use: "run", RunE: teamsRun})
+	if err != nil {
+		t.Fatalf("teams run: %v", err)
+	}
+
+	clt, _ := client.ClientFromContext(ctx)
+
+	var created []string
+	for _, c := range clt.Pending() {
+		created = append(created, c.Target)
+	}
+
+	want := []string{"engineering", "backend", "api"}
+	if !slices.Equal(created, want) {
+		t.Errorf("created %v, want %v", created, want)
+	}
+
+	for _, want := range []string{"create team backend under engineering", "create team api under backend"} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("output is missing %q:\n%s", want, out.String())
+		}
+	}
+}
@@ -0,0 +1,59 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gomicro/concord/client"
+)
+
+func TestCheckRateBudget(t *testing.T) {
+	reset := time.Date(2026, 1, 1, 15, 4, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		remaining int
+		limited   bool
+		changes   int
+		warn      bool
+	}{
+		{name: "nothing queued", limited: true},
+		{name: "enough remaining", remaining: 4000, limited: true, changes: 2},
+		{name: "too few remaining", remaining: 5, limited: true, changes: 2, warn: true},
+		{name: "not limited", changes: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out := captureReport(t)
+
+			clt := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if !tt.limited || r.URL.Path != "/api/v3/rate_limit" {
+					http.NotFound(w, r)
+					return
+				}
+
+				w.Header().Set("Content-Type", "application/json")
+				fmt.Fprintf(w, `{"resources": {"core": {"limit": 5000, "remaining": %d, "reset": %d}}}`, tt.remaining, reset.Unix())
+			}))
+
+			for i := 0; i < tt.changes; i++ {
+				clt.Add(client.Change{Resource: "repo", Action: "create", Org: "acme", Repo: "widgets"}, func() error { return nil })
+			}
+
+			err := checkRateBudget(context.Background(), clt)
+			if err != nil {
+				t.Fatalf("check rate budget: %v", err)
+			}
+
+			warned := strings.Contains(out.String(), "requests remaining, the limit resets at "+reset.Local().Format(time.Kitchen))
+			if warned != tt.warn {
+				t.Errorf("warned = %v, want %v:\n%s", warned, tt.warn, out.String())
+			}
+		})
+	}
+}
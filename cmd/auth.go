@@ -47,7 +47,7 @@ func NewAuthCmd(out io.Writer, browserFunc func(string) error) *cobra.Command {
 
 func authRun(browserFunc func(string) error) func(*cobra.Command, []string) error {
 	return func(cmd *cobra.Command, args []string) error {
-		ctx, cancel := context.WithCancel(context.Background())
+		ctx, cancel := context.WithCancel(cmd.Context())
 		defer cancel()
 
 		listener, err := net.Listen("tcp", "127.0.0.1:0")
@@ -107,8 +107,14 @@ func authRun(browserFunc func(string) error) func(*cobra.Command, []string) erro
 			return fmt.Errorf("auth: %w", err)
 		}
 
-		tkn := <-token
-		close(token)
+		var tkn string
+		select {
+		case tkn = <-token:
+			close(token)
+		case <-ctx.Done():
+			cmd.SilenceUsage = true
+			return fmt.Errorf("auth: %w", ctx.Err())
+		}
 
 		c, err := config.ParseFromFile()
 		if err != nil {
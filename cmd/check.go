@@ -0,0 +1,83 @@
+package cmd
+
+import (
+	"errors"
+	"io"
+	"os"
+
+	"github.com/gomicro/concord/manifest"
+	"github.com/gomicro/concord/report"
+	"github.com/spf13/cobra"
+)
+
+var checkCmd = NewCheckCmd(os.Stdout)
+
+func init() {
+	rootCmd.AddCommand(checkCmd)
+}
+
+func NewCheckCmd(out io.Writer) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:               "check",
+		Short:             "Check parts of an org configuration for drift",
+		Long:              `Compare parts of an org configuration against github and print the differences, without changing anything`,
+		PersistentPreRunE: setupClient,
+	}
+
+	cmd.SetOut(out)
+
+	return cmd
+}
+
+// checkManifest returns the manifest to check, given either as the only
+// argument or with the file flag.
+func checkManifest(cmd *cobra.Command, args []string) string {
+	if len(args) > 0 {
+		return args[0]
+	}
+
+	return cmd.Flags().Lookup("file").Value.String()
+}
+
+// checkSection reconciles one section of the manifest against github with the
+// run given, printing what would change but never applying it.
+func checkSection(cmd *cobra.Command, args []string, run func(*cobra.Command, []string) error) error {
+	ctx, err := manifest.WithManifest(cmd.Context(), checkManifest(cmd, args))
+	if err != nil {
+		return handleError(cmd, err)
+	}
+
+	cmd.SetContext(ctx)
+
+	// a table replaces the per-repo output, which is dropped while the rows
+	// are collected
+	table := tableFormat(cmd)
+	if table {
+		complianceRows = &[]complianceRow{}
+		report.SetOutput(io.Discard)
+	}
+
+	// the planned changes are only printed, check never flushes them
+	err = eachOrg(cmd, func() error { return run(cmd, nil) })
+
+	if table {
+		report.SetOutput(cmd.OutOrStdout())
+	}
+
+	if err != nil && !errors.Is(err, errReposFailed) {
+		return handleError(cmd, err)
+	}
+
+	if table {
+		werr := writeComplianceTable(cmd.OutOrStdout(), *complianceRows)
+		if werr != nil {
+			return handleError(cmd, werr)
+		}
+
+		return handleError(cmd, err)
+	}
+
+	report.PrintSummary(true)
+
+	return handleError(cmd, err)
+}
@@ -0,0 +1,42 @@
+package cmd
+
+import (
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+	"golang.org/x/exp/slices"
+)
+
+func init() {
+	checkCmd.AddCommand(NewCheckAuthCmd(os.Stdout))
+}
+
+func NewCheckAuthCmd(out io.Writer) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "auth",
+		Short: "Check credentials before a run",
+		Long:  `Check the token is valid and can see the manifest's organization, printing the API endpoint, the authenticated user, and the remaining rate limit budget. Nothing is changed, and it fails when any of them is not usable.`,
+		Args:  cobra.NoArgs,
+		RunE:  checkAuthRun,
+	}
+
+	cmd.SetOut(out)
+
+	return cmd
+}
+
+// authChecks names the doctor checks needed to know a run can authenticate
+// and reach the org.
+var authChecks = []string{"base url", "token", "rate limit", "organization"}
+
+func checkAuthRun(cmd *cobra.Command, args []string) error {
+	checks := []doctorCheck{}
+	for _, c := range doctorChecks {
+		if slices.Contains(authChecks, c.name) {
+			checks = append(checks, c)
+		}
+	}
+
+	return runDoctorChecks(cmd, "Auth", checks)
+}
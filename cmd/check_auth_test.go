@@ -0,0 +1,92 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/gomicro/concord/client/fakeclient"
+	gh_pb "github.com/gomicro/concord/github/v1"
+)
+
+func TestCheckAuthRun(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "concord.yml")
+
+	err := os.WriteFile(file, []byte("organization:\n  name: acme\n"), 0o600)
+	if err != nil {
+		t.Fatalf("write manifest: %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		breaks func(fc *fakeclient.Client)
+		want   []string
+		failed bool
+	}{
+		{
+			name:   "usable",
+			breaks: func(fc *fakeclient.Client) {},
+			want: []string{
+				"pass base url: https://api.github.com/",
+				"pass token: authenticated as concord-bot",
+				"pass rate limit: 4999 of 5000 requests remaining",
+				"pass organization: acme",
+			},
+		},
+		{
+			name: "enterprise",
+			breaks: func(fc *fakeclient.Client) {
+				fc.URL = "https://ghes.example.com/api/v3/"
+				fc.Enterprise = true
+			},
+			want: []string{"pass base url: https://ghes.example.com/api/v3/"},
+		},
+		{
+			name: "misconfigured enterprise",
+			breaks: func(fc *fakeclient.Client) {
+				fc.URL = "https://ghes.example.com/"
+				fc.Enterprise = true
+			},
+			want:   []string{"fail base url: https://ghes.example.com/ does not look like an Enterprise Server API endpoint"},
+			failed: true,
+		},
+		{
+			name:   "bad token",
+			breaks: func(fc *fakeclient.Client) { fc.Token = nil },
+			want:   []string{"fail token:"},
+			failed: true,
+		},
+		{
+			name:   "budget exhausted",
+			breaks: func(fc *fakeclient.Client) { fc.Rate.Remaining = 0 },
+			want:   []string{"fail rate limit: budget exhausted"},
+			failed: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fc := healthyFake()
+			tt.breaks(fc)
+
+			out, err := runFake(t, fc, &gh_pb.Organization{Name: "acme"}, checkAuthRun, "--file", file)
+			if (err != nil) != tt.failed {
+				t.Fatalf("err = %v, want failed %v", err, tt.failed)
+			}
+
+			for _, w := range tt.want {
+				if !strings.Contains(out, w) {
+					t.Errorf("output missing %q:\n%s", w, out)
+				}
+			}
+
+			// only what a run needs to authenticate is checked
+			for _, skipped := range []string{"api connectivity", "token scopes", "clock skew"} {
+				if strings.Contains(out, skipped) {
+					t.Errorf("output has the %s check:\n%s", skipped, out)
+				}
+			}
+		})
+	}
+}
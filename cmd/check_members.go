@@ -0,0 +1,30 @@
+package cmd
+
+import (
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	checkCmd.AddCommand(NewCheckMembersCmd(os.Stdout))
+}
+
+func NewCheckMembersCmd(out io.Writer) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "members [manifest]",
+		Short: "Check org members",
+		Long:  `Check org members and pending invitations against github without touching the org, teams, or repos`,
+		Args:  cobra.MaximumNArgs(1),
+		RunE:  checkMembersRun,
+	}
+
+	cmd.SetOut(out)
+
+	return cmd
+}
+
+func checkMembersRun(cmd *cobra.Command, args []string) error {
+	return checkSection(cmd, args, membersRun)
+}
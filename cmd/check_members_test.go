@@ -0,0 +1,63 @@
+package cmd
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"golang.org/x/exp/slices"
+)
+
+func TestCheckMembersSetsUpClient(t *testing.T) {
+	captureReport(t)
+
+	var (
+		mu    sync.Mutex
+		paths []string
+	)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		paths = append(paths, r.URL.Path)
+		mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.URL.Path == "/api/v3/orgs/acme" {
+			w.Write([]byte(`{"login": "acme"}`)) //nolint: errcheck
+			return
+		}
+
+		w.Write([]byte(`[]`)) //nolint: errcheck
+	}))
+	t.Cleanup(srv.Close)
+
+	file := filepath.Join(t.TempDir(), "concord.yml")
+
+	err := os.WriteFile(file, []byte("organization:\n  name: acme\n"), 0o600)
+	if err != nil {
+		t.Fatalf("write manifest: %v", err)
+	}
+
+	// run the way a standalone check members is, with only the check
+	// command's pre-run to add the client
+	check := NewCheckCmd(io.Discard)
+	check.AddCommand(NewCheckMembersCmd(io.Discard))
+
+	err = executeTestCmd(t, context.Background(), check, "members", file, "--token", "tkn", "--base-url", srv.URL)
+	if err != nil {
+		t.Fatalf("check members: %v", err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	if !slices.Contains(paths, "/api/v3/orgs/acme/members") {
+		t.Errorf("members were not read from github, requests made: %v", paths)
+	}
+}
@@ -0,0 +1,30 @@
+package cmd
+
+import (
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	checkCmd.AddCommand(NewCheckOrgCmd(os.Stdout))
+}
+
+func NewCheckOrgCmd(out io.Writer) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "org [manifest]",
+		Short: "Check org level configuration",
+		Long:  `Check the org profile, permissions, secrets, and variables against github without touching repos, teams, or members`,
+		Args:  cobra.MaximumNArgs(1),
+		RunE:  checkOrgRun,
+	}
+
+	cmd.SetOut(out)
+
+	return cmd
+}
+
+func checkOrgRun(cmd *cobra.Command, args []string) error {
+	return checkSection(cmd, args, orgRun)
+}
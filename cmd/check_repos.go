@@ -0,0 +1,30 @@
+package cmd
+
+import (
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	checkCmd.AddCommand(NewCheckReposCmd(os.Stdout))
+}
+
+func NewCheckReposCmd(out io.Writer) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "repos [manifest]",
+		Short: "Check repo configuration",
+		Long:  `Check repos against github without touching the org, teams, or members`,
+		Args:  cobra.MaximumNArgs(1),
+		RunE:  checkReposRun,
+	}
+
+	cmd.SetOut(out)
+
+	return cmd
+}
+
+func checkReposRun(cmd *cobra.Command, args []string) error {
+	return checkSection(cmd, args, reposRun)
+}
@@ -0,0 +1,128 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/gomicro/concord/client"
+	"github.com/spf13/cobra"
+)
+
+// complianceRow is one repo's line in the table printed by the check
+// commands with the table format.
+type complianceRow struct {
+	repo      string
+	private   string
+	protected string
+	checks    string
+	drift     string
+}
+
+// complianceRows collects a row for every repo checked while a table is being
+// rendered, and is nil otherwise.
+var complianceRows *[]complianceRow
+
+// tableFormat reports whether the check table was asked for with the format
+// flag.
+func tableFormat(cmd *cobra.Command) bool {
+	return strings.EqualFold(cmd.Root().PersistentFlags().Lookup("format").Value.String(), "table")
+}
+
+// isCheckCmd reports whether the command is one of the check commands. It
+// goes by name, as checkCmd itself is set up with the flag checks that call
+// this.
+func isCheckCmd(cmd *cobra.Command) bool {
+	for c := cmd; c.HasParent(); c = c.Parent() {
+		if c.Name() == "check" && c.Parent() == c.Root() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// recordCompliance adds a row for a repo to the check table, when one is
+// being rendered, reading its visibility and default branch protection from
+// github. Repos that do not exist yet get a row with only their drift.
+func recordCompliance(ctx context.Context, clt client.GitHubClient, org, repo string, drifted bool, failed error) error {
+	if complianceRows == nil {
+		return nil
+	}
+
+	row := complianceRow{
+		repo:      repo,
+		private:   "-",
+		protected: "-",
+		checks:    "-",
+		drift:     "no",
+	}
+
+	switch {
+	case failed != nil:
+		row.drift = "failed"
+	case drifted:
+		row.drift = "yes"
+	}
+
+	ghr, err := clt.GetRepo(ctx, org, repo)
+	if err != nil {
+		if !errors.Is(err, client.ErrRepoNotFound) {
+			return err
+		}
+
+		row.drift = "missing"
+		*complianceRows = append(*complianceRows, row)
+
+		return nil
+	}
+
+	row.private = yesNo(ghr.GetVisibility() != "public")
+	row.protected = "no"
+
+	p, err := clt.GetBranchProtection(ctx, org, repo, ghr.GetDefaultBranch())
+	if err != nil && !errors.Is(err, client.ErrBranchProtectionNotFound) {
+		return err
+	}
+
+	if p != nil {
+		row.protected = "yes"
+
+		if rc := p.GetRequiredStatusChecks(); rc != nil && len(rc.Checks) > 0 {
+			checks := make([]string, 0, len(rc.Checks))
+			for _, c := range rc.Checks {
+				checks = append(checks, c.Context)
+			}
+
+			row.checks = strings.Join(checks, ",")
+		}
+	}
+
+	*complianceRows = append(*complianceRows, row)
+
+	return nil
+}
+
+// writeComplianceTable writes the check table with a row per repo, aligned
+// into columns.
+func writeComplianceTable(w io.Writer, rows []complianceRow) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(tw, "REPO\tPRIVATE\tPROTECTED DEFAULT\tREQUIRED CHECKS\tDRIFT")
+	for _, r := range rows {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n", r.repo, r.private, r.protected, r.checks, r.drift)
+	}
+
+	return tw.Flush()
+}
+
+func yesNo(b bool) string {
+	if b {
+		return "yes"
+	}
+
+	return "no"
+}
@@ -0,0 +1,78 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/gomicro/concord/client/fakeclient"
+	gh_pb "github.com/gomicro/concord/github/v1"
+	"github.com/google/go-github/v56/github"
+)
+
+func TestComplianceTable(t *testing.T) {
+	fc := fakeclient.New()
+	fc.Repos = []*github.Repository{
+		{Name: github.String("widgets"), Visibility: github.String("private"), DefaultBranch: github.String("main")},
+		{Name: github.String("gadgets"), Visibility: github.String("public"), DefaultBranch: github.String("trunk")},
+		{Name: github.String("gizmos"), Visibility: github.String("internal"), DefaultBranch: github.String("main")},
+	}
+	fc.Protections = map[string]*github.Protection{
+		"widgets/main": {
+			RequiredStatusChecks: &github.RequiredStatusChecks{
+				Checks: []*github.RequiredStatusCheck{{Context: "ci/build"}, {Context: "ci/test"}},
+			},
+		},
+		"gizmos/main": {},
+	}
+
+	rows := []complianceRow{}
+	complianceRows = &rows
+	t.Cleanup(func() { complianceRows = nil })
+
+	ctx := fakeCtx(fc, &gh_pb.Organization{Name: "acme"})
+
+	for _, r := range []struct {
+		name    string
+		drifted bool
+		failed  error
+	}{
+		{name: "widgets"},
+		{name: "gadgets", drifted: true},
+		{name: "gizmos", failed: errors.New("boom")},
+		{name: "doohickeys", drifted: true},
+	} {
+		err := recordCompliance(ctx, fc, "acme", r.name, r.drifted, r.failed)
+		if err != nil {
+			t.Fatalf("record %s: %v", r.name, err)
+		}
+	}
+
+	var out bytes.Buffer
+
+	err := writeComplianceTable(&out, rows)
+	if err != nil {
+		t.Fatalf("write table: %v", err)
+	}
+
+	want := `REPO        PRIVATE  PROTECTED DEFAULT  REQUIRED CHECKS   DRIFT
+widgets     yes      yes                ci/build,ci/test  no
+gadgets     no       no                 -                 yes
+gizmos      yes      yes                -                 failed
+doohickeys  -        -                  -                 missing
+`
+
+	if out.String() != want {
+		t.Errorf("table =\n%s\nwant\n%s", out.String(), want)
+	}
+}
+
+func TestComplianceNotRecordedWithoutTable(t *testing.T) {
+	fc := fakeclient.New()
+	fc.Errs = map[string]error{"GetRepo": errors.New("read without a table")}
+
+	err := recordCompliance(fakeCtx(fc, &gh_pb.Organization{Name: "acme"}), fc, "acme", "widgets", false, nil)
+	if err != nil {
+		t.Errorf("record: %v", err)
+	}
+}
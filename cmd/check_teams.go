@@ -0,0 +1,30 @@
+package cmd
+
+import (
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	checkCmd.AddCommand(NewCheckTeamsCmd(os.Stdout))
+}
+
+func NewCheckTeamsCmd(out io.Writer) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "teams [manifest]",
+		Short: "Check team configuration",
+		Long:  `Check teams and their members against github without touching the org, org members, or repos`,
+		Args:  cobra.MaximumNArgs(1),
+		RunE:  checkTeamsRun,
+	}
+
+	cmd.SetOut(out)
+
+	return cmd
+}
+
+func checkTeamsRun(cmd *cobra.Command, args []string) error {
+	return checkSection(cmd, args, teamsRun)
+}
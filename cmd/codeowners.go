@@ -0,0 +1,115 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/gomicro/concord/client"
+	"github.com/gomicro/concord/report"
+	"golang.org/x/exp/slices"
+)
+
+// checkCodeowners warns when a branch requires code owner review but the
+// CODEOWNERS file github uses for it is missing or names owners that do not
+// exist, either of which leaves the requirement with nobody to satisfy it. It
+// only reads from github.
+func checkCodeowners(ctx context.Context, clt client.GitHubClient, org, repo, branch string) error {
+	ghpb, err := clt.GetBranchProtection(ctx, org, repo, branch)
+	if err != nil {
+		if errors.Is(err, client.ErrBranchProtectionNotFound) {
+			return nil
+		}
+
+		return err
+	}
+
+	if !ghpb.GetRequiredPullRequestReviews().RequireCodeOwnerReviews {
+		return nil
+	}
+
+	content, path, err := clt.GetCodeowners(ctx, org, repo, branch)
+	if err != nil {
+		return err
+	}
+
+	if path == "" {
+		report.PrintWarn(branch + " requires code owner review but has no CODEOWNERS file")
+		report.Println()
+
+		return nil
+	}
+
+	var teams []string
+	for _, owner := range codeowners(content) {
+		switch {
+		// email owners can not be looked up
+		case !strings.HasPrefix(owner, "@"):
+			continue
+
+		case strings.Contains(owner, "/"):
+			o, slug, _ := strings.Cut(strings.TrimPrefix(owner, "@"), "/")
+			if !strings.EqualFold(o, org) {
+				report.PrintWarn(path + " names team " + owner + " from outside the org")
+				report.Println()
+
+				continue
+			}
+
+			if teams == nil {
+				tms, err := clt.GetTeams(ctx, org)
+				if err != nil {
+					return err
+				}
+
+				teams = []string{}
+				for _, t := range tms {
+					teams = append(teams, strings.ToLower(t.GetSlug()))
+				}
+			}
+
+			if !slices.Contains(teams, strings.ToLower(slug)) {
+				report.PrintWarn(path + " names unknown team " + owner)
+				report.Println()
+			}
+
+		default:
+			exists, err := clt.UserExists(ctx, strings.TrimPrefix(owner, "@"))
+			if err != nil {
+				return err
+			}
+
+			if !exists {
+				report.PrintWarn(path + " names unknown user " + owner)
+				report.Println()
+			}
+		}
+	}
+
+	return nil
+}
+
+// codeowners returns every distinct owner named in a CODEOWNERS file, in the
+// order they first appear.
+func codeowners(content string) []string {
+	owners := []string{}
+	for _, line := range strings.Split(content, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		if i := strings.Index(line, " #"); i >= 0 {
+			line = line[:i]
+		}
+
+		fields := strings.Fields(line)
+		for _, f := range fields[1:] {
+			if !slices.Contains(owners, f) {
+				owners = append(owners, f)
+			}
+		}
+	}
+
+	return owners
+}
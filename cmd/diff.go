@@ -0,0 +1,164 @@
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/gomicro/concord/client"
+	"github.com/gomicro/concord/manifest"
+	"github.com/gomicro/concord/report"
+	"github.com/spf13/cobra"
+)
+
+var errDiffFormat = errors.New("format must be one of diff, report, or json")
+
+func init() {
+	rootCmd.AddCommand(NewDiffCmd(os.Stdout))
+}
+
+func NewDiffCmd(out io.Writer) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:               "diff [manifest]",
+		Short:             "Print the differences between an org configuration and github",
+		Long:              `Compare a whole org configuration against github and print what would change, without changing anything. The diff format prints unified diff style +/- lines grouped by resource, report prints the same output as check, and json prints the changes for scripts.`,
+		Args:              cobra.MaximumNArgs(1),
+		PersistentPreRunE: setupClient,
+		RunE:              diffRun,
+	}
+
+	cmd.Flags().String("format", "diff", "how to print the differences, one of diff, report, or json")
+
+	cmd.SetOut(out)
+
+	return cmd
+}
+
+func diffRun(cmd *cobra.Command, args []string) error {
+	format := strings.ToLower(cmd.Flags().Lookup("format").Value.String())
+	if format != "diff" && format != "report" && format != "json" {
+		return handleError(cmd, fmt.Errorf("%w, got '%s'", errDiffFormat, format))
+	}
+
+	ctx, err := manifest.WithManifest(cmd.Context(), checkManifest(cmd, args))
+	if err != nil {
+		return handleError(cmd, err)
+	}
+
+	cmd.SetContext(ctx)
+
+	clt, err := client.ClientFromContext(ctx)
+	if err != nil {
+		return handleError(cmd, err)
+	}
+
+	// the other formats are rendered from the planned changes afterwards, so
+	// the prose printed while reconciling is dropped
+	if format != "report" {
+		report.SetOutput(io.Discard)
+	}
+
+	err = reconcile(cmd, nil)
+
+	report.SetOutput(cmd.OutOrStdout())
+
+	if err != nil && !errors.Is(err, errReposFailed) {
+		return handleError(cmd, err)
+	}
+
+	switch format {
+	case "report":
+		report.PrintSummary(true)
+	case "json":
+		writeErr := writeDiffJSON(cmd.OutOrStdout(), clt.Planned())
+		if writeErr != nil {
+			return handleError(cmd, writeErr)
+		}
+	default:
+		writeDiff(cmd.OutOrStdout(), clt.Planned())
+	}
+
+	return handleError(cmd, err)
+}
+
+func writeDiffJSON(w io.Writer, changes []client.PlannedChange) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+
+	err := enc.Encode(changes)
+	if err != nil {
+		return fmt.Errorf("marshal diff: %w", err)
+	}
+
+	return nil
+}
+
+// writeDiff prints the changes as a unified diff from github to the manifest,
+// with a hunk for the org and for each repo in the order they were planned.
+// Field updates become a - line with the current value and a + line with the
+// desired one, other changes a single line signed by their action.
+func writeDiff(w io.Writer, changes []client.PlannedChange) {
+	if len(changes) == 0 {
+		return
+	}
+
+	fmt.Fprintln(w, "--- github")
+	fmt.Fprintln(w, "+++ manifest")
+
+	hunk := ""
+	for _, c := range changes {
+		h := c.Org
+		if c.Repo != "" {
+			h += "/" + c.Repo
+		}
+
+		if h != hunk {
+			hunk = h
+			fmt.Fprintln(w, "@@ "+hunk+" @@")
+		}
+
+		details := c.Details
+		if len(details) == 0 {
+			details = []string{describeChange(c.Change)}
+		}
+
+		for _, d := range details {
+			from, to, ok := splitFieldChange(d)
+			if ok {
+				fmt.Fprintln(w, "-"+from)
+				fmt.Fprintln(w, "+"+to)
+
+				continue
+			}
+
+			fmt.Fprintln(w, diffSign(c.Change)+c.Resource+": "+d)
+		}
+	}
+}
+
+// splitFieldChange splits a line such as "updating description: 'a' -> 'b'"
+// into "description: 'a'" and "description: 'b'".
+func splitFieldChange(line string) (string, string, bool) {
+	field, values, ok := strings.Cut(strings.TrimPrefix(line, "updating "), ": ")
+	if !ok {
+		return "", "", false
+	}
+
+	from, to, ok := strings.Cut(values, " -> ")
+	if !ok {
+		return "", "", false
+	}
+
+	return field + ": " + from, field + ": " + to, true
+}
+
+func diffSign(c client.Change) string {
+	if c.Destructive() {
+		return "-"
+	}
+
+	return "+"
+}
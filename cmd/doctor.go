@@ -0,0 +1,249 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gomicro/concord/client"
+	"github.com/gomicro/concord/manifest"
+	"github.com/gomicro/concord/report"
+	"github.com/spf13/cobra"
+	"golang.org/x/exp/slices"
+)
+
+const (
+	maxClockSkew = time.Minute
+)
+
+var (
+	requiredScopes = []string{"repo", "admin:org"}
+)
+
+func init() {
+	rootCmd.AddCommand(NewDoctorCmd(os.Stdout))
+}
+
+func NewDoctorCmd(out io.Writer) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:               "doctor",
+		Short:             "Diagnose concord's setup",
+		Long:              `Check connectivity, credentials, rate limits, and organization access, printing hints for anything that fails.`,
+		PersistentPreRunE: setupClient,
+		RunE:              doctorRun,
+	}
+
+	cmd.SetOut(out)
+
+	return cmd
+}
+
+type doctorCheck struct {
+	name string
+	hint string
+	run  func(cmd *cobra.Command, clt client.GitHubClient, token tokenInfoFunc) (string, error)
+}
+
+// tokenInfoFunc returns the token's info, fetched once for a run of the checks
+// however many of them ask for it.
+type tokenInfoFunc func() (*client.TokenInfo, error)
+
+var doctorChecks = []doctorCheck{
+	{
+		name: "api connectivity",
+		hint: "check network access to the API endpoint and any proxy settings",
+		run:  checkConnectivity,
+	},
+	{
+		name: "base url",
+		hint: "GitHub Enterprise Server APIs are served from https://<host>/api/v3/",
+		run:  checkBaseURL,
+	},
+	{
+		name: "token",
+		hint: "run `concord auth`, or pass a valid token with --token or CONCORD_GITHUB_TOKEN",
+		run:  checkToken,
+	},
+	{
+		name: "token scopes",
+		hint: "run `concord auth --reauth` to grant the " + strings.Join(requiredScopes, " and ") + " scopes",
+		run:  checkScopes,
+	},
+	{
+		name: "rate limit",
+		hint: "wait for the rate limit to reset before running concord",
+		run:  checkRateLimit,
+	},
+	{
+		name: "clock skew",
+		hint: "sync the local clock; rate limit resets are calculated from it",
+		run:  checkClockSkew,
+	},
+	{
+		name: "organization",
+		hint: "check the organization name in the manifest and that the token's user belongs to it",
+		run:  checkOrgVisible,
+	},
+}
+
+func doctorRun(cmd *cobra.Command, args []string) error {
+	return runDoctorChecks(cmd, "Doctor", doctorChecks)
+}
+
+// runDoctorChecks runs each check, printing whether it passed or the hint for
+// fixing it, and fails when any of them did.
+func runDoctorChecks(cmd *cobra.Command, title string, checks []doctorCheck) error {
+	clt, err := client.ClientFromContext(cmd.Context())
+	if err != nil {
+		return handleError(cmd, err)
+	}
+
+	token := sync.OnceValues(func() (*client.TokenInfo, error) {
+		return clt.GetTokenInfo(cmd.Context())
+	})
+
+	report.PrintHeader(title)
+	report.Println()
+
+	failed := 0
+	for _, c := range checks {
+		detail, err := c.run(cmd, clt, token)
+		if err != nil {
+			failed++
+
+			report.PrintError("fail " + c.name + ": " + err.Error())
+			report.Println()
+			report.PrintInfo("  " + c.hint)
+			report.Println()
+
+			continue
+		}
+
+		report.PrintSuccess("pass " + c.name + ": " + detail)
+		report.Println()
+	}
+
+	if failed > 0 {
+		return handleError(cmd, fmt.Errorf("%s: %d of %d checks failed", strings.ToLower(title), failed, len(checks)))
+	}
+
+	return nil
+}
+
+func checkConnectivity(cmd *cobra.Command, clt client.GitHubClient, _ tokenInfoFunc) (string, error) {
+	_, err := clt.RateStatus(cmd.Context())
+	if err != nil && !errors.Is(err, client.ErrRateLimitDisabled) {
+		return "", err
+	}
+
+	return "reached " + clt.BaseURL(), nil
+}
+
+func checkBaseURL(cmd *cobra.Command, clt client.GitHubClient, _ tokenInfoFunc) (string, error) {
+	u := clt.BaseURL()
+
+	if clt.IsEnterprise() && !strings.HasSuffix(u, "/api/v3/") {
+		return "", errors.New(u + " does not look like an Enterprise Server API endpoint")
+	}
+
+	return u, nil
+}
+
+func checkToken(cmd *cobra.Command, clt client.GitHubClient, token tokenInfoFunc) (string, error) {
+	info, err := token()
+	if err != nil {
+		return "", err
+	}
+
+	return "authenticated as " + info.User.GetLogin(), nil
+}
+
+func checkScopes(cmd *cobra.Command, clt client.GitHubClient, token tokenInfoFunc) (string, error) {
+	info, err := token()
+	if err != nil {
+		return "", err
+	}
+
+	if len(info.Scopes) == 0 {
+		return "no scopes reported (fine-grained token or app)", nil
+	}
+
+	var missing []string
+	for _, s := range requiredScopes {
+		if !slices.Contains(info.Scopes, s) {
+			missing = append(missing, s)
+		}
+	}
+
+	if len(missing) > 0 {
+		return "", errors.New("missing [" + strings.Join(missing, ", ") + "]")
+	}
+
+	return "[" + strings.Join(info.Scopes, ", ") + "]", nil
+}
+
+func checkRateLimit(cmd *cobra.Command, clt client.GitHubClient, _ tokenInfoFunc) (string, error) {
+	rate, err := clt.RateStatus(cmd.Context())
+	if err != nil {
+		if errors.Is(err, client.ErrRateLimitDisabled) {
+			return "not enabled on this server", nil
+		}
+
+		return "", err
+	}
+
+	reset := rate.Reset.Local().Format(time.Kitchen)
+
+	if rate.Remaining == 0 {
+		return "", errors.New("budget exhausted until " + reset)
+	}
+
+	return fmt.Sprintf("%d of %d requests remaining, resets at %s", rate.Remaining, rate.Limit, reset), nil
+}
+
+func checkClockSkew(cmd *cobra.Command, clt client.GitHubClient, token tokenInfoFunc) (string, error) {
+	info, err := token()
+	if err != nil {
+		return "", err
+	}
+
+	if info.ServerTime.IsZero() {
+		return "", errors.New("server did not report its time")
+	}
+
+	skew := time.Since(info.ServerTime).Round(time.Second)
+	if skew < -maxClockSkew || skew > maxClockSkew {
+		return "", fmt.Errorf("local clock is off by %s", skew)
+	}
+
+	return "within " + maxClockSkew.String(), nil
+}
+
+func checkOrgVisible(cmd *cobra.Command, clt client.GitHubClient, _ tokenInfoFunc) (string, error) {
+	file := cmd.Flags().Lookup("file").Value.String()
+
+	orgs, err := manifest.ReadManifests(file)
+	if err != nil {
+		return "", fmt.Errorf("read manifest: %w", err)
+	}
+
+	names := []string{}
+	for _, org := range orgs {
+		exists, err := clt.OrgExists(cmd.Context(), org.Name)
+		if err != nil {
+			return "", err
+		}
+
+		if !exists {
+			return "", errors.New("organization '" + org.Name + "' is not visible to the token")
+		}
+
+		names = append(names, org.Name)
+	}
+
+	return strings.Join(names, ", "), nil
+}
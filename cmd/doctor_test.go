@@ -0,0 +1,141 @@
+package cmd
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gomicro/concord/client"
+	"github.com/gomicro/concord/client/fakeclient"
+	gh_pb "github.com/gomicro/concord/github/v1"
+	"github.com/google/go-github/v56/github"
+)
+
+// healthyFake returns a fake client every doctor check passes against for
+// the acme org.
+func healthyFake() *fakeclient.Client {
+	fc := fakeclient.New()
+	fc.Rate = &github.Rate{Limit: 5000, Remaining: 4999, Reset: github.Timestamp{Time: time.Now().Add(time.Hour)}}
+	fc.Token = &client.TokenInfo{
+		User:       &github.User{Login: github.String("concord-bot")},
+		Scopes:     []string{"repo", "admin:org"},
+		ServerTime: time.Now(),
+	}
+	fc.Orgs = map[string]bool{"acme": true}
+
+	return fc
+}
+
+func TestDoctorRun(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "concord.yml")
+
+	err := os.WriteFile(file, []byte("organization:\n  name: acme\n"), 0600)
+	if err != nil {
+		t.Fatalf("write manifest: %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		breaks func(fc *fakeclient.Client)
+		failed []string
+	}{
+		{
+			name:   "all pass",
+			breaks: func(fc *fakeclient.Client) {},
+		},
+		{
+			name:   "bad token",
+			breaks: func(fc *fakeclient.Client) { fc.Token = nil },
+			failed: []string{"token", "token scopes", "clock skew"},
+		},
+		{
+			name:   "invisible org",
+			breaks: func(fc *fakeclient.Client) { fc.Orgs = nil },
+			failed: []string{"organization"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fc := healthyFake()
+			tt.breaks(fc)
+
+			out, err := runFake(t, fc, &gh_pb.Organization{Name: "acme"}, doctorRun, "--file", file)
+			if (err != nil) != (len(tt.failed) > 0) {
+				t.Fatalf("err = %v, want failures %v", err, tt.failed)
+			}
+
+			// every check runs whatever fails before it
+			for _, c := range doctorChecks {
+				want := "pass " + c.name + ":"
+				for _, f := range tt.failed {
+					if f == c.name {
+						want = "fail " + c.name + ":"
+					}
+				}
+
+				if !strings.Contains(out, want) {
+					t.Errorf("output is missing %q:\n%s", want, out)
+				}
+			}
+		})
+	}
+}
+
+func TestDoctorCmdReadsTokenOnce(t *testing.T) {
+	captureReport(t)
+
+	var (
+		mu    sync.Mutex
+		users int
+	)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/api/v3/user":
+			mu.Lock()
+			users++
+			mu.Unlock()
+
+			w.Header().Set("X-OAuth-Scopes", "repo, admin:org")
+			w.Write([]byte(`{"login": "concord-bot"}`)) //nolint: errcheck
+		case "/api/v3/rate_limit":
+			w.Write([]byte(`{"resources": {"core": {"limit": 5000, "remaining": 4999}}}`)) //nolint: errcheck
+		case "/api/v3/orgs/acme":
+			w.Write([]byte(`{"login": "acme"}`)) //nolint: errcheck
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	file := filepath.Join(t.TempDir(), "concord.yml")
+
+	err := os.WriteFile(file, []byte("organization:\n  name: acme\n"), 0o600)
+	if err != nil {
+		t.Fatalf("write manifest: %v", err)
+	}
+
+	// the client comes from the doctor command's own pre-run
+	err = executeTestCmd(t, context.Background(), NewDoctorCmd(io.Discard), "--token", "tkn", "--base-url", srv.URL, "--file", file, "--timeout", "1m")
+	if err != nil {
+		t.Fatalf("doctor: %v", err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	// the token, scopes, and clock skew checks share one read of the token
+	if users != 1 {
+		t.Errorf("read the token's user %d times, want 1", users)
+	}
+}
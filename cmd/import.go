@@ -0,0 +1,304 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/gomicro/concord/client"
+	gh_pb "github.com/gomicro/concord/github/v1"
+	"github.com/gomicro/concord/report"
+	"github.com/google/go-github/v56/github"
+	"github.com/spf13/cobra"
+	"golang.org/x/exp/slices"
+	"google.golang.org/protobuf/encoding/protojson"
+	"gopkg.in/yaml.v3"
+)
+
+func init() {
+	rootCmd.AddCommand(NewImportCmd(os.Stdout))
+}
+
+func NewImportCmd(out io.Writer) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:               "import <org>",
+		Short:             "Write manifest fragments from an org's repos in github",
+		Long:              `Read every repo of an org from github and write one manifest fragment per repo into a directory, holding that repo's entry in a manifest's repositories list with the settings concord can reconcile. Keys are sorted so importing again only changes what changed in github.`,
+		Args:              cobra.ExactArgs(1),
+		PersistentPreRunE: setupClient,
+		RunE:              importRun,
+	}
+
+	cmd.Flags().String("split-dir", "", "directory to write a <repo>.yaml fragment into for each repo")
+	cmd.MarkFlagRequired("split-dir") //nolint: errcheck
+
+	cmd.SetOut(out)
+
+	return cmd
+}
+
+func importRun(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+	org := args[0]
+
+	clt, err := client.ClientFromContext(ctx)
+	if err != nil {
+		return handleError(cmd, err)
+	}
+
+	dir := cmd.Flags().Lookup("split-dir").Value.String()
+	err = os.MkdirAll(dir, 0755)
+	if err != nil {
+		return handleError(cmd, fmt.Errorf("import: %w", err))
+	}
+
+	repos, err := clt.GetRepos(ctx, org)
+	if err != nil {
+		return handleError(cmd, err)
+	}
+
+	slices.SortFunc(repos, func(a, b *github.Repository) int { return strings.Compare(a.GetName(), b.GetName()) })
+
+	report.PrintHeader("Import")
+	report.Println()
+
+	for _, ghr := range repos {
+		repo, err := importRepo(ctx, clt, org, ghr.GetName())
+		if err != nil {
+			return handleError(cmd, err)
+		}
+
+		file := filepath.Join(dir, repo.Name+".yaml")
+		err = writeFragment(file, repo)
+		if err != nil {
+			return handleError(cmd, err)
+		}
+
+		report.PrintSuccess("wrote " + file)
+		report.Println()
+	}
+
+	report.Println()
+	report.PrintInfo(fmt.Sprintf("imported %d repos into %s", len(repos), dir))
+	report.Println()
+
+	return nil
+}
+
+// importRepo reads the settings concord reconciles on a repo from github into
+// a manifest entry. Empty strings and lists are left out, as is archived on
+// repos that are not.
+func importRepo(ctx context.Context, clt client.GitHubClient, org, name string) (*gh_pb.Repository, error) {
+	ghr, err := clt.GetRepo(ctx, org, name)
+	if err != nil {
+		return nil, err
+	}
+
+	repo := &gh_pb.Repository{
+		Name:                   name,
+		Visibility:             github.String(ghr.GetVisibility()),
+		HasIssues:              github.Bool(ghr.GetHasIssues()),
+		HasProjects:            github.Bool(ghr.GetHasProjects()),
+		HasWiki:                github.Bool(ghr.GetHasWiki()),
+		HasDiscussions:         github.Bool(ghr.GetHasDiscussions()),
+		AllowAutoMerge:         github.Bool(ghr.GetAllowAutoMerge()),
+		AutoDeleteHeadBranches: github.Bool(ghr.GetDeleteBranchOnMerge()),
+		Topics:                 normalizeTopics(ghr.Topics),
+	}
+
+	if ghr.GetDescription() != "" {
+		repo.Description = ghr.Description
+	}
+
+	if ghr.GetHomepage() != "" {
+		repo.Homepage = ghr.Homepage
+	}
+
+	if ghr.GetArchived() {
+		repo.Archived = github.Bool(true)
+	}
+
+	if ghr.GetDefaultBranch() != "" {
+		repo.DefaultBranch = ghr.DefaultBranch
+	}
+
+	repo.VulnerabilityAlerts, err = importSetting(clt.GetVulnerabilityAlerts(ctx, org, name))
+	if err != nil {
+		return nil, err
+	}
+
+	repo.AutomatedSecurityFixes, err = importSetting(clt.GetAutomatedSecurityFixes(ctx, org, name))
+	if err != nil {
+		return nil, err
+	}
+
+	repo.SecretScanning, err = importSetting(clt.GetSecretScanning(ctx, org, name))
+	if err != nil {
+		return nil, err
+	}
+
+	repo.ProtectedBranches, err = importProtectedBranches(ctx, clt, org, name)
+	if err != nil {
+		return nil, err
+	}
+
+	links, err := clt.ListAutolinks(ctx, org, name)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, l := range links {
+		repo.Autolinks = append(repo.Autolinks, &gh_pb.Autolink{
+			KeyPrefix:      l.GetKeyPrefix(),
+			UrlTemplate:    l.GetURLTemplate(),
+			IsAlphanumeric: github.Bool(l.GetIsAlphanumeric()),
+		})
+	}
+
+	labels, err := clt.ListLabels(ctx, org, name)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, l := range labels {
+		il := &gh_pb.IssueLabel{
+			Name:  l.GetName(),
+			Color: l.GetColor(),
+		}
+
+		if l.GetDescription() != "" {
+			il.Description = l.Description
+		}
+
+		repo.IssueLabels = append(repo.IssueLabels, il)
+	}
+
+	users, err := clt.ListCollaborators(ctx, org, name)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, u := range users {
+		repo.Collaborators = append(repo.Collaborators, &gh_pb.Collaborator{
+			Username:   u.GetLogin(),
+			Permission: u.GetRoleName(),
+		})
+	}
+
+	license, err := clt.GetLicense(ctx, org, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if spdx := license.GetLicense().GetSPDXID(); spdx != "" && spdx != "NOASSERTION" {
+		repo.License = github.String(spdx)
+	}
+
+	return repo, nil
+}
+
+// importSetting turns a setting read from github into a manifest field,
+// leaving it out when the token is not allowed to read it.
+func importSetting(enabled bool, err error) (*bool, error) {
+	if err != nil {
+		var ghErr *github.ErrorResponse
+		if errors.As(err, &ghErr) && ghErr.Response != nil && ghErr.Response.StatusCode == http.StatusForbidden {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return github.Bool(enabled), nil
+}
+
+// importProtectedBranches reads whether each protected branch requires pull
+// requests and which status checks it requires.
+func importProtectedBranches(ctx context.Context, clt client.GitHubClient, org, repo string) ([]*gh_pb.Branch, error) {
+	names, err := clt.GetProtectedBranches(ctx, org, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	slices.Sort(names)
+
+	branches := []*gh_pb.Branch{}
+	for _, name := range names {
+		ghpb, err := clt.GetBranchProtection(ctx, org, repo, name)
+		if err != nil {
+			if errors.Is(err, client.ErrBranchProtectionNotFound) {
+				continue
+			}
+
+			return nil, err
+		}
+
+		p := &gh_pb.Protection{
+			RequirePr:      github.Bool(ghpb.GetRequiredPullRequestReviews() != nil),
+			ChecksMustPass: github.Bool(ghpb.GetRequiredStatusChecks() != nil),
+		}
+
+		if rc := ghpb.GetRequiredStatusChecks(); rc != nil {
+			for _, c := range rc.Checks {
+				p.RequiredChecks = append(p.RequiredChecks, c.Context)
+
+				if c.GetAppID() > 0 {
+					if p.CheckAppIds == nil {
+						p.CheckAppIds = map[string]int64{}
+					}
+
+					p.CheckAppIds[c.Context] = c.GetAppID()
+				}
+			}
+
+			slices.Sort(p.RequiredChecks)
+		}
+
+		branches = append(branches, &gh_pb.Branch{Name: name, Protection: p})
+	}
+
+	return branches, nil
+}
+
+// writeFragment writes a repo's manifest entry as YAML. It goes through JSON
+// first so field names match the manifest's, and maps so keys come out sorted.
+func writeFragment(file string, repo *gh_pb.Repository) error {
+	b, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(repo)
+	if err != nil {
+		return fmt.Errorf("marshal %s: %w", repo.Name, err)
+	}
+
+	var v map[string]interface{}
+	err = json.Unmarshal(b, &v)
+	if err != nil {
+		return fmt.Errorf("marshal %s: %w", repo.Name, err)
+	}
+
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+
+	err = enc.Encode(v)
+	if err != nil {
+		return fmt.Errorf("marshal %s: %w", repo.Name, err)
+	}
+
+	err = enc.Close()
+	if err != nil {
+		return fmt.Errorf("marshal %s: %w", repo.Name, err)
+	}
+
+	err = os.WriteFile(file, buf.Bytes(), 0644)
+	if err != nil {
+		return fmt.Errorf("write %s: %w", file, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,187 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"strings"
+
+	gh_pb "github.com/gomicro/concord/github/v1"
+	"github.com/gomicro/concord/manifest"
+	"github.com/gomicro/concord/report"
+	"github.com/spf13/cobra"
+	"golang.org/x/exp/slices"
+)
+
+func init() {
+	rootCmd.AddCommand(NewLintCmd(os.Stdout))
+}
+
+func NewLintCmd(out io.Writer) *cobra.Command {
+	names := []string{}
+	for _, r := range lintRules {
+		names = append(names, r.name)
+	}
+
+	cmd := &cobra.Command{
+		Use:   "lint [manifest]",
+		Short: "Check an org configuration against policy rules",
+		Long:  `Check a valid org configuration against built in policy rules, such as every repo having a description, and fail when any are broken. The rules are ` + strings.Join(names, ", ") + `.`,
+		Args:  cobra.MaximumNArgs(1),
+		RunE:  lintRun,
+	}
+
+	cmd.Flags().StringSlice("disable", nil, "rules to skip, can be repeated or comma separated")
+
+	cmd.SetOut(out)
+
+	return cmd
+}
+
+type lintRule struct {
+	name string
+	run  func(org *gh_pb.Organization) []string
+}
+
+var lintRules = []lintRule{
+	{
+		name: "repo-description",
+		run:  lintRepoDescription,
+	},
+	{
+		name: "default-branch-protected",
+		run:  lintDefaultBranchProtected,
+	},
+	{
+		name: "private-require-pr",
+		run:  lintPrivateRequirePR,
+	},
+}
+
+func lintRun(cmd *cobra.Command, args []string) error {
+	disabled, err := cmd.Flags().GetStringSlice("disable")
+	if err != nil {
+		return handleError(cmd, err)
+	}
+
+	for _, d := range disabled {
+		if !slices.ContainsFunc(lintRules, func(r lintRule) bool { return r.name == d }) {
+			return handleError(cmd, fmt.Errorf("unknown lint rule '%s'", d))
+		}
+	}
+
+	orgs, err := manifest.ReadManifests(checkManifest(cmd, args))
+	if err != nil {
+		return handleError(cmd, err)
+	}
+
+	violations := 0
+	for i, org := range orgs {
+		if i > 0 {
+			report.Println()
+		}
+
+		if len(orgs) > 1 {
+			report.PrintHeader("Lint " + org.Name)
+		} else {
+			report.PrintHeader("Lint")
+		}
+		report.Println()
+
+		for _, r := range lintRules {
+			if slices.Contains(disabled, r.name) {
+				report.PrintInfo("skip " + r.name)
+				report.Println()
+
+				continue
+			}
+
+			found := r.run(org)
+			if len(found) == 0 {
+				report.PrintSuccess("pass " + r.name)
+				report.Println()
+
+				continue
+			}
+
+			violations += len(found)
+
+			for _, v := range found {
+				report.PrintError("fail " + r.name + ": " + v)
+				report.Println()
+			}
+		}
+	}
+
+	if violations > 0 {
+		return handleError(cmd, fmt.Errorf("lint: %d violations", violations))
+	}
+
+	return nil
+}
+
+func lintRepoDescription(org *gh_pb.Organization) []string {
+	var found []string
+	for _, r := range org.Repositories {
+		if strings.TrimSpace(r.GetDescription()) == "" {
+			found = append(found, r.Name+" has no description")
+		}
+	}
+
+	return found
+}
+
+func lintDefaultBranchProtected(org *gh_pb.Organization) []string {
+	var found []string
+	for _, r := range org.Repositories {
+		if defaultBranchProtection(r) == nil {
+			found = append(found, r.Name+" does not protect its default branch "+defaultBranchName(r))
+		}
+	}
+
+	return found
+}
+
+func lintPrivateRequirePR(org *gh_pb.Organization) []string {
+	var found []string
+	for _, r := range org.Repositories {
+		if !lintIsPrivate(r) {
+			continue
+		}
+
+		if !defaultBranchProtection(r).GetRequirePr() {
+			found = append(found, r.Name+" is private but does not require pull requests on "+defaultBranchName(r))
+		}
+	}
+
+	return found
+}
+
+// defaultBranchProtection finds the protection for the repo's default branch,
+// whether it is named or matched by a pattern.
+func defaultBranchProtection(r *gh_pb.Repository) *gh_pb.Protection {
+	b := defaultBranchName(r)
+
+	for _, pb := range r.ProtectedBranches {
+		if pb.Name == b {
+			return pb.Protection
+		}
+	}
+
+	for _, pb := range r.ProtectedBranches {
+		if ok, _ := path.Match(pb.Pattern, b); pb.Pattern != "" && ok {
+			return pb.Protection
+		}
+	}
+
+	return nil
+}
+
+func lintIsPrivate(r *gh_pb.Repository) bool {
+	if r.Visibility != nil {
+		return r.GetVisibility() != "public"
+	}
+
+	return r.GetPrivate()
+}
@@ -0,0 +1,120 @@
+package cmd
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	gh_pb "github.com/gomicro/concord/github/v1"
+	"github.com/google/go-github/v56/github"
+	"golang.org/x/exp/slices"
+)
+
+func TestLintRules(t *testing.T) {
+	protected := func(name, pattern string, requirePR bool) []*gh_pb.Branch {
+		return []*gh_pb.Branch{{Name: name, Pattern: pattern, Protection: &gh_pb.Protection{RequirePr: github.Bool(requirePR)}}}
+	}
+
+	tests := []struct {
+		name string
+		rule func(*gh_pb.Organization) []string
+		repo *gh_pb.Repository
+		want []string
+	}{
+		{
+			name: "repo-description set",
+			rule: lintRepoDescription,
+			repo: &gh_pb.Repository{Name: "widgets", Description: github.String("all the widgets")},
+		},
+		{
+			name: "repo-description blank",
+			rule: lintRepoDescription,
+			repo: &gh_pb.Repository{Name: "widgets", Description: github.String("  ")},
+			want: []string{"widgets has no description"},
+		},
+		{
+			name: "default-branch-protected by name",
+			rule: lintDefaultBranchProtected,
+			repo: &gh_pb.Repository{Name: "widgets", ProtectedBranches: protected("main", "", false)},
+		},
+		{
+			name: "default-branch-protected by pattern",
+			rule: lintDefaultBranchProtected,
+			repo: &gh_pb.Repository{Name: "widgets", DefaultBranch: github.String("release/1"), ProtectedBranches: protected("", "release/*", false)},
+		},
+		{
+			name: "default-branch-protected other branch",
+			rule: lintDefaultBranchProtected,
+			repo: &gh_pb.Repository{Name: "widgets", DefaultBranch: github.String("trunk"), ProtectedBranches: protected("main", "", false)},
+			want: []string{"widgets does not protect its default branch trunk"},
+		},
+		{
+			name: "private-require-pr public",
+			rule: lintPrivateRequirePR,
+			repo: &gh_pb.Repository{Name: "widgets", Visibility: github.String("public")},
+		},
+		{
+			name: "private-require-pr required",
+			rule: lintPrivateRequirePR,
+			repo: &gh_pb.Repository{Name: "widgets", Private: github.Bool(true), ProtectedBranches: protected("main", "", true)},
+		},
+		{
+			name: "private-require-pr not required",
+			rule: lintPrivateRequirePR,
+			repo: &gh_pb.Repository{Name: "widgets", Visibility: github.String("internal"), ProtectedBranches: protected("main", "", false)},
+			want: []string{"widgets is private but does not require pull requests on main"},
+		},
+		{
+			name: "private-require-pr unprotected",
+			rule: lintPrivateRequirePR,
+			repo: &gh_pb.Repository{Name: "widgets", Private: github.Bool(true)},
+			want: []string{"widgets is private but does not require pull requests on main"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.rule(&gh_pb.Organization{Name: "acme", Repositories: []*gh_pb.Repository{tt.repo}})
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("violations = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLintRun(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "concord.yml")
+
+	err := os.WriteFile(file, []byte("organization:\n  name: acme\n  repositories:\n    - name: widgets\n      description: all the widgets\n"), 0o600)
+	if err != nil {
+		t.Fatalf("write manifest: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{name: "violations fail", wantErr: "lint: 1 violations"},
+		{name: "rules can be disabled", args: []string{"--disable", "default-branch-protected"}},
+		{name: "unknown rules are refused", args: []string{"--disable", "no-such-rule"}, wantErr: "unknown lint rule 'no-such-rule'"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out := captureReport(t)
+
+			err := executeTestCmd(t, context.Background(), NewLintCmd(io.Discard), append([]string{file}, tt.args...)...)
+			if tt.wantErr == "" && err != nil {
+				t.Fatalf("lint: %v\n%s", err, out)
+			}
+
+			if tt.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErr)) {
+				t.Fatalf("err = %v, want %s", err, tt.wantErr)
+			}
+		})
+	}
+}
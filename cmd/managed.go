@@ -0,0 +1,60 @@
+package cmd
+
+import (
+	"context"
+	"strings"
+
+	"github.com/gomicro/concord/manifest"
+	"github.com/gomicro/concord/report"
+	"github.com/google/go-github/v56/github"
+)
+
+// manages reports whether concord may change a field, such as
+// repo.description, of the org in the context. Every field is managed when
+// the manifest lists no managed fields, and listing a section, such as repo,
+// manages every field in it. Fields left alone are noted when running
+// verbosely.
+func manages(ctx context.Context, field string) bool {
+	org, err := manifest.OrgFromContext(ctx)
+	if err != nil || len(org.ManagedFields) == 0 {
+		return true
+	}
+
+	for _, f := range org.ManagedFields {
+		if f == field || strings.HasPrefix(field, f+".") {
+			return true
+		}
+	}
+
+	report.PrintTrace("leaving " + field + " alone, it is not a managed field")
+
+	return false
+}
+
+// dropUnmanagedEdits clears the edits to fields that are not managed, so
+// only the managed ones are sent to github.
+func dropUnmanagedEdits(ctx context.Context, edits *github.Repository) {
+	fields := []struct {
+		name  string
+		set   bool
+		clear func()
+	}{
+		{"repo.description", edits.Description != nil, func() { edits.Description = nil }},
+		{"repo.homepage", edits.Homepage != nil, func() { edits.Homepage = nil }},
+		{"repo.archived", edits.Archived != nil, func() { edits.Archived = nil }},
+		{"repo.visibility", edits.Visibility != nil || edits.Private != nil, func() { edits.Visibility, edits.Private = nil, nil }},
+		{"repo.default_branch", edits.DefaultBranch != nil, func() { edits.DefaultBranch = nil }},
+		{"repo.has_issues", edits.HasIssues != nil, func() { edits.HasIssues = nil }},
+		{"repo.has_projects", edits.HasProjects != nil, func() { edits.HasProjects = nil }},
+		{"repo.has_wiki", edits.HasWiki != nil, func() { edits.HasWiki = nil }},
+		{"repo.has_discussions", edits.HasDiscussions != nil, func() { edits.HasDiscussions = nil }},
+		{"repo.auto_delete_head_branches", edits.DeleteBranchOnMerge != nil, func() { edits.DeleteBranchOnMerge = nil }},
+		{"repo.allow_auto_merge", edits.AllowAutoMerge != nil, func() { edits.AllowAutoMerge = nil }},
+	}
+
+	for _, f := range fields {
+		if f.set && !manages(ctx, f.name) {
+			f.clear()
+		}
+	}
+}
@@ -0,0 +1,138 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/gomicro/concord/client/fakeclient"
+	gh_pb "github.com/gomicro/concord/github/v1"
+	"github.com/google/go-github/v56/github"
+)
+
+func TestManages(t *testing.T) {
+	tests := []struct {
+		name    string
+		managed []string
+		field   string
+		want    bool
+	}{
+		{name: "everything by default", field: "repo.description", want: true},
+		{name: "listed", managed: []string{"repo.description"}, field: "repo.description", want: true},
+		{name: "in a listed section", managed: []string{"repo"}, field: "repo.description", want: true},
+		{name: "not listed", managed: []string{"repo.homepage"}, field: "repo.description"},
+		{name: "section is not a prefix of another", managed: []string{"repo"}, field: "repository.description"},
+		{name: "field does not manage its section", managed: []string{"repo.description"}, field: "repo"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			captureReport(t)
+
+			org := &gh_pb.Organization{Name: "acme", ManagedFields: tt.managed}
+
+			got := manages(fakeCtx(fakeclient.New(), org), tt.field)
+			if got != tt.want {
+				t.Errorf("manages(%s) = %v, want %v", tt.field, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEnsureRepoUnmanagedFieldsMakeNoCalls(t *testing.T) {
+	tests := []struct {
+		name    string
+		managed []string
+		writes  int
+	}{
+		{name: "only the managed field", managed: []string{"repo.homepage"}, writes: 1},
+		{name: "nothing in the repo", managed: []string{"org.profile"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			captureReport(t)
+
+			fc := fakeclient.New()
+			fc.Repos = []*github.Repository{{
+				Name:          github.String("widgets"),
+				Description:   github.String("old"),
+				Homepage:      github.String("https://old.example.com"),
+				DefaultBranch: github.String("main"),
+				Topics:        []string{"old"},
+			}}
+
+			org := &gh_pb.Organization{Name: "acme", ManagedFields: tt.managed}
+			repo := &gh_pb.Repository{
+				Name:        "widgets",
+				Description: github.String("new"),
+				Homepage:    github.String("https://new.example.com"),
+				HasWiki:     github.Bool(false),
+				Topics:      []string{"new"},
+				ProtectedBranches: []*gh_pb.Branch{
+					{Name: "main", Protection: &gh_pb.Protection{RequirePr: github.Bool(true)}},
+				},
+				IssueLabels:   []*gh_pb.IssueLabel{{Name: "bug", Color: "d73a4a"}},
+				Collaborators: []*gh_pb.Collaborator{{Username: "alice", Permission: "write"}},
+			}
+
+			err := ensureRepo(fakeCtx(fc, org), fc, org.Name, repo, true)
+			if err != nil {
+				t.Fatalf("ensure repo: %v", err)
+			}
+
+			writes := 0
+			for _, c := range fc.Calls {
+				if c.Method == "UpdateRepo" {
+					edits := c.Args[1].(*github.Repository)
+					if edits.Description != nil || edits.HasWiki != nil || edits.GetHomepage() != "https://new.example.com" {
+						t.Errorf("edits = %v, want only the homepage", edits)
+					}
+				}
+
+				writes++
+			}
+
+			if writes != tt.writes {
+				t.Errorf("writes = %v, want %d", fc.Methods(), tt.writes)
+			}
+		})
+	}
+}
+
+func TestSetBranchProtectionKeepsUnmanagedFields(t *testing.T) {
+	captureReport(t)
+
+	fc := fakeclient.New()
+	fc.Protections = map[string]*github.Protection{
+		"widgets/main": {
+			RequiredStatusChecks: &github.RequiredStatusChecks{
+				Strict: true,
+				Checks: []*github.RequiredStatusCheck{{Context: "ci/build"}},
+			},
+		},
+	}
+
+	// only pull requests are managed, so the checks set in github stay
+	org := &gh_pb.Organization{Name: "acme", ManagedFields: []string{"branch_protection.require_pr"}}
+	repo := &gh_pb.Repository{Name: "widgets"}
+	branch := &gh_pb.Branch{Name: "main", Protection: &gh_pb.Protection{RequirePr: github.Bool(true)}}
+
+	err := setBranchProtection(fakeCtx(fc, org), fc, org.Name, repo, branch, "main", false)
+	if err != nil {
+		t.Fatalf("set branch protection: %v", err)
+	}
+
+	calls := fc.CallsTo("ProtectBranch")
+	if len(calls) != 1 {
+		t.Fatalf("protected %d times, want 1", len(calls))
+	}
+
+	req := calls[0].Args[1].(*github.ProtectionRequest)
+	if req.RequiredPullRequestReviews == nil {
+		t.Error("pull requests are not required")
+	}
+
+	rc := req.RequiredStatusChecks
+	if rc == nil || !rc.Strict || len(rc.Checks) != 1 || rc.Checks[0].Context != "ci/build" {
+		t.Errorf("status checks = %+v, want ci/build kept", rc)
+	}
+}
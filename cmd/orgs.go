@@ -0,0 +1,67 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/gomicro/concord/client"
+	"github.com/gomicro/concord/manifest"
+	"github.com/gomicro/concord/report"
+	"github.com/spf13/cobra"
+)
+
+// eachOrg runs fn once for every organization in the manifest, with only that
+// organization in the command's context while it runs. Each org is checked to
+// exist and gets a header first, naming it when the manifest lists several.
+// Orgs with failed repos do not stop the ones after them; the failures are
+// returned together at the end.
+func eachOrg(cmd *cobra.Command, fn func() error) error {
+	ctx := cmd.Context()
+	defer cmd.SetContext(ctx)
+
+	orgs, err := manifest.OrgsFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	clt, err := client.ClientFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for i, org := range orgs {
+		exists, err := clt.OrgExists(ctx, org.Name)
+		if err != nil {
+			return err
+		}
+
+		if !exists {
+			return fmt.Errorf("organization '%s' does not exist", org.Name)
+		}
+
+		cmd.SetContext(manifest.WithOrg(ctx, org))
+
+		if i > 0 {
+			report.Println()
+		}
+
+		if len(orgs) > 1 {
+			report.PrintHeader("Org " + org.Name)
+		} else {
+			report.PrintHeader("Org")
+		}
+		report.Println()
+
+		err = fn()
+		if err != nil {
+			if !errors.Is(err, errReposFailed) {
+				return err
+			}
+
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,203 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/gomicro/concord/client"
+	gh_pb "github.com/gomicro/concord/github/v1"
+	"github.com/gomicro/concord/manifest"
+	"github.com/gomicro/concord/report"
+	"github.com/spf13/cobra"
+	"golang.org/x/exp/slices"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const planVersion = 1
+
+func init() {
+	rootCmd.AddCommand(NewPlanCmd(os.Stdout))
+}
+
+func NewPlanCmd(out io.Writer) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:               "plan [manifest]",
+		Short:             "Write the changes an apply would make to a file",
+		Long:              `Compare an org configuration against github and write the pending changes to a plan file for review, without changing anything. The plan can later be applied with apply --plan.`,
+		Args:              cobra.MaximumNArgs(1),
+		PersistentPreRunE: setupClient,
+		RunE:              planRun,
+	}
+
+	cmd.Flags().StringP("out", "o", "plan.json", "file to write the plan to")
+
+	cmd.SetOut(out)
+
+	return cmd
+}
+
+func planRun(cmd *cobra.Command, args []string) error {
+	ctx, err := manifest.WithManifest(cmd.Context(), checkManifest(cmd, args))
+	if err != nil {
+		return handleError(cmd, err)
+	}
+
+	cmd.SetContext(ctx)
+
+	// a plan carries a single org's manifest for apply to check against
+	orgs, err := manifest.OrgsFromContext(ctx)
+	if err != nil {
+		return handleError(cmd, err)
+	}
+
+	if len(orgs) > 1 {
+		return handleError(cmd, fmt.Errorf("plan: %w, plan each organization from a manifest of its own", manifest.ErrMultipleOrgs))
+	}
+
+	org := orgs[0]
+
+	clt, err := client.ClientFromContext(ctx)
+	if err != nil {
+		return handleError(cmd, err)
+	}
+
+	// a plan is only useful when every repo could be compared
+	err = reconcile(cmd, nil)
+	if err != nil {
+		return handleError(cmd, err)
+	}
+
+	p := &plan{
+		Version: planVersion,
+		Created: time.Now().UTC(),
+		Prune:   prune(cmd),
+		Changes: clt.Pending(),
+		org:     org,
+	}
+
+	file := cmd.Flags().Lookup("out").Value.String()
+	err = writePlan(file, p)
+	if err != nil {
+		return handleError(cmd, err)
+	}
+
+	report.PrintSummary(true)
+
+	report.Println()
+	report.PrintInfo(fmt.Sprintf("wrote %d changes to %s", len(p.Changes), file))
+	report.Println()
+
+	return nil
+}
+
+// plan is the file written by the plan command. It carries the manifest it
+// was made from so apply --plan can recompute the changes and refuse to
+// apply them if github has changed since.
+type plan struct {
+	Version  int             `json:"version"`
+	Created  time.Time       `json:"created"`
+	Prune    bool            `json:"prune,omitempty"`
+	Manifest json.RawMessage `json:"manifest"`
+	Changes  []client.Change `json:"changes"`
+
+	org *gh_pb.Organization
+}
+
+func writePlan(file string, p *plan) error {
+	m, err := protojson.Marshal(p.org)
+	if err != nil {
+		return fmt.Errorf("marshal manifest: %w", err)
+	}
+
+	// protojson does not promise stable whitespace, compact it so plans of
+	// the same manifest diff cleanly
+	var buf bytes.Buffer
+	err = json.Compact(&buf, m)
+	if err != nil {
+		return fmt.Errorf("marshal manifest: %w", err)
+	}
+
+	p.Manifest = buf.Bytes()
+
+	if p.Changes == nil {
+		p.Changes = []client.Change{}
+	}
+
+	b, err := json.MarshalIndent(p, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshal plan: %w", err)
+	}
+
+	err = os.WriteFile(file, append(b, '\n'), 0644)
+	if err != nil {
+		return fmt.Errorf("write plan: %w", err)
+	}
+
+	return nil
+}
+
+func readPlan(file string) (*plan, error) {
+	b, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("read plan: %w", err)
+	}
+
+	p := &plan{}
+	err = json.Unmarshal(b, p)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal plan: %w", err)
+	}
+
+	if p.Version != planVersion {
+		return nil, fmt.Errorf("unsupported plan version %d", p.Version)
+	}
+
+	p.org = &gh_pb.Organization{}
+	err = protojson.Unmarshal(p.Manifest, p.org)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal plan manifest: %w", err)
+	}
+
+	return p, nil
+}
+
+// verify checks the recomputed changes against the planned ones, so a plan
+// is only applied while it still describes exactly what will happen.
+func (p *plan) verify(changes []client.Change) error {
+	if slices.Equal(p.Changes, changes) {
+		return nil
+	}
+
+	for _, c := range p.Changes {
+		if !slices.Contains(changes, c) {
+			report.PrintWarn("planned change no longer needed: " + describeChange(c))
+			report.Println()
+		}
+	}
+
+	for _, c := range changes {
+		if !slices.Contains(p.Changes, c) {
+			report.PrintWarn("change not in plan: " + describeChange(c))
+			report.Println()
+		}
+	}
+
+	return fmt.Errorf("github has changed since the plan was made %s, run plan again", p.Created.Format(time.RFC3339))
+}
+
+func describeChange(c client.Change) string {
+	target := c.Org
+	if c.Repo != "" {
+		target += "/" + c.Repo
+	}
+
+	if c.Target != "" {
+		target += " " + c.Target
+	}
+
+	return c.Action + " " + c.Resource + " " + target
+}
@@ -3,56 +3,328 @@ package cmd
 import (
 	"bufio"
 	"context"
+	"errors"
 	"fmt"
+	"net/mail"
 	"os"
+	"os/signal"
 	"strings"
+	"syscall"
 
 	"github.com/gomicro/concord/client"
 	"github.com/gomicro/concord/config"
+	"github.com/gomicro/concord/manifest"
 	"github.com/gomicro/concord/report"
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+var (
+	errOutputFormat = errors.New("format must be one of text, github-actions, or table")
+	errTableFormat  = errors.New("format table is only supported by the check commands")
+	errRunTimeout   = errors.New("run took longer than the timeout flag allows")
 )
 
 func init() {
 	cobra.OnInitialize(initEnvs)
 
-	rootCmd.PersistentFlags().StringP("file", "f", "concord.yml", "Path to a file containing a manifest")
-	rootCmd.PersistentFlags().Bool("dry", false, "Print out the actions that would be taken without actually taking them")
-	rootCmd.PersistentFlags().Bool("force", false, "Force the action to be taken without prompting for confirmation")
+	rootCmd.SetGlobalNormalizationFunc(normalizeFlags)
+
+	rootCmd.PersistentFlags().StringP("file", "f", "concord.yml", "Path to a file containing a manifest, or - to read it from stdin")
+	rootCmd.PersistentFlags().Bool("dry", false, "Print out the actions that would be taken without actually taking them, also accepted as --dry-run")
+	rootCmd.PersistentFlags().Bool("force", false, "Force the action to be taken without prompting to confirm destructive changes, also accepted as --yes")
+	rootCmd.PersistentFlags().Bool("prune", false, "Remove things found in github that are not in the manifest instead of only warning about them")
+	rootCmd.PersistentFlags().StringToString("var", nil, "Set a value for ${NAME} references in the manifest as name=value, taking precedence over environment variables, can be repeated")
+	rootCmd.PersistentFlags().Bool("strict-vars", false, "Fail when the manifest references a variable that is not set, instead of leaving the reference as written")
+	rootCmd.PersistentFlags().String("filter", "", "Only reconcile repos whose names match this glob, such as 'service-*'")
+	rootCmd.PersistentFlags().String("repos-from", "", "Only reconcile the manifest's repos listed in this file, one name per line")
+	rootCmd.PersistentFlags().Duration("changed-since", 0, "Only reconcile repos pushed to or updated within this long, such as 24h. Repos created in the run are always included, but settings drift on the skipped repos is not caught")
+	rootCmd.PersistentFlags().Bool("include-archived", false, "Also list archived repos in github, so they are reported when not in the manifest")
+	rootCmd.PersistentFlags().Bool("graphql", false, "Read repos, their topics, and which branches are protected in bulk over GraphQL rather than one repo at a time over REST; changes are still made over REST")
+	rootCmd.PersistentFlags().Bool("continue-on-error", false, "Keep reconciling the remaining repos when one fails, reporting all failures at the end")
+	rootCmd.PersistentFlags().String("base-url", "", "Github Enterprise Server url to use instead of github.com, defaults to the CONCORD_GITHUB_BASE_URL environment variable")
+	rootCmd.PersistentFlags().BoolP("quiet", "q", false, "Only print changes, warnings, and errors")
+	rootCmd.PersistentFlags().BoolP("verbose", "v", false, "Also print every API call made")
+	rootCmd.MarkFlagsMutuallyExclusive("quiet", "verbose")
+	rootCmd.PersistentFlags().Bool("no-color", false, "Disable colored output, also disabled by NO_COLOR or when not writing to a terminal")
+	rootCmd.PersistentFlags().String("format", "", "How to print output, one of text, github-actions, or table (check commands only), defaults to github-actions when the GITHUB_ACTIONS environment variable is true and text otherwise")
+	rootCmd.PersistentFlags().String("commit-author", "", "Name and email, as 'Name <email>', to author and commit file changes as instead of the token's user")
+	rootCmd.PersistentFlags().String("commit-trailer", "", "Line to end the message of every commit changing files with, such as '[skip ci]'")
+	rootCmd.PersistentFlags().String("audit-log", "", "Path to append a JSON line to for every change applied, or planned on a dry run")
+	rootCmd.PersistentFlags().Float64("rate", client.RequestsPerSecond, "Maximum requests per second to make to github")
+	rootCmd.PersistentFlags().Int("burst", client.BurstLimit, "Requests allowed to burst above the rate")
+	rootCmd.PersistentFlags().Duration("timeout", 0, "Stop the run when it takes longer than this, such as 10m, no limit by default")
+	rootCmd.PersistentFlags().Duration("request-timeout", 0, "Fail any single call to github that takes longer than this, such as 30s, no limit by default")
+	rootCmd.PersistentFlags().String("token", "", "Github token to use, takes precedence over the CONCORD_GITHUB_TOKEN and GITHUB_TOKEN environment variables")
 }
 
 func initEnvs() {
 }
 
+// normalizeFlags maps alternate spellings of flags onto their canonical names.
+func normalizeFlags(f *pflag.FlagSet, name string) pflag.NormalizedName {
+	switch name {
+	case "dry-run":
+		name = "dry"
+	case "yes":
+		name = "force"
+	}
+
+	return pflag.NormalizedName(name)
+}
+
 var rootCmd = &cobra.Command{
-	Use:   "concord",
-	Short: "concord is a tool to manage your Github repositories",
+	Use:               "concord",
+	Short:             "concord is a tool to manage your Github repositories",
+	PersistentPreRunE: setupOutput,
 }
 
+// cancelTimeout releases the deadline set by the timeout flag, if any.
+var cancelTimeout context.CancelFunc = func() {}
+
+// closeAuditLog flushes and closes the file opened by the audit-log flag, if
+// any, once the command is done with it.
+var closeAuditLog = func() error { return nil }
+
 func Execute() {
-	c, err := config.ParseFromFile()
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+
+	err := rootCmd.ExecuteContext(ctx)
+	cancelTimeout()
+	stop()
+
+	cerr := closeAuditLog()
+	if err == nil {
+		err = cerr
+	}
+
+	// drift has already been reported, only the exit code is left to set
+	if errors.Is(err, errDrift) {
+		os.Exit(2)
+	}
+
+	if errors.Is(err, context.DeadlineExceeded) {
+		err = fmt.Errorf("%w: %w", errRunTimeout, err)
+	}
+
 	if err != nil {
 		fmt.Printf("Error: %s\n", err.Error())
 		os.Exit(1)
 	}
+}
 
-	tkn := os.Getenv("GITHUB_TOKEN")
+// setupOutput configures the report package from the output flags and puts
+// the timeout on the command's context. Commands with their own pre-run
+// replace the root's, so they must call it themselves.
+func setupOutput(cmd *cobra.Command, args []string) error {
+	timeout, err := cmd.Flags().GetDuration("timeout")
+	if err != nil {
+		return handleError(cmd, err)
+	}
 
-	if tkn != "" {
-		c.Github.Token = tkn
+	if timeout > 0 {
+		var ctx context.Context
+		ctx, cancelTimeout = context.WithTimeout(cmd.Context(), timeout)
+		cmd.SetContext(ctx)
 	}
 
-	ctx, err := client.WithClient(context.Background(), c.Github.Token)
+	report.SetOutput(cmd.OutOrStdout())
+
+	vars, err := cmd.Flags().GetStringToString("var")
 	if err != nil {
-		fmt.Printf("Error: %s\n", err.Error())
-		os.Exit(1)
+		return handleError(cmd, err)
+	}
+
+	manifest.SetVars(vars, strings.EqualFold(cmd.Flags().Lookup("strict-vars").Value.String(), "true"))
+
+	switch {
+	case strings.EqualFold(cmd.Flags().Lookup("quiet").Value.String(), "true"):
+		report.SetLevel(report.Quiet)
+	case strings.EqualFold(cmd.Flags().Lookup("verbose").Value.String(), "true"):
+		report.SetLevel(report.Verbose)
+	}
+
+	if strings.EqualFold(cmd.Flags().Lookup("no-color").Value.String(), "true") {
+		report.SetColor(false)
 	}
 
-	err = rootCmd.ExecuteContext(ctx)
+	f, err := outputFormat(cmd)
 	if err != nil {
-		fmt.Printf("Error: %s\n", err.Error())
-		os.Exit(1)
+		return handleError(cmd, err)
 	}
+
+	report.SetFormat(f)
+
+	return nil
+}
+
+// outputFormat returns the report format picked with the format flag, or
+// github-actions when running in a GitHub Actions workflow without one. The
+// diff command has a format flag of its own that shadows the root's, so it is
+// looked up on the root. The table format is rendered by the check commands
+// themselves, so the report format stays text for it.
+func outputFormat(cmd *cobra.Command) (report.Format, error) {
+	format := strings.ToLower(cmd.Root().PersistentFlags().Lookup("format").Value.String())
+	if format == "" && strings.EqualFold(os.Getenv("GITHUB_ACTIONS"), "true") {
+		format = "github-actions"
+	}
+
+	switch format {
+	case "", "text":
+		return report.Text, nil
+	case "github-actions":
+		return report.GitHubActions, nil
+	case "table":
+		if !isCheckCmd(cmd) {
+			return report.Text, errTableFormat
+		}
+
+		return report.Text, nil
+	default:
+		return report.Text, fmt.Errorf("%w, got '%s'", errOutputFormat, format)
+	}
+}
+
+// setupClient adds a github client to the command's context. It is meant to
+// be used as the PersistentPreRunE of commands that talk to github.
+func setupClient(cmd *cobra.Command, args []string) error {
+	err := setupOutput(cmd, args)
+	if err != nil {
+		return handleError(cmd, err)
+	}
+
+	tkn, source, err := resolveToken(cmd)
+	if err != nil {
+		return handleError(cmd, err)
+	}
+
+	report.PrintTrace("using token from " + source)
+
+	rps, err := cmd.Flags().GetFloat64("rate")
+	if err != nil {
+		return handleError(cmd, err)
+	}
+
+	burst, err := cmd.Flags().GetInt("burst")
+	if err != nil {
+		return handleError(cmd, err)
+	}
+
+	requestTimeout, err := cmd.Flags().GetDuration("request-timeout")
+	if err != nil {
+		return handleError(cmd, err)
+	}
+
+	opts := []client.Option{
+		client.WithContinueOnError(continueOnError(cmd)),
+		client.WithIncludeArchived(strings.EqualFold(cmd.Flags().Lookup("include-archived").Value.String(), "true")),
+		client.WithGraphQL(strings.EqualFold(cmd.Flags().Lookup("graphql").Value.String(), "true")),
+		client.WithRate(rps, burst),
+		client.WithRequestTimeout(requestTimeout),
+	}
+
+	baseURL := cmd.Flags().Lookup("base-url").Value.String()
+	if baseURL == "" {
+		baseURL = os.Getenv("CONCORD_GITHUB_BASE_URL")
+	}
+
+	if baseURL != "" {
+		opts = append(opts, client.WithBaseURL(baseURL))
+	}
+
+	author := cmd.Flags().Lookup("commit-author").Value.String()
+	if author != "" {
+		addr, err := mail.ParseAddress(author)
+		if err != nil || addr.Name == "" {
+			return handleError(cmd, fmt.Errorf("commit author '%s' must be given as 'Name <email>'", author))
+		}
+
+		opts = append(opts, client.WithCommitAuthor(addr.Name, addr.Address))
+	}
+
+	trailer := cmd.Flags().Lookup("commit-trailer").Value.String()
+	if trailer != "" {
+		opts = append(opts, client.WithCommitTrailer(trailer))
+	}
+
+	auditLog := cmd.Flags().Lookup("audit-log").Value.String()
+	if auditLog != "" {
+		f, err := os.OpenFile(auditLog, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+		if err != nil {
+			return handleError(cmd, fmt.Errorf("open audit log: %w", err))
+		}
+
+		closeAuditLog = func() error {
+			err := f.Sync()
+			if err != nil {
+				f.Close()
+				return fmt.Errorf("sync audit log: %w", err)
+			}
+
+			err = f.Close()
+			if err != nil {
+				return fmt.Errorf("close audit log: %w", err)
+			}
+
+			return nil
+		}
+
+		opts = append(opts, client.WithAuditLog(f))
+	}
+
+	ctx, err := client.WithClient(cmd.Context(), tkn, opts...)
+	if err != nil {
+		return handleError(cmd, err)
+	}
+
+	cmd.SetContext(ctx)
+
+	return nil
+}
+
+// resolveToken finds the github token to use, in order of precedence: the
+// --token flag, CONCORD_GITHUB_TOKEN, GITHUB_TOKEN, then the token stored by
+// `concord auth`. It also returns where the token was found.
+func resolveToken(cmd *cobra.Command) (string, string, error) {
+	tkn := cmd.Flags().Lookup("token").Value.String()
+	if tkn != "" {
+		return tkn, "--token", nil
+	}
+
+	for _, env := range []string{"CONCORD_GITHUB_TOKEN", "GITHUB_TOKEN"} {
+		tkn = os.Getenv(env)
+		if tkn != "" {
+			return tkn, env, nil
+		}
+	}
+
+	c, err := config.ParseFromFile()
+	if err != nil {
+		return "", "", err
+	}
+
+	if c.Github.Token == "" {
+		return "", "", client.ErrTokenEmpty
+	}
+
+	return c.Github.Token, "config", nil
+}
+
+// dryRun reports whether the command should only print the changes it would
+// make rather than applying them.
+func dryRun(cmd *cobra.Command) bool {
+	return strings.EqualFold(cmd.Flags().Lookup("dry").Value.String(), "true")
+}
+
+// prune reports whether unmanaged things found in github should be removed.
+func prune(cmd *cobra.Command) bool {
+	return strings.EqualFold(cmd.Flags().Lookup("prune").Value.String(), "true")
+}
+
+// continueOnError reports whether a failure on one repo should be collected
+// rather than stopping the run.
+func continueOnError(cmd *cobra.Command) bool {
+	return strings.EqualFold(cmd.Flags().Lookup("continue-on-error").Value.String(), "true")
 }
 
 func handleError(c *cobra.Command, err error) error {
@@ -60,27 +332,56 @@ func handleError(c *cobra.Command, err error) error {
 	return err
 }
 
-func confirm(cmd *cobra.Command, msg string) bool {
-	if strings.EqualFold(cmd.Flags().Lookup("force").Value.String(), "true") {
+// confirmDestructive lists the destructive changes about to be applied and
+// asks for confirmation, defaulting to no. Runs without destructive changes,
+// or with the force flag, go ahead without asking.
+func confirmDestructive(cmd *cobra.Command, changes []client.Change) bool {
+	destructive := []client.Change{}
+	for _, c := range changes {
+		if c.Destructive() {
+			destructive = append(destructive, c)
+		}
+	}
+
+	if len(destructive) == 0 || strings.EqualFold(cmd.Flags().Lookup("force").Value.String(), "true") {
 		return true
 	}
 
 	report.Println()
-	report.PrintInfo(msg)
+	report.PrintHeader("Destructive Changes")
+	report.Println()
+
+	for _, c := range destructive {
+		report.PrintDelete(describeChange(c))
+		report.Println()
+	}
+
+	return confirm(cmd, fmt.Sprintf("Apply %d destructive changes? (y/N): ", len(destructive)))
+}
+
+// confirm prompts until it gets a yes or no, treating an empty answer as no.
+func confirm(cmd *cobra.Command, msg string) bool {
+	report.Println()
+	report.PrintPrompt(msg)
 
 	reader := bufio.NewReader(os.Stdin)
 	for {
-		s, _ := reader.ReadString('\n')
-		s = strings.ToLower(strings.TrimSuffix(s, "\n"))
+		s, err := reader.ReadString('\n')
+		s = strings.ToLower(strings.TrimSpace(s))
+
+		if s == "y" {
+			return true
+		} else if s == "n" || (s == "" && err == nil) {
+			return false
+		} else if err != nil {
+			// stdin is closed, or was used for the manifest, so nobody can answer
+			report.Println()
+			report.PrintWarn("no answer to confirm with, pass --yes to apply without prompting")
+			report.Println()
 
-		if strings.Compare(s, "n") == 0 {
 			return false
-		} else if strings.Compare(s, "y") == 0 {
-			break
-		} else {
-			report.PrintInfo(msg)
 		}
-	}
 
-	return true
+		report.PrintPrompt(msg)
+	}
 }
@@ -0,0 +1,278 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gomicro/concord/client"
+	"github.com/gomicro/concord/client/fakeclient"
+	gh_pb "github.com/gomicro/concord/github/v1"
+	"github.com/gomicro/concord/manifest"
+	"github.com/gomicro/concord/report"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// executeTestCmd runs c as a subcommand of a root carrying the root's flags,
+// putting the flags back to their defaults afterwards as they are shared.
+func executeTestCmd(t *testing.T, ctx context.Context, c *cobra.Command, args ...string) error {
+	t.Helper()
+
+	root := &cobra.Command{Use: "concord"}
+	root.PersistentFlags().AddFlagSet(rootCmd.PersistentFlags())
+	root.SetGlobalNormalizationFunc(normalizeFlags)
+	root.SetOut(io.Discard)
+	root.SetErr(io.Discard)
+	root.AddCommand(c)
+
+	t.Cleanup(func() {
+		rootCmd.PersistentFlags().VisitAll(func(f *pflag.Flag) {
+			f.Value.Set(f.DefValue) //nolint: errcheck
+			f.Changed = false
+		})
+	})
+
+	root.SetArgs(append([]string{c.Name()}, args...))
+
+	return root.ExecuteContext(ctx)
+}
+
+// captureReport sends the report to a buffer, with colors off, for the length
+// of the test.
+func captureReport(t *testing.T) *bytes.Buffer {
+	t.Helper()
+
+	var out bytes.Buffer
+
+	report.SetOutput(&out)
+	report.SetColor(false)
+	t.Cleanup(func() { report.SetOutput(os.Stdout) })
+
+	return &out
+}
+
+// fakeCtx returns a context carrying fc as the client and org as the manifest,
+// for calling reconcile helpers directly.
+func fakeCtx(fc *fakeclient.Client, org *gh_pb.Organization) context.Context {
+	return manifest.WithOrg(client.WithGitHubClient(context.Background(), fc), org)
+}
+
+// runFake runs fn as a command against fc, with org as the manifest and the
+// given flags and args, and returns what it printed with colors off.
+func runFake(t *testing.T, fc *fakeclient.Client, org *gh_pb.Organization, fn func(*cobra.Command, []string) error, args ...string) (string, error) {
+	t.Helper()
+
+	out := captureReport(t)
+
+	c := &cobra.Command{
+		Use: "run",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := client.WithGitHubClient(cmd.Context(), fc)
+			cmd.SetContext(manifest.WithOrg(ctx, org))
+
+			return fn(cmd, args)
+		},
+	}
+
+	err := executeTestCmd(t, context.Background(), c, args...)
+
+	return out.String(), err
+}
+
+// newTestClient returns a client talking to a server answering with h.
+func newTestClient(t *testing.T, h http.Handler) *client.Client {
+	t.Helper()
+
+	srv := httptest.NewServer(h)
+	t.Cleanup(srv.Close)
+
+	clt, err := client.New(context.Background(), "tkn", client.WithBaseURL(srv.URL+"/"))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	return clt
+}
+
+// writeTestManifest writes a manifest for the length of the test, returning
+// its path relative to the working directory manifests are read from.
+func writeTestManifest(t *testing.T, manifest string) string {
+	t.Helper()
+
+	dir := t.TempDir()
+
+	err := os.WriteFile(filepath.Join(dir, "concord.yml"), []byte(manifest), 0600)
+	if err != nil {
+		t.Fatalf("write manifest: %v", err)
+	}
+
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatalf("working directory: %v", err)
+	}
+
+	file, err := filepath.Rel(wd, filepath.Join(dir, "concord.yml"))
+	if err != nil {
+		t.Fatalf("relative manifest path: %v", err)
+	}
+
+	return file
+}
+
+// testCtx returns a context carrying a client talking to a server answering
+// with h and the given manifest.
+func testCtx(t *testing.T, h http.Handler, manifestYAML string) context.Context {
+	t.Helper()
+
+	srv := httptest.NewServer(h)
+	t.Cleanup(srv.Close)
+
+	ctx, err := client.WithClient(context.Background(), "tkn", client.WithBaseURL(srv.URL+"/"))
+	if err != nil {
+		t.Fatalf("with client: %v", err)
+	}
+
+	ctx, err = manifest.WithManifest(ctx, writeTestManifest(t, manifestYAML))
+	if err != nil {
+		t.Fatalf("with manifest: %v", err)
+	}
+
+	return ctx
+}
+
+func TestSetupOutputUsesCommandWriter(t *testing.T) {
+	t.Cleanup(func() { report.SetOutput(os.Stdout) })
+
+	var out bytes.Buffer
+
+	c := &cobra.Command{
+		Use:               "run",
+		PersistentPreRunE: setupOutput,
+		RunE: func(*cobra.Command, []string) error {
+			report.PrintHeader("Repos")
+			report.Println()
+			report.PrintWarn("widgets exists in github but not in manifest")
+			report.Println()
+
+			return nil
+		},
+	}
+	c.SetOut(&out)
+
+	err := executeTestCmd(t, context.Background(), c)
+	if err != nil {
+		t.Fatalf("execute: %v", err)
+	}
+
+	want := "Repos\n  widgets exists in github but not in manifest\n"
+	if out.String() != want {
+		t.Errorf("output = %q, want %q", out.String(), want)
+	}
+}
+
+func TestSetupOutputTimeout(t *testing.T) {
+	t.Cleanup(func() {
+		cancelTimeout()
+		cancelTimeout = func() {}
+		report.SetOutput(os.Stdout)
+	})
+
+	tests := []struct {
+		name     string
+		args     []string
+		deadline bool
+	}{
+		{name: "no limit by default"},
+		{name: "timeout sets a deadline", args: []string{"--timeout", "1m"}, deadline: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var deadline bool
+
+			c := &cobra.Command{
+				Use:               "run",
+				PersistentPreRunE: setupOutput,
+				RunE: func(cmd *cobra.Command, args []string) error {
+					_, deadline = cmd.Context().Deadline()
+					return nil
+				},
+			}
+
+			err := executeTestCmd(t, context.Background(), c, tt.args...)
+			if err != nil {
+				t.Fatalf("execute: %v", err)
+			}
+
+			if deadline != tt.deadline {
+				t.Errorf("deadline = %v, want %v", deadline, tt.deadline)
+			}
+		})
+	}
+}
+
+func TestRunsUseContextClient(t *testing.T) {
+	errFake := errors.New("answered by the fake")
+
+	tests := []struct {
+		name   string
+		run    func(*cobra.Command, []string) error
+		org    *gh_pb.Organization
+		method string
+	}{
+		{name: "org", run: orgRun, org: &gh_pb.Organization{Name: "acme", Profile: &gh_pb.Profile{}}, method: "UpdateOrg"},
+		{name: "members", run: membersRun, org: &gh_pb.Organization{Name: "acme"}, method: "GetMembers"},
+		{name: "teams", run: teamsRun, org: &gh_pb.Organization{Name: "acme"}, method: "GetTeams"},
+		{name: "repos", run: reposRun, org: &gh_pb.Organization{Name: "acme"}, method: "GetRepos"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fc := fakeclient.New()
+			fc.Errs = map[string]error{tt.method: errFake}
+
+			_, err := runFake(t, fc, tt.org, tt.run)
+			if !errors.Is(err, errFake) {
+				t.Errorf("err = %v, want the client from the context to be used", err)
+			}
+		})
+	}
+}
+
+func TestSetupClientClosesAuditLog(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+
+	t.Cleanup(func() { closeAuditLog = func() error { return nil } })
+
+	c := &cobra.Command{
+		Use:               "run",
+		PersistentPreRunE: setupClient,
+		RunE:              func(*cobra.Command, []string) error { return nil },
+	}
+
+	err := executeTestCmd(t, context.Background(), c, "--token", "tkn", "--audit-log", path)
+	if err != nil {
+		t.Fatalf("execute: %v", err)
+	}
+
+	err = closeAuditLog()
+	if err != nil {
+		t.Fatalf("close audit log: %v", err)
+	}
+
+	if _, err := os.Stat(path); err != nil {
+		t.Errorf("audit log was not created: %v", err)
+	}
+
+	// closing again fails once the file has been closed
+	if err := closeAuditLog(); err == nil {
+		t.Error("audit log was left open")
+	}
+}
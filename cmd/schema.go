@@ -0,0 +1,39 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/gomicro/concord/manifest"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	rootCmd.AddCommand(NewSchemaCmd(os.Stdout))
+}
+
+func NewSchemaCmd(out io.Writer) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schema",
+		Short: "Print a JSON Schema for the manifest format",
+		Long:  `Print a JSON Schema describing every field a manifest supports, for editor completion and external validation. It is generated from the same definitions concord parses manifests with.`,
+		Args:  cobra.NoArgs,
+		RunE:  schemaRun,
+	}
+
+	cmd.SetOut(out)
+
+	return cmd
+}
+
+func schemaRun(cmd *cobra.Command, args []string) error {
+	s, err := manifest.JSONSchema()
+	if err != nil {
+		return handleError(cmd, err)
+	}
+
+	fmt.Fprintln(cmd.OutOrStdout(), string(s))
+
+	return nil
+}
@@ -0,0 +1,129 @@
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/gomicro/concord/client"
+	"github.com/gomicro/concord/manifest"
+	"github.com/gomicro/concord/report"
+	"github.com/spf13/cobra"
+)
+
+var (
+	errStatusOutput = errors.New("output must be one of text or json")
+
+	// errDrift marks a status run that found changes pending. The verdict has
+	// already been printed, so it only sets the exit code.
+	errDrift = errors.New("changes pending")
+)
+
+func init() {
+	rootCmd.AddCommand(NewStatusCmd(os.Stdout))
+}
+
+func NewStatusCmd(out io.Writer) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:               "status [manifest]",
+		Short:             "Report drift across a whole org configuration",
+		Long:              `Check the org, members, teams, and repos of a manifest against github without changing anything, then print a summary and whether everything is in sync. Exits with 2 when changes are pending and 1 when the check itself fails, so it can be scheduled.`,
+		Args:              cobra.MaximumNArgs(1),
+		PersistentPreRunE: setupClient,
+		RunE:              statusRun,
+	}
+
+	cmd.Flags().String("output", "text", "how to print the status, one of text or json")
+
+	cmd.SetOut(out)
+
+	return cmd
+}
+
+// status is the outcome of a status run, as printed with the json output.
+type status struct {
+	InSync   bool                   `json:"in_sync"`
+	Pending  int                    `json:"pending"`
+	Error    string                 `json:"error,omitempty"`
+	Sections []report.SectionCounts `json:"sections"`
+	Changes  []client.PlannedChange `json:"changes"`
+}
+
+func statusRun(cmd *cobra.Command, args []string) error {
+	output := strings.ToLower(cmd.Flags().Lookup("output").Value.String())
+	if output != "text" && output != "json" {
+		return handleError(cmd, fmt.Errorf("%w, got '%s'", errStatusOutput, output))
+	}
+
+	ctx, err := manifest.WithManifest(cmd.Context(), checkManifest(cmd, args))
+	if err != nil {
+		return handleError(cmd, err)
+	}
+
+	cmd.SetContext(ctx)
+
+	clt, err := client.ClientFromContext(ctx)
+	if err != nil {
+		return handleError(cmd, err)
+	}
+
+	if output == "json" {
+		report.SetOutput(io.Discard)
+	}
+
+	// the planned changes are only counted, status never flushes them
+	err = reconcile(cmd, nil)
+
+	report.SetOutput(cmd.OutOrStdout())
+
+	if err != nil && !errors.Is(err, errReposFailed) {
+		return handleError(cmd, err)
+	}
+
+	s := &status{
+		Pending:  len(clt.Planned()),
+		Sections: report.Sections(),
+		Changes:  clt.Planned(),
+	}
+
+	s.InSync = s.Pending == 0 && err == nil
+
+	if err != nil {
+		s.Error = err.Error()
+	}
+
+	if output == "json" {
+		enc := json.NewEncoder(cmd.OutOrStdout())
+		enc.SetIndent("", "  ")
+
+		werr := enc.Encode(s)
+		if werr != nil {
+			return handleError(cmd, fmt.Errorf("marshal status: %w", werr))
+		}
+	} else {
+		report.PrintSummary(true)
+		report.Println()
+
+		switch {
+		case s.InSync:
+			report.PrintPrompt("in sync")
+			report.Println()
+		case s.Pending > 0:
+			report.PrintPrompt(fmt.Sprintf("%d changes pending", s.Pending))
+			report.Println()
+		}
+	}
+
+	if err != nil {
+		return handleError(cmd, err)
+	}
+
+	if s.Pending > 0 {
+		return handleError(cmd, errDrift)
+	}
+
+	return nil
+}
@@ -0,0 +1,128 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/gomicro/concord/client"
+	"github.com/gomicro/concord/client/fakeclient"
+	"github.com/google/go-github/v56/github"
+)
+
+// runStatus runs the status command against fc for the manifest given, with
+// the flags given, returning what the command and the report printed.
+func runStatus(t *testing.T, fc *fakeclient.Client, content string, args ...string) (string, error) {
+	t.Helper()
+
+	file := filepath.Join(t.TempDir(), "concord.yml")
+
+	err := os.WriteFile(file, []byte(content), 0o600)
+	if err != nil {
+		t.Fatalf("write manifest: %v", err)
+	}
+
+	out := captureReport(t)
+
+	var cmdOut bytes.Buffer
+
+	c := NewStatusCmd(&cmdOut)
+	c.PersistentPreRunE = nil
+
+	ctx := client.WithGitHubClient(context.Background(), fc)
+
+	err = executeTestCmd(t, ctx, c, append([]string{file}, args...)...)
+
+	return cmdOut.String() + out.String(), err
+}
+
+func TestStatusRun(t *testing.T) {
+	tests := []struct {
+		name     string
+		manifest string
+		pending  int
+		want     string
+		wantErr  error
+	}{
+		{
+			name:     "in sync",
+			manifest: "organization:\n  name: acme\n  repositories:\n    - name: widgets\n",
+			want:     "in sync",
+		},
+		{
+			name:     "drift",
+			manifest: "organization:\n  name: acme\n  repositories:\n    - name: widgets\n    - name: gadgets\n",
+			pending:  1,
+			want:     "1 changes pending",
+			wantErr:  errDrift,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fc := healthyFake()
+			fc.Repos = []*github.Repository{{Name: github.String("widgets")}}
+
+			out, err := runStatus(t, fc, tt.manifest)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("err = %v, want %v", err, tt.wantErr)
+			}
+
+			if !strings.Contains(out, tt.want) {
+				t.Errorf("output missing %q:\n%s", tt.want, out)
+			}
+
+			if len(fc.Flushed) != 0 {
+				t.Errorf("status applied %d changes", len(fc.Flushed))
+			}
+
+			if len(fc.Pending()) != tt.pending {
+				t.Errorf("pending = %v, want %d", fc.Pending(), tt.pending)
+			}
+		})
+	}
+}
+
+func TestStatusJSON(t *testing.T) {
+	fc := healthyFake()
+	fc.Repos = []*github.Repository{{Name: github.String("widgets")}}
+
+	out, err := runStatus(t, fc, "organization:\n  name: acme\n  repositories:\n    - name: widgets\n    - name: gadgets\n", "--output", "json")
+	if !errors.Is(err, errDrift) {
+		t.Fatalf("err = %v, want %v", err, errDrift)
+	}
+
+	var s status
+
+	err = json.Unmarshal([]byte(out), &s)
+	if err != nil {
+		t.Fatalf("decode status: %v\n%s", err, out)
+	}
+
+	if s.InSync || s.Pending != 1 || len(s.Changes) != 1 {
+		t.Errorf("status = in sync %v, %d pending, %d changes, want drift with 1 change", s.InSync, s.Pending, len(s.Changes))
+	}
+
+	found := false
+	for _, sec := range s.Sections {
+		if sec.Section == "Repos" {
+			found = true
+		}
+	}
+
+	if !found {
+		t.Errorf("sections missing Repos: %+v", s.Sections)
+	}
+}
+
+func TestStatusBadOutput(t *testing.T) {
+	_, err := runStatus(t, healthyFake(), "organization:\n  name: acme\n", "--output", "yaml")
+	if !errors.Is(err, errStatusOutput) {
+		t.Errorf("err = %v, want %v", err, errStatusOutput)
+	}
+}
@@ -0,0 +1,94 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	gh_pb "github.com/gomicro/concord/github/v1"
+	"github.com/spf13/cobra"
+	"golang.org/x/exp/slices"
+)
+
+var errUnknownTarget = errors.New("unknown target")
+
+// targetsFor returns the names of the resources of a kind picked with the
+// target flag, and whether any targets were given at all. Resources are
+// addressed as org, member/<login>, team/<name>, or repo/<name>. Commands
+// without the flag target everything.
+func targetsFor(cmd *cobra.Command, kind string) ([]string, bool) {
+	if cmd.Flags().Lookup("target") == nil {
+		return nil, false
+	}
+
+	addrs, _ := cmd.Flags().GetStringArray("target")
+	if len(addrs) == 0 {
+		return nil, false
+	}
+
+	names := []string{}
+	for _, a := range addrs {
+		k, name, _ := strings.Cut(a, "/")
+		if k == kind {
+			names = append(names, name)
+		}
+	}
+
+	return names, true
+}
+
+// validateTargets makes sure every address names a kind of resource that can
+// be targeted and, for all but the org, one the manifest has in any of its
+// organizations.
+func validateTargets(cmd *cobra.Command, orgs []*gh_pb.Organization) error {
+	if cmd.Flags().Lookup("target") == nil {
+		return nil
+	}
+
+	addrs, err := cmd.Flags().GetStringArray("target")
+	if err != nil {
+		return err
+	}
+
+	for _, a := range addrs {
+		kind, name, _ := strings.Cut(a, "/")
+
+		var found bool
+		for _, org := range orgs {
+			switch kind {
+			case "org":
+				found = name == ""
+			case "member":
+				found = slices.ContainsFunc(org.People, func(p *gh_pb.People) bool { return strings.EqualFold(p.Username, name) })
+			case "team":
+				found = slices.ContainsFunc(org.Teams, func(t *gh_pb.Team) bool { return t.Name == name })
+			case "repo":
+				found = slices.ContainsFunc(org.Repositories, func(r *gh_pb.Repository) bool { return r.Name == name })
+			default:
+				return fmt.Errorf("%w '%s': address resources as %s", errUnknownTarget, a, "org, member/<login>, team/<name>, or repo/<name>")
+			}
+
+			if found {
+				break
+			}
+		}
+
+		if !found {
+			return fmt.Errorf("%w '%s': not in the manifest", errUnknownTarget, a)
+		}
+	}
+
+	return nil
+}
+
+// onlyTargeted keeps the names that were targeted.
+func onlyTargeted(names, targets []string) []string {
+	kept := []string{}
+	for _, n := range names {
+		if slices.ContainsFunc(targets, func(t string) bool { return strings.EqualFold(t, n) }) {
+			kept = append(kept, n)
+		}
+	}
+
+	return kept
+}
@@ -0,0 +1,122 @@
+package cmd
+
+import (
+	"context"
+	"path"
+
+	"github.com/gomicro/concord/client"
+	gh_pb "github.com/gomicro/concord/github/v1"
+	"github.com/gomicro/concord/manifest"
+	"github.com/gomicro/concord/report"
+	"github.com/google/go-github/v56/github"
+	"github.com/spf13/cobra"
+	"golang.org/x/exp/slices"
+)
+
+// reportUnmanaged lists everything found in github that the manifest does not
+// mention, grouped by resource type, for each of the manifest's organizations.
+// It only reads from github.
+func reportUnmanaged(cmd *cobra.Command) error {
+	ctx := cmd.Context()
+
+	orgs, err := manifest.OrgsFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	clt, err := client.ClientFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, org := range orgs {
+		// sections are only told apart by org when there are several
+		prefix := ""
+		if len(orgs) > 1 {
+			prefix = org.Name + " "
+		}
+
+		err = reportUnmanagedOrg(ctx, clt, org, prefix)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func reportUnmanagedOrg(ctx context.Context, clt client.GitHubClient, org *gh_pb.Organization, prefix string) error {
+	repos, err := clt.GetRepos(ctx, org.Name)
+	if err != nil {
+		return err
+	}
+
+	printUnmanaged(prefix+"Repos", getUnmanagedRepos(org.Repositories, repos))
+
+	tms, err := clt.GetTeams(ctx, org.Name)
+	if err != nil {
+		return err
+	}
+
+	_, _, teams := getTeamsBreakdown(teamNames(org.Teams), tms)
+	printUnmanaged(prefix+"Teams", teams)
+
+	ms, err := clt.GetMembers(ctx, org.Name)
+	if err != nil {
+		return err
+	}
+
+	_, _, members := getMemberBreakdown(org.People, ms)
+	printUnmanaged(prefix+"Members", members)
+
+	var branches []string
+	for _, r := range org.Repositories {
+		if !slices.ContainsFunc(repos, func(ghr *github.Repository) bool { return ghr.GetName() == r.Name }) {
+			continue
+		}
+
+		protected, err := clt.GetProtectedBranches(ctx, org.Name, r.Name)
+		if err != nil {
+			return err
+		}
+
+		for _, b := range protected {
+			if !slices.ContainsFunc(r.ProtectedBranches, func(mb *gh_pb.Branch) bool { return managesBranch(mb, b) }) {
+				branches = append(branches, r.Name+":"+b)
+			}
+		}
+	}
+
+	printUnmanaged(prefix+"Protected Branches", branches)
+
+	return nil
+}
+
+// managesBranch reports whether a protected branch entry covers the branch,
+// by name or by pattern.
+func managesBranch(entry *gh_pb.Branch, branch string) bool {
+	if entry.Pattern != "" {
+		ok, _ := path.Match(entry.Pattern, branch)
+		return ok
+	}
+
+	return entry.Name == branch
+}
+
+func printUnmanaged(section string, names []string) {
+	report.Println()
+	report.PrintHeader("Unmanaged " + section)
+	report.Println()
+
+	if len(names) == 0 {
+		report.PrintInfo("none")
+		report.Println()
+
+		return
+	}
+
+	for _, n := range names {
+		report.PrintWarn(n + " exists in github but not in manifest")
+		report.Println()
+	}
+}
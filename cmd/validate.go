@@ -30,7 +30,7 @@ func NewValidateCmd(out io.Writer) *cobra.Command {
 func validateRun(cmd *cobra.Command, args []string) error {
 	file := cmd.Flags().Lookup("file").Value.String()
 
-	_, err := manifest.ReadManifest(file)
+	_, err := manifest.ReadManifests(file)
 	if err != nil {
 		return handleError(cmd, err)
 	}
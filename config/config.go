@@ -80,16 +80,13 @@ func (c *File) writeToFile(file string) error {
 	return nil
 }
 
-func WithConfig(ctx context.Context, file string) context.Context {
-	ctx, cancel := context.WithCancelCause(ctx)
-
+func WithConfig(ctx context.Context, file string) (context.Context, error) {
 	c, err := ParseFromFile()
 	if err != nil {
-		cancel(err)
-		return nil
+		return nil, err
 	}
 
-	return context.WithValue(ctx, ctxKeyConfig, c)
+	return context.WithValue(ctx, ctxKeyConfig, c), nil
 }
 
 func ConfigFromContext(ctx context.Context) (*File, error) {
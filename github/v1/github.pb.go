@@ -26,13 +26,33 @@ type Organization struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name         string          `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Defaults     *Defaults       `protobuf:"bytes,2,opt,name=defaults,proto3" json:"defaults,omitempty"`
-	Permissions  *OrgPermissions `protobuf:"bytes,3,opt,name=permissions,proto3" json:"permissions,omitempty"`
-	Teams        []string        `protobuf:"bytes,10,rep,name=teams,proto3" json:"teams,omitempty"`
-	People       []*People       `protobuf:"bytes,11,rep,name=people,proto3" json:"people,omitempty"`
-	Repositories []*Repository   `protobuf:"bytes,12,rep,name=repositories,proto3" json:"repositories,omitempty"`
-	Labels       []string        `protobuf:"bytes,13,rep,name=labels,proto3" json:"labels,omitempty"`
+	Name        string          `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Defaults    *Defaults       `protobuf:"bytes,2,opt,name=defaults,proto3" json:"defaults,omitempty"`
+	Permissions *OrgPermissions `protobuf:"bytes,3,opt,name=permissions,proto3" json:"permissions,omitempty"`
+	// Whether the authenticated account should watch the managed repositories.
+	// Setting this to false keeps automation accounts from being flooded with
+	// notifications for every repository they create or manage.
+	WatchRepositories *bool         `protobuf:"varint,4,opt,name=watch_repositories,json=watchRepositories,proto3,oneof" json:"watch_repositories,omitempty"`
+	Profile           *Profile      `protobuf:"bytes,5,opt,name=profile,proto3" json:"profile,omitempty"`
+	Teams             []*Team       `protobuf:"bytes,16,rep,name=teams,proto3" json:"teams,omitempty"`
+	People            []*People     `protobuf:"bytes,11,rep,name=people,proto3" json:"people,omitempty"`
+	Repositories      []*Repository `protobuf:"bytes,12,rep,name=repositories,proto3" json:"repositories,omitempty"`
+	// Topics added to every repository. Labels is the deprecated name for the
+	// same list, it is only read when no topics are given.
+	Topics    []string       `protobuf:"bytes,17,rep,name=topics,proto3" json:"topics,omitempty"`
+	Labels    []string       `protobuf:"bytes,13,rep,name=labels,proto3" json:"labels,omitempty"`
+	Secrets   []*OrgSecret   `protobuf:"bytes,14,rep,name=secrets,proto3" json:"secrets,omitempty"`
+	Variables []*OrgVariable `protobuf:"bytes,15,rep,name=variables,proto3" json:"variables,omitempty"`
+	// Webhooks on the organization, matched by url. Hooks in github that are not
+	// listed are only removed when pruning.
+	Webhooks []*Webhook `protobuf:"bytes,19,rep,name=webhooks,proto3" json:"webhooks,omitempty"`
+	// Which repositories may use GitHub Actions and which actions they may run.
+	ActionsPermissions *OrgActionsPermissions `protobuf:"bytes,20,opt,name=actions_permissions,json=actionsPermissions,proto3" json:"actions_permissions,omitempty"`
+	// When any are listed, only these fields are changed in github and every
+	// other one is left alone, even when the manifest sets it, so concord can
+	// share an org with other tooling. Listing a section, such as repo, manages
+	// every field in it. Repositories missing from github are still created.
+	ManagedFields []string `protobuf:"bytes,18,rep,name=managed_fields,json=managedFields,proto3" json:"managed_fields,omitempty"`
 }
 
 func (x *Organization) Reset() {
@@ -88,7 +108,21 @@ func (x *Organization) GetPermissions() *OrgPermissions {
 	return nil
 }
 
-func (x *Organization) GetTeams() []string {
+func (x *Organization) GetWatchRepositories() bool {
+	if x != nil && x.WatchRepositories != nil {
+		return *x.WatchRepositories
+	}
+	return false
+}
+
+func (x *Organization) GetProfile() *Profile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+func (x *Organization) GetTeams() []*Team {
 	if x != nil {
 		return x.Teams
 	}
@@ -109,6 +143,13 @@ func (x *Organization) GetRepositories() []*Repository {
 	return nil
 }
 
+func (x *Organization) GetTopics() []string {
+	if x != nil {
+		return x.Topics
+	}
+	return nil
+}
+
 func (x *Organization) GetLabels() []string {
 	if x != nil {
 		return x.Labels
@@ -116,20 +157,203 @@ func (x *Organization) GetLabels() []string {
 	return nil
 }
 
+func (x *Organization) GetSecrets() []*OrgSecret {
+	if x != nil {
+		return x.Secrets
+	}
+	return nil
+}
+
+func (x *Organization) GetVariables() []*OrgVariable {
+	if x != nil {
+		return x.Variables
+	}
+	return nil
+}
+
+func (x *Organization) GetWebhooks() []*Webhook {
+	if x != nil {
+		return x.Webhooks
+	}
+	return nil
+}
+
+func (x *Organization) GetActionsPermissions() *OrgActionsPermissions {
+	if x != nil {
+		return x.ActionsPermissions
+	}
+	return nil
+}
+
+func (x *Organization) GetManagedFields() []string {
+	if x != nil {
+		return x.ManagedFields
+	}
+	return nil
+}
+
+// Parent teams are created before their children.
+type Team struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name   string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Parent *string `protobuf:"bytes,2,opt,name=parent,proto3,oneof" json:"parent,omitempty"`
+	// The identity provider group, by name, the team's membership is synced
+	// from. Members are then left to the sync rather than being managed from
+	// the people listed.
+	IdpGroup *string `protobuf:"bytes,3,opt,name=idp_group,json=idpGroup,proto3,oneof" json:"idp_group,omitempty"`
+}
+
+func (x *Team) Reset() {
+	*x = Team{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_concord_github_v1_github_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Team) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Team) ProtoMessage() {}
+
+func (x *Team) ProtoReflect() protoreflect.Message {
+	mi := &file_concord_github_v1_github_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Team.ProtoReflect.Descriptor instead.
+func (*Team) Descriptor() ([]byte, []int) {
+	return file_concord_github_v1_github_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Team) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Team) GetParent() string {
+	if x != nil && x.Parent != nil {
+		return *x.Parent
+	}
+	return ""
+}
+
+func (x *Team) GetIdpGroup() string {
+	if x != nil && x.IdpGroup != nil {
+		return *x.IdpGroup
+	}
+	return ""
+}
+
+type Profile struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Description  *string `protobuf:"bytes,1,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	BillingEmail *string `protobuf:"bytes,2,opt,name=billing_email,json=billingEmail,proto3,oneof" json:"billing_email,omitempty"`
+	Company      *string `protobuf:"bytes,3,opt,name=company,proto3,oneof" json:"company,omitempty"`
+	Location     *string `protobuf:"bytes,4,opt,name=location,proto3,oneof" json:"location,omitempty"`
+	Blog         *string `protobuf:"bytes,5,opt,name=blog,proto3,oneof" json:"blog,omitempty"`
+}
+
+func (x *Profile) Reset() {
+	*x = Profile{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_concord_github_v1_github_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Profile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Profile) ProtoMessage() {}
+
+func (x *Profile) ProtoReflect() protoreflect.Message {
+	mi := &file_concord_github_v1_github_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Profile.ProtoReflect.Descriptor instead.
+func (*Profile) Descriptor() ([]byte, []int) {
+	return file_concord_github_v1_github_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Profile) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *Profile) GetBillingEmail() string {
+	if x != nil && x.BillingEmail != nil {
+		return *x.BillingEmail
+	}
+	return ""
+}
+
+func (x *Profile) GetCompany() string {
+	if x != nil && x.Company != nil {
+		return *x.Company
+	}
+	return ""
+}
+
+func (x *Profile) GetLocation() string {
+	if x != nil && x.Location != nil {
+		return *x.Location
+	}
+	return ""
+}
+
+func (x *Profile) GetBlog() string {
+	if x != nil && x.Blog != nil {
+		return *x.Blog
+	}
+	return ""
+}
+
 type OrgPermissions struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BasePermissions    *string `protobuf:"bytes,1,opt,name=base_permissions,json=basePermissions,proto3,oneof" json:"base_permissions,omitempty"`
-	CreatePrivateRepos *bool   `protobuf:"varint,2,opt,name=create_private_repos,json=createPrivateRepos,proto3,oneof" json:"create_private_repos,omitempty"`
-	CreatePublicRepos  *bool   `protobuf:"varint,3,opt,name=create_public_repos,json=createPublicRepos,proto3,oneof" json:"create_public_repos,omitempty"`
+	BasePermissions     *string `protobuf:"bytes,1,opt,name=base_permissions,json=basePermissions,proto3,oneof" json:"base_permissions,omitempty"`
+	CreatePrivateRepos  *bool   `protobuf:"varint,2,opt,name=create_private_repos,json=createPrivateRepos,proto3,oneof" json:"create_private_repos,omitempty"`
+	CreatePublicRepos   *bool   `protobuf:"varint,3,opt,name=create_public_repos,json=createPublicRepos,proto3,oneof" json:"create_public_repos,omitempty"`
+	CreateInternalRepos *bool   `protobuf:"varint,4,opt,name=create_internal_repos,json=createInternalRepos,proto3,oneof" json:"create_internal_repos,omitempty"`
+	CreatePages         *bool   `protobuf:"varint,5,opt,name=create_pages,json=createPages,proto3,oneof" json:"create_pages,omitempty"`
 }
 
 func (x *OrgPermissions) Reset() {
 	*x = OrgPermissions{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_concord_github_v1_github_proto_msgTypes[1]
+		mi := &file_concord_github_v1_github_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -142,7 +366,7 @@ func (x *OrgPermissions) String() string {
 func (*OrgPermissions) ProtoMessage() {}
 
 func (x *OrgPermissions) ProtoReflect() protoreflect.Message {
-	mi := &file_concord_github_v1_github_proto_msgTypes[1]
+	mi := &file_concord_github_v1_github_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -155,7 +379,7 @@ func (x *OrgPermissions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OrgPermissions.ProtoReflect.Descriptor instead.
 func (*OrgPermissions) Descriptor() ([]byte, []int) {
-	return file_concord_github_v1_github_proto_rawDescGZIP(), []int{1}
+	return file_concord_github_v1_github_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *OrgPermissions) GetBasePermissions() string {
@@ -179,7 +403,23 @@ func (x *OrgPermissions) GetCreatePublicRepos() bool {
 	return false
 }
 
-// Defaults are overriden by the same settings specified in the repository
+func (x *OrgPermissions) GetCreateInternalRepos() bool {
+	if x != nil && x.CreateInternalRepos != nil {
+		return *x.CreateInternalRepos
+	}
+	return false
+}
+
+func (x *OrgPermissions) GetCreatePages() bool {
+	if x != nil && x.CreatePages != nil {
+		return *x.CreatePages
+	}
+	return false
+}
+
+// Defaults are overriden by the same settings specified in the repository. A
+// setting left out of a repository inherits the default, while one set there,
+// even to false or empty, is kept.
 type Defaults struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -191,12 +431,26 @@ type Defaults struct {
 	AutoDeleteHeadBranches *bool                       `protobuf:"varint,4,opt,name=auto_delete_head_branches,json=autoDeleteHeadBranches,proto3,oneof" json:"auto_delete_head_branches,omitempty"`
 	ProtectedBranches      []*Branch                   `protobuf:"bytes,5,rep,name=protected_branches,json=protectedBranches,proto3" json:"protected_branches,omitempty"`
 	Permissions            map[string]*TeamPermissions `protobuf:"bytes,6,rep,name=permissions,proto3" json:"permissions,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	//repeated File            files                     = 7;
+	//repeated Secret          secrets                   = 8;
+	Visibility             *string `protobuf:"bytes,9,opt,name=visibility,proto3,oneof" json:"visibility,omitempty"`
+	HasIssues              *bool   `protobuf:"varint,10,opt,name=has_issues,json=hasIssues,proto3,oneof" json:"has_issues,omitempty"`
+	HasProjects            *bool   `protobuf:"varint,11,opt,name=has_projects,json=hasProjects,proto3,oneof" json:"has_projects,omitempty"`
+	HasWiki                *bool   `protobuf:"varint,12,opt,name=has_wiki,json=hasWiki,proto3,oneof" json:"has_wiki,omitempty"`
+	HasDiscussions         *bool   `protobuf:"varint,13,opt,name=has_discussions,json=hasDiscussions,proto3,oneof" json:"has_discussions,omitempty"`
+	VulnerabilityAlerts    *bool   `protobuf:"varint,14,opt,name=vulnerability_alerts,json=vulnerabilityAlerts,proto3,oneof" json:"vulnerability_alerts,omitempty"`
+	AutomatedSecurityFixes *bool   `protobuf:"varint,15,opt,name=automated_security_fixes,json=automatedSecurityFixes,proto3,oneof" json:"automated_security_fixes,omitempty"`
+	SecretScanning         *bool   `protobuf:"varint,16,opt,name=secret_scanning,json=secretScanning,proto3,oneof" json:"secret_scanning,omitempty"`
+	// Checks required on every repository's default branch when the manifest
+	// protects it, on top of the ones its protection lists. Protections that
+	// set checks must pass to false opt out.
+	RequiredChecks []string `protobuf:"bytes,17,rep,name=required_checks,json=requiredChecks,proto3" json:"required_checks,omitempty"`
 }
 
 func (x *Defaults) Reset() {
 	*x = Defaults{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_concord_github_v1_github_proto_msgTypes[2]
+		mi := &file_concord_github_v1_github_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -209,7 +463,7 @@ func (x *Defaults) String() string {
 func (*Defaults) ProtoMessage() {}
 
 func (x *Defaults) ProtoReflect() protoreflect.Message {
-	mi := &file_concord_github_v1_github_proto_msgTypes[2]
+	mi := &file_concord_github_v1_github_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -222,7 +476,7 @@ func (x *Defaults) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Defaults.ProtoReflect.Descriptor instead.
 func (*Defaults) Descriptor() ([]byte, []int) {
-	return file_concord_github_v1_github_proto_rawDescGZIP(), []int{2}
+	return file_concord_github_v1_github_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *Defaults) GetPrivate() bool {
@@ -267,6 +521,69 @@ func (x *Defaults) GetPermissions() map[string]*TeamPermissions {
 	return nil
 }
 
+func (x *Defaults) GetVisibility() string {
+	if x != nil && x.Visibility != nil {
+		return *x.Visibility
+	}
+	return ""
+}
+
+func (x *Defaults) GetHasIssues() bool {
+	if x != nil && x.HasIssues != nil {
+		return *x.HasIssues
+	}
+	return false
+}
+
+func (x *Defaults) GetHasProjects() bool {
+	if x != nil && x.HasProjects != nil {
+		return *x.HasProjects
+	}
+	return false
+}
+
+func (x *Defaults) GetHasWiki() bool {
+	if x != nil && x.HasWiki != nil {
+		return *x.HasWiki
+	}
+	return false
+}
+
+func (x *Defaults) GetHasDiscussions() bool {
+	if x != nil && x.HasDiscussions != nil {
+		return *x.HasDiscussions
+	}
+	return false
+}
+
+func (x *Defaults) GetVulnerabilityAlerts() bool {
+	if x != nil && x.VulnerabilityAlerts != nil {
+		return *x.VulnerabilityAlerts
+	}
+	return false
+}
+
+func (x *Defaults) GetAutomatedSecurityFixes() bool {
+	if x != nil && x.AutomatedSecurityFixes != nil {
+		return *x.AutomatedSecurityFixes
+	}
+	return false
+}
+
+func (x *Defaults) GetSecretScanning() bool {
+	if x != nil && x.SecretScanning != nil {
+		return *x.SecretScanning
+	}
+	return false
+}
+
+func (x *Defaults) GetRequiredChecks() []string {
+	if x != nil {
+		return x.RequiredChecks
+	}
+	return nil
+}
+
 type TeamPermissions struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -278,7 +595,7 @@ type TeamPermissions struct {
 func (x *TeamPermissions) Reset() {
 	*x = TeamPermissions{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_concord_github_v1_github_proto_msgTypes[3]
+		mi := &file_concord_github_v1_github_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -291,7 +608,7 @@ func (x *TeamPermissions) String() string {
 func (*TeamPermissions) ProtoMessage() {}
 
 func (x *TeamPermissions) ProtoReflect() protoreflect.Message {
-	mi := &file_concord_github_v1_github_proto_msgTypes[3]
+	mi := &file_concord_github_v1_github_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -304,7 +621,7 @@ func (x *TeamPermissions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TeamPermissions.ProtoReflect.Descriptor instead.
 func (*TeamPermissions) Descriptor() ([]byte, []int) {
-	return file_concord_github_v1_github_proto_rawDescGZIP(), []int{3}
+	return file_concord_github_v1_github_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *TeamPermissions) GetTeams() []string {
@@ -327,7 +644,7 @@ type People struct {
 func (x *People) Reset() {
 	*x = People{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_concord_github_v1_github_proto_msgTypes[4]
+		mi := &file_concord_github_v1_github_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -340,7 +657,7 @@ func (x *People) String() string {
 func (*People) ProtoMessage() {}
 
 func (x *People) ProtoReflect() protoreflect.Message {
-	mi := &file_concord_github_v1_github_proto_msgTypes[4]
+	mi := &file_concord_github_v1_github_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -353,7 +670,7 @@ func (x *People) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use People.ProtoReflect.Descriptor instead.
 func (*People) Descriptor() ([]byte, []int) {
-	return file_concord_github_v1_github_proto_rawDescGZIP(), []int{4}
+	return file_concord_github_v1_github_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *People) GetName() string {
@@ -377,41 +694,39 @@ func (x *People) GetTeams() []string {
 	return nil
 }
 
-type Repository struct {
+// Secret values are never stored in the manifest, only where to read them
+// from. Secrets can not be read back from github, so they are only set when
+// missing.
+// TODO: Figure out how and when to handle rotation of them.
+type Secret struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name        string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Description *string  `protobuf:"bytes,2,opt,name=description,proto3,oneof" json:"description,omitempty"`
-	Archived    *bool    `protobuf:"varint,3,opt,name=archived,proto3,oneof" json:"archived,omitempty"`
-	Labels      []string `protobuf:"bytes,4,rep,name=labels,proto3" json:"labels,omitempty"`
-	// Overrides defaults
-	Private                *bool                       `protobuf:"varint,10,opt,name=private,proto3,oneof" json:"private,omitempty"`
-	DefaultBranch          *string                     `protobuf:"bytes,11,opt,name=default_branch,json=defaultBranch,proto3,oneof" json:"default_branch,omitempty"`
-	AllowAutoMerge         *bool                       `protobuf:"varint,12,opt,name=allow_auto_merge,json=allowAutoMerge,proto3,oneof" json:"allow_auto_merge,omitempty"`
-	AutoDeleteHeadBranches *bool                       `protobuf:"varint,13,opt,name=auto_delete_head_branches,json=autoDeleteHeadBranches,proto3,oneof" json:"auto_delete_head_branches,omitempty"`
-	ProtectedBranches      []*Branch                   `protobuf:"bytes,14,rep,name=protected_branches,json=protectedBranches,proto3" json:"protected_branches,omitempty"`
-	Permissions            map[string]*TeamPermissions `protobuf:"bytes,15,rep,name=permissions,proto3" json:"permissions,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Types that are assignable to Value:
+	//	*Secret_FromEnv
+	//	*Secret_FromFile
+	Value isSecret_Value `protobuf_oneof:"value"`
 }
 
-func (x *Repository) Reset() {
-	*x = Repository{}
+func (x *Secret) Reset() {
+	*x = Secret{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_concord_github_v1_github_proto_msgTypes[5]
+		mi := &file_concord_github_v1_github_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Repository) String() string {
+func (x *Secret) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Repository) ProtoMessage() {}
+func (*Secret) ProtoMessage() {}
 
-func (x *Repository) ProtoReflect() protoreflect.Message {
-	mi := &file_concord_github_v1_github_proto_msgTypes[5]
+func (x *Secret) ProtoReflect() protoreflect.Message {
+	mi := &file_concord_github_v1_github_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -422,94 +737,1534 @@ func (x *Repository) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Repository.ProtoReflect.Descriptor instead.
-func (*Repository) Descriptor() ([]byte, []int) {
-	return file_concord_github_v1_github_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use Secret.ProtoReflect.Descriptor instead.
+func (*Secret) Descriptor() ([]byte, []int) {
+	return file_concord_github_v1_github_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *Repository) GetName() string {
+func (x *Secret) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *Repository) GetDescription() string {
-	if x != nil && x.Description != nil {
-		return *x.Description
+func (m *Secret) GetValue() isSecret_Value {
+	if m != nil {
+		return m.Value
 	}
-	return ""
+	return nil
 }
 
-func (x *Repository) GetArchived() bool {
-	if x != nil && x.Archived != nil {
-		return *x.Archived
+func (x *Secret) GetFromEnv() string {
+	if x, ok := x.GetValue().(*Secret_FromEnv); ok {
+		return x.FromEnv
 	}
-	return false
+	return ""
 }
 
-func (x *Repository) GetLabels() []string {
-	if x != nil {
-		return x.Labels
+func (x *Secret) GetFromFile() string {
+	if x, ok := x.GetValue().(*Secret_FromFile); ok {
+		return x.FromFile
 	}
-	return nil
+	return ""
 }
 
-func (x *Repository) GetPrivate() bool {
-	if x != nil && x.Private != nil {
-		return *x.Private
-	}
-	return false
+type isSecret_Value interface {
+	isSecret_Value()
 }
 
-func (x *Repository) GetDefaultBranch() string {
-	if x != nil && x.DefaultBranch != nil {
-		return *x.DefaultBranch
-	}
-	return ""
+type Secret_FromEnv struct {
+	FromEnv string `protobuf:"bytes,2,opt,name=from_env,json=fromEnv,proto3,oneof"`
 }
 
-func (x *Repository) GetAllowAutoMerge() bool {
-	if x != nil && x.AllowAutoMerge != nil {
+type Secret_FromFile struct {
+	FromFile string `protobuf:"bytes,3,opt,name=from_file,json=fromFile,proto3,oneof"`
+}
+
+func (*Secret_FromEnv) isSecret_Value() {}
+
+func (*Secret_FromFile) isSecret_Value() {}
+
+// Org secrets and variables are scoped to all repositories, private
+// repositories only, or the selected repositories listed.
+type OrgSecret struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Types that are assignable to Value:
+	//	*OrgSecret_FromEnv
+	//	*OrgSecret_FromFile
+	Value                isOrgSecret_Value `protobuf_oneof:"value"`
+	Visibility           string            `protobuf:"bytes,4,opt,name=visibility,proto3" json:"visibility,omitempty"`
+	SelectedRepositories []string          `protobuf:"bytes,5,rep,name=selected_repositories,json=selectedRepositories,proto3" json:"selected_repositories,omitempty"`
+}
+
+func (x *OrgSecret) Reset() {
+	*x = OrgSecret{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_concord_github_v1_github_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OrgSecret) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrgSecret) ProtoMessage() {}
+
+func (x *OrgSecret) ProtoReflect() protoreflect.Message {
+	mi := &file_concord_github_v1_github_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrgSecret.ProtoReflect.Descriptor instead.
+func (*OrgSecret) Descriptor() ([]byte, []int) {
+	return file_concord_github_v1_github_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *OrgSecret) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (m *OrgSecret) GetValue() isOrgSecret_Value {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (x *OrgSecret) GetFromEnv() string {
+	if x, ok := x.GetValue().(*OrgSecret_FromEnv); ok {
+		return x.FromEnv
+	}
+	return ""
+}
+
+func (x *OrgSecret) GetFromFile() string {
+	if x, ok := x.GetValue().(*OrgSecret_FromFile); ok {
+		return x.FromFile
+	}
+	return ""
+}
+
+func (x *OrgSecret) GetVisibility() string {
+	if x != nil {
+		return x.Visibility
+	}
+	return ""
+}
+
+func (x *OrgSecret) GetSelectedRepositories() []string {
+	if x != nil {
+		return x.SelectedRepositories
+	}
+	return nil
+}
+
+type isOrgSecret_Value interface {
+	isOrgSecret_Value()
+}
+
+type OrgSecret_FromEnv struct {
+	FromEnv string `protobuf:"bytes,2,opt,name=from_env,json=fromEnv,proto3,oneof"`
+}
+
+type OrgSecret_FromFile struct {
+	FromFile string `protobuf:"bytes,3,opt,name=from_file,json=fromFile,proto3,oneof"`
+}
+
+func (*OrgSecret_FromEnv) isOrgSecret_Value() {}
+
+func (*OrgSecret_FromFile) isOrgSecret_Value() {}
+
+type OrgVariable struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value                string   `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Visibility           string   `protobuf:"bytes,3,opt,name=visibility,proto3" json:"visibility,omitempty"`
+	SelectedRepositories []string `protobuf:"bytes,4,rep,name=selected_repositories,json=selectedRepositories,proto3" json:"selected_repositories,omitempty"`
+}
+
+func (x *OrgVariable) Reset() {
+	*x = OrgVariable{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_concord_github_v1_github_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OrgVariable) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrgVariable) ProtoMessage() {}
+
+func (x *OrgVariable) ProtoReflect() protoreflect.Message {
+	mi := &file_concord_github_v1_github_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrgVariable.ProtoReflect.Descriptor instead.
+func (*OrgVariable) Descriptor() ([]byte, []int) {
+	return file_concord_github_v1_github_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *OrgVariable) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *OrgVariable) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *OrgVariable) GetVisibility() string {
+	if x != nil {
+		return x.Visibility
+	}
+	return ""
+}
+
+func (x *OrgVariable) GetSelectedRepositories() []string {
+	if x != nil {
+		return x.SelectedRepositories
+	}
+	return nil
+}
+
+type Variable struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *Variable) Reset() {
+	*x = Variable{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_concord_github_v1_github_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Variable) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Variable) ProtoMessage() {}
+
+func (x *Variable) ProtoReflect() protoreflect.Message {
+	mi := &file_concord_github_v1_github_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Variable.ProtoReflect.Descriptor instead.
+func (*Variable) Descriptor() ([]byte, []int) {
+	return file_concord_github_v1_github_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *Variable) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Variable) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type Repository struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The repository's previous name. When no repository has the name yet, the
+	// one with this name is renamed rather than a new one being created.
+	RenameFrom  *string `protobuf:"bytes,34,opt,name=rename_from,json=renameFrom,proto3,oneof" json:"rename_from,omitempty"`
+	Description *string `protobuf:"bytes,2,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Archived    *bool   `protobuf:"varint,3,opt,name=archived,proto3,oneof" json:"archived,omitempty"`
+	// Labels is the deprecated name for topics, it is only read when no topics
+	// are given.
+	Topics         []string `protobuf:"bytes,38,rep,name=topics,proto3" json:"topics,omitempty"`
+	Labels         []string `protobuf:"bytes,4,rep,name=labels,proto3" json:"labels,omitempty"`
+	HasIssues      *bool    `protobuf:"varint,5,opt,name=has_issues,json=hasIssues,proto3,oneof" json:"has_issues,omitempty"`
+	HasProjects    *bool    `protobuf:"varint,6,opt,name=has_projects,json=hasProjects,proto3,oneof" json:"has_projects,omitempty"`
+	HasWiki        *bool    `protobuf:"varint,7,opt,name=has_wiki,json=hasWiki,proto3,oneof" json:"has_wiki,omitempty"`
+	HasDiscussions *bool    `protobuf:"varint,8,opt,name=has_discussions,json=hasDiscussions,proto3,oneof" json:"has_discussions,omitempty"`
+	Homepage       *string  `protobuf:"bytes,9,opt,name=homepage,proto3,oneof" json:"homepage,omitempty"`
+	// Overrides defaults
+	Private                *bool                       `protobuf:"varint,10,opt,name=private,proto3,oneof" json:"private,omitempty"`
+	DefaultBranch          *string                     `protobuf:"bytes,11,opt,name=default_branch,json=defaultBranch,proto3,oneof" json:"default_branch,omitempty"`
+	AllowAutoMerge         *bool                       `protobuf:"varint,12,opt,name=allow_auto_merge,json=allowAutoMerge,proto3,oneof" json:"allow_auto_merge,omitempty"`
+	AutoDeleteHeadBranches *bool                       `protobuf:"varint,13,opt,name=auto_delete_head_branches,json=autoDeleteHeadBranches,proto3,oneof" json:"auto_delete_head_branches,omitempty"`
+	ProtectedBranches      []*Branch                   `protobuf:"bytes,14,rep,name=protected_branches,json=protectedBranches,proto3" json:"protected_branches,omitempty"`
+	Permissions            map[string]*TeamPermissions `protobuf:"bytes,15,rep,name=permissions,proto3" json:"permissions,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	//repeated File            files                     = 16;
+	Secrets   []*Secret   `protobuf:"bytes,17,rep,name=secrets,proto3" json:"secrets,omitempty"`
+	Variables []*Variable `protobuf:"bytes,25,rep,name=variables,proto3" json:"variables,omitempty"`
+	// Webhooks are matched by url. Hooks with other urls are only removed from
+	// the repository when pruning.
+	Webhooks               []*Webhook `protobuf:"bytes,26,rep,name=webhooks,proto3" json:"webhooks,omitempty"`
+	VulnerabilityAlerts    *bool      `protobuf:"varint,27,opt,name=vulnerability_alerts,json=vulnerabilityAlerts,proto3,oneof" json:"vulnerability_alerts,omitempty"`
+	AutomatedSecurityFixes *bool      `protobuf:"varint,28,opt,name=automated_security_fixes,json=automatedSecurityFixes,proto3,oneof" json:"automated_security_fixes,omitempty"`
+	SecretScanning         *bool      `protobuf:"varint,29,opt,name=secret_scanning,json=secretScanning,proto3,oneof" json:"secret_scanning,omitempty"`
+	Pages                  *Pages     `protobuf:"bytes,30,opt,name=pages,proto3" json:"pages,omitempty"`
+	// Rulesets are matched by name and can be used alongside, or instead of,
+	// protected branches. Rulesets in github that are not listed are left
+	// alone.
+	Rulesets []*Ruleset `protobuf:"bytes,31,rep,name=rulesets,proto3" json:"rulesets,omitempty"`
+	// When any tag patterns are listed they are authoritative, protected
+	// patterns not listed are removed from the repository.
+	ProtectedTags []string `protobuf:"bytes,32,rep,name=protected_tags,json=protectedTags,proto3" json:"protected_tags,omitempty"`
+	// Users given access to the repository directly rather than through a
+	// team. Collaborators not listed are only removed when pruning.
+	Collaborators []*Collaborator `protobuf:"bytes,33,rep,name=collaborators,proto3" json:"collaborators,omitempty"`
+	// Autolinks are matched by key prefix. When any are listed they are
+	// authoritative, autolinks with other prefixes are removed from the
+	// repository.
+	Autolinks []*Autolink `protobuf:"bytes,36,rep,name=autolinks,proto3" json:"autolinks,omitempty"`
+	// Issue labels are matched by name, ignoring case. Labels not listed are
+	// only removed when pruning. These are separate from the deprecated labels,
+	// which set the repository's topics.
+	IssueLabels []*IssueLabel `protobuf:"bytes,37,rep,name=issue_labels,json=issueLabels,proto3" json:"issue_labels,omitempty"`
+	// Which GitHub Actions the repository may run.
+	ActionsPermissions *ActionsPermissions `protobuf:"bytes,40,opt,name=actions_permissions,json=actionsPermissions,proto3" json:"actions_permissions,omitempty"`
+	// Takes precedence over private when both are set. Internal visibility is
+	// only available to enterprise organizations.
+	Visibility *string `protobuf:"bytes,19,opt,name=visibility,proto3,oneof" json:"visibility,omitempty"`
+	// Only applied on GitHub Enterprise Server
+	PreReceiveHooks []*PreReceiveHook `protobuf:"bytes,18,rep,name=pre_receive_hooks,json=preReceiveHooks,proto3" json:"pre_receive_hooks,omitempty"`
+	// Only applied when the repository is created. Auto init seeds the repo with
+	// an initial commit so branch settings can be applied straight away.
+	AutoInit          *bool   `protobuf:"varint,20,opt,name=auto_init,json=autoInit,proto3,oneof" json:"auto_init,omitempty"`
+	LicenseTemplate   *string `protobuf:"bytes,21,opt,name=license_template,json=licenseTemplate,proto3,oneof" json:"license_template,omitempty"`
+	GitignoreTemplate *string `protobuf:"bytes,22,opt,name=gitignore_template,json=gitignoreTemplate,proto3,oneof" json:"gitignore_template,omitempty"`
+	// An empty topics list leaves the repo's topics alone unless clear topics is
+	// set, in which case all of them are removed. Clear labels is the deprecated
+	// name for it.
+	ClearTopics *bool `protobuf:"varint,39,opt,name=clear_topics,json=clearTopics,proto3,oneof" json:"clear_topics,omitempty"`
+	ClearLabels *bool `protobuf:"varint,23,opt,name=clear_labels,json=clearLabels,proto3,oneof" json:"clear_labels,omitempty"`
+	// Creates the default branch from the current one when it does not exist
+	// yet, instead of failing.
+	CreateDefaultBranch *bool `protobuf:"varint,24,opt,name=create_default_branch,json=createDefaultBranch,proto3,oneof" json:"create_default_branch,omitempty"`
+	// The SPDX id, such as MIT or Apache-2.0, of the license the repository
+	// must have. Unlike the license template it is kept in place on every run,
+	// committing the license text to the default branch whenever github detects
+	// a different license, or none.
+	License *string `protobuf:"bytes,35,opt,name=license,proto3,oneof" json:"license,omitempty"`
+}
+
+func (x *Repository) Reset() {
+	*x = Repository{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_concord_github_v1_github_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Repository) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Repository) ProtoMessage() {}
+
+func (x *Repository) ProtoReflect() protoreflect.Message {
+	mi := &file_concord_github_v1_github_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Repository.ProtoReflect.Descriptor instead.
+func (*Repository) Descriptor() ([]byte, []int) {
+	return file_concord_github_v1_github_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *Repository) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Repository) GetRenameFrom() string {
+	if x != nil && x.RenameFrom != nil {
+		return *x.RenameFrom
+	}
+	return ""
+}
+
+func (x *Repository) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *Repository) GetArchived() bool {
+	if x != nil && x.Archived != nil {
+		return *x.Archived
+	}
+	return false
+}
+
+func (x *Repository) GetTopics() []string {
+	if x != nil {
+		return x.Topics
+	}
+	return nil
+}
+
+func (x *Repository) GetLabels() []string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *Repository) GetHasIssues() bool {
+	if x != nil && x.HasIssues != nil {
+		return *x.HasIssues
+	}
+	return false
+}
+
+func (x *Repository) GetHasProjects() bool {
+	if x != nil && x.HasProjects != nil {
+		return *x.HasProjects
+	}
+	return false
+}
+
+func (x *Repository) GetHasWiki() bool {
+	if x != nil && x.HasWiki != nil {
+		return *x.HasWiki
+	}
+	return false
+}
+
+func (x *Repository) GetHasDiscussions() bool {
+	if x != nil && x.HasDiscussions != nil {
+		return *x.HasDiscussions
+	}
+	return false
+}
+
+func (x *Repository) GetHomepage() string {
+	if x != nil && x.Homepage != nil {
+		return *x.Homepage
+	}
+	return ""
+}
+
+func (x *Repository) GetPrivate() bool {
+	if x != nil && x.Private != nil {
+		return *x.Private
+	}
+	return false
+}
+
+func (x *Repository) GetDefaultBranch() string {
+	if x != nil && x.DefaultBranch != nil {
+		return *x.DefaultBranch
+	}
+	return ""
+}
+
+func (x *Repository) GetAllowAutoMerge() bool {
+	if x != nil && x.AllowAutoMerge != nil {
 		return *x.AllowAutoMerge
 	}
-	return false
+	return false
+}
+
+func (x *Repository) GetAutoDeleteHeadBranches() bool {
+	if x != nil && x.AutoDeleteHeadBranches != nil {
+		return *x.AutoDeleteHeadBranches
+	}
+	return false
+}
+
+func (x *Repository) GetProtectedBranches() []*Branch {
+	if x != nil {
+		return x.ProtectedBranches
+	}
+	return nil
+}
+
+func (x *Repository) GetPermissions() map[string]*TeamPermissions {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+func (x *Repository) GetSecrets() []*Secret {
+	if x != nil {
+		return x.Secrets
+	}
+	return nil
+}
+
+func (x *Repository) GetVariables() []*Variable {
+	if x != nil {
+		return x.Variables
+	}
+	return nil
+}
+
+func (x *Repository) GetWebhooks() []*Webhook {
+	if x != nil {
+		return x.Webhooks
+	}
+	return nil
+}
+
+func (x *Repository) GetVulnerabilityAlerts() bool {
+	if x != nil && x.VulnerabilityAlerts != nil {
+		return *x.VulnerabilityAlerts
+	}
+	return false
+}
+
+func (x *Repository) GetAutomatedSecurityFixes() bool {
+	if x != nil && x.AutomatedSecurityFixes != nil {
+		return *x.AutomatedSecurityFixes
+	}
+	return false
+}
+
+func (x *Repository) GetSecretScanning() bool {
+	if x != nil && x.SecretScanning != nil {
+		return *x.SecretScanning
+	}
+	return false
+}
+
+func (x *Repository) GetPages() *Pages {
+	if x != nil {
+		return x.Pages
+	}
+	return nil
+}
+
+func (x *Repository) GetRulesets() []*Ruleset {
+	if x != nil {
+		return x.Rulesets
+	}
+	return nil
+}
+
+func (x *Repository) GetProtectedTags() []string {
+	if x != nil {
+		return x.ProtectedTags
+	}
+	return nil
+}
+
+func (x *Repository) GetCollaborators() []*Collaborator {
+	if x != nil {
+		return x.Collaborators
+	}
+	return nil
+}
+
+func (x *Repository) GetAutolinks() []*Autolink {
+	if x != nil {
+		return x.Autolinks
+	}
+	return nil
+}
+
+func (x *Repository) GetIssueLabels() []*IssueLabel {
+	if x != nil {
+		return x.IssueLabels
+	}
+	return nil
+}
+
+func (x *Repository) GetActionsPermissions() *ActionsPermissions {
+	if x != nil {
+		return x.ActionsPermissions
+	}
+	return nil
+}
+
+func (x *Repository) GetVisibility() string {
+	if x != nil && x.Visibility != nil {
+		return *x.Visibility
+	}
+	return ""
+}
+
+func (x *Repository) GetPreReceiveHooks() []*PreReceiveHook {
+	if x != nil {
+		return x.PreReceiveHooks
+	}
+	return nil
+}
+
+func (x *Repository) GetAutoInit() bool {
+	if x != nil && x.AutoInit != nil {
+		return *x.AutoInit
+	}
+	return false
+}
+
+func (x *Repository) GetLicenseTemplate() string {
+	if x != nil && x.LicenseTemplate != nil {
+		return *x.LicenseTemplate
+	}
+	return ""
+}
+
+func (x *Repository) GetGitignoreTemplate() string {
+	if x != nil && x.GitignoreTemplate != nil {
+		return *x.GitignoreTemplate
+	}
+	return ""
+}
+
+func (x *Repository) GetClearTopics() bool {
+	if x != nil && x.ClearTopics != nil {
+		return *x.ClearTopics
+	}
+	return false
+}
+
+func (x *Repository) GetClearLabels() bool {
+	if x != nil && x.ClearLabels != nil {
+		return *x.ClearLabels
+	}
+	return false
+}
+
+func (x *Repository) GetCreateDefaultBranch() bool {
+	if x != nil && x.CreateDefaultBranch != nil {
+		return *x.CreateDefaultBranch
+	}
+	return false
+}
+
+func (x *Repository) GetLicense() string {
+	if x != nil && x.License != nil {
+		return *x.License
+	}
+	return ""
+}
+
+// Which GitHub Actions may run. Allowed actions is all, local_only, or
+// selected. Only with selected can actions owned by github, those from
+// verified creators, and those matching patterns such as octo-org/* be
+// allowed. Settings left unset are kept as they are in github.
+type ActionsPermissions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Enabled            *bool    `protobuf:"varint,1,opt,name=enabled,proto3,oneof" json:"enabled,omitempty"`
+	AllowedActions     *string  `protobuf:"bytes,2,opt,name=allowed_actions,json=allowedActions,proto3,oneof" json:"allowed_actions,omitempty"`
+	GithubOwnedAllowed *bool    `protobuf:"varint,3,opt,name=github_owned_allowed,json=githubOwnedAllowed,proto3,oneof" json:"github_owned_allowed,omitempty"`
+	VerifiedAllowed    *bool    `protobuf:"varint,4,opt,name=verified_allowed,json=verifiedAllowed,proto3,oneof" json:"verified_allowed,omitempty"`
+	PatternsAllowed    []string `protobuf:"bytes,5,rep,name=patterns_allowed,json=patternsAllowed,proto3" json:"patterns_allowed,omitempty"`
+}
+
+func (x *ActionsPermissions) Reset() {
+	*x = ActionsPermissions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_concord_github_v1_github_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ActionsPermissions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActionsPermissions) ProtoMessage() {}
+
+func (x *ActionsPermissions) ProtoReflect() protoreflect.Message {
+	mi := &file_concord_github_v1_github_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActionsPermissions.ProtoReflect.Descriptor instead.
+func (*ActionsPermissions) Descriptor() ([]byte, []int) {
+	return file_concord_github_v1_github_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ActionsPermissions) GetEnabled() bool {
+	if x != nil && x.Enabled != nil {
+		return *x.Enabled
+	}
+	return false
+}
+
+func (x *ActionsPermissions) GetAllowedActions() string {
+	if x != nil && x.AllowedActions != nil {
+		return *x.AllowedActions
+	}
+	return ""
+}
+
+func (x *ActionsPermissions) GetGithubOwnedAllowed() bool {
+	if x != nil && x.GithubOwnedAllowed != nil {
+		return *x.GithubOwnedAllowed
+	}
+	return false
+}
+
+func (x *ActionsPermissions) GetVerifiedAllowed() bool {
+	if x != nil && x.VerifiedAllowed != nil {
+		return *x.VerifiedAllowed
+	}
+	return false
+}
+
+func (x *ActionsPermissions) GetPatternsAllowed() []string {
+	if x != nil {
+		return x.PatternsAllowed
+	}
+	return nil
+}
+
+// The org wide policy for GitHub Actions. Enabled repositories is all, none,
+// or selected, in which case only the repositories listed may use Actions.
+// Allowed actions and the selected actions work as they do for a repository,
+// and bound what each repository may allow. Settings left unset are kept as
+// they are in github.
+type OrgActionsPermissions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EnabledRepositories  *string  `protobuf:"bytes,1,opt,name=enabled_repositories,json=enabledRepositories,proto3,oneof" json:"enabled_repositories,omitempty"`
+	SelectedRepositories []string `protobuf:"bytes,2,rep,name=selected_repositories,json=selectedRepositories,proto3" json:"selected_repositories,omitempty"`
+	AllowedActions       *string  `protobuf:"bytes,3,opt,name=allowed_actions,json=allowedActions,proto3,oneof" json:"allowed_actions,omitempty"`
+	GithubOwnedAllowed   *bool    `protobuf:"varint,4,opt,name=github_owned_allowed,json=githubOwnedAllowed,proto3,oneof" json:"github_owned_allowed,omitempty"`
+	VerifiedAllowed      *bool    `protobuf:"varint,5,opt,name=verified_allowed,json=verifiedAllowed,proto3,oneof" json:"verified_allowed,omitempty"`
+	PatternsAllowed      []string `protobuf:"bytes,6,rep,name=patterns_allowed,json=patternsAllowed,proto3" json:"patterns_allowed,omitempty"`
+}
+
+func (x *OrgActionsPermissions) Reset() {
+	*x = OrgActionsPermissions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_concord_github_v1_github_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OrgActionsPermissions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrgActionsPermissions) ProtoMessage() {}
+
+func (x *OrgActionsPermissions) ProtoReflect() protoreflect.Message {
+	mi := &file_concord_github_v1_github_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrgActionsPermissions.ProtoReflect.Descriptor instead.
+func (*OrgActionsPermissions) Descriptor() ([]byte, []int) {
+	return file_concord_github_v1_github_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *OrgActionsPermissions) GetEnabledRepositories() string {
+	if x != nil && x.EnabledRepositories != nil {
+		return *x.EnabledRepositories
+	}
+	return ""
+}
+
+func (x *OrgActionsPermissions) GetSelectedRepositories() []string {
+	if x != nil {
+		return x.SelectedRepositories
+	}
+	return nil
+}
+
+func (x *OrgActionsPermissions) GetAllowedActions() string {
+	if x != nil && x.AllowedActions != nil {
+		return *x.AllowedActions
+	}
+	return ""
+}
+
+func (x *OrgActionsPermissions) GetGithubOwnedAllowed() bool {
+	if x != nil && x.GithubOwnedAllowed != nil {
+		return *x.GithubOwnedAllowed
+	}
+	return false
+}
+
+func (x *OrgActionsPermissions) GetVerifiedAllowed() bool {
+	if x != nil && x.VerifiedAllowed != nil {
+		return *x.VerifiedAllowed
+	}
+	return false
+}
+
+func (x *OrgActionsPermissions) GetPatternsAllowed() []string {
+	if x != nil {
+		return x.PatternsAllowed
+	}
+	return nil
+}
+
+// An autolink turns references such as JIRA-123 into links. The url template
+// must contain <num> where the reference goes. References are alphanumeric
+// unless set otherwise, matching github.
+type Autolink struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	KeyPrefix      string `protobuf:"bytes,1,opt,name=key_prefix,json=keyPrefix,proto3" json:"key_prefix,omitempty"`
+	UrlTemplate    string `protobuf:"bytes,2,opt,name=url_template,json=urlTemplate,proto3" json:"url_template,omitempty"`
+	IsAlphanumeric *bool  `protobuf:"varint,3,opt,name=is_alphanumeric,json=isAlphanumeric,proto3,oneof" json:"is_alphanumeric,omitempty"`
+}
+
+func (x *Autolink) Reset() {
+	*x = Autolink{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_concord_github_v1_github_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Autolink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Autolink) ProtoMessage() {}
+
+func (x *Autolink) ProtoReflect() protoreflect.Message {
+	mi := &file_concord_github_v1_github_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Autolink.ProtoReflect.Descriptor instead.
+func (*Autolink) Descriptor() ([]byte, []int) {
+	return file_concord_github_v1_github_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *Autolink) GetKeyPrefix() string {
+	if x != nil {
+		return x.KeyPrefix
+	}
+	return ""
+}
+
+func (x *Autolink) GetUrlTemplate() string {
+	if x != nil {
+		return x.UrlTemplate
+	}
+	return ""
+}
+
+func (x *Autolink) GetIsAlphanumeric() bool {
+	if x != nil && x.IsAlphanumeric != nil {
+		return *x.IsAlphanumeric
+	}
+	return false
+}
+
+// The color is a hex code such as d73a4a, with or without the leading #. A
+// description left unset keeps whatever the label has in github.
+type IssueLabel struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Color       string  `protobuf:"bytes,2,opt,name=color,proto3" json:"color,omitempty"`
+	Description *string `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+}
+
+func (x *IssueLabel) Reset() {
+	*x = IssueLabel{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_concord_github_v1_github_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IssueLabel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueLabel) ProtoMessage() {}
+
+func (x *IssueLabel) ProtoReflect() protoreflect.Message {
+	mi := &file_concord_github_v1_github_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueLabel.ProtoReflect.Descriptor instead.
+func (*IssueLabel) Descriptor() ([]byte, []int) {
+	return file_concord_github_v1_github_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *IssueLabel) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *IssueLabel) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+func (x *IssueLabel) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+// Webhooks are matched by url. Content type defaults to json, events to push,
+// and hooks are active unless set otherwise. The secret is only ever read from
+// the environment.
+type Webhook struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Url           string   `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	ContentType   *string  `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3,oneof" json:"content_type,omitempty"`
+	Events        []string `protobuf:"bytes,3,rep,name=events,proto3" json:"events,omitempty"`
+	SecretFromEnv *string  `protobuf:"bytes,4,opt,name=secret_from_env,json=secretFromEnv,proto3,oneof" json:"secret_from_env,omitempty"`
+	Active        *bool    `protobuf:"varint,5,opt,name=active,proto3,oneof" json:"active,omitempty"`
+}
+
+func (x *Webhook) Reset() {
+	*x = Webhook{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_concord_github_v1_github_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Webhook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Webhook) ProtoMessage() {}
+
+func (x *Webhook) ProtoReflect() protoreflect.Message {
+	mi := &file_concord_github_v1_github_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Webhook.ProtoReflect.Descriptor instead.
+func (*Webhook) Descriptor() ([]byte, []int) {
+	return file_concord_github_v1_github_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *Webhook) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Webhook) GetContentType() string {
+	if x != nil && x.ContentType != nil {
+		return *x.ContentType
+	}
+	return ""
+}
+
+func (x *Webhook) GetEvents() []string {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *Webhook) GetSecretFromEnv() string {
+	if x != nil && x.SecretFromEnv != nil {
+		return *x.SecretFromEnv
+	}
+	return ""
+}
+
+func (x *Webhook) GetActive() bool {
+	if x != nil && x.Active != nil {
+		return *x.Active
+	}
+	return false
+}
+
+// Pages are published from a branch, at its root unless a path is given. The
+// custom domain and https enforcement are left alone when not set.
+type Pages struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Branch       string  `protobuf:"bytes,1,opt,name=branch,proto3" json:"branch,omitempty"`
+	Path         *string `protobuf:"bytes,2,opt,name=path,proto3,oneof" json:"path,omitempty"`
+	CustomDomain *string `protobuf:"bytes,3,opt,name=custom_domain,json=customDomain,proto3,oneof" json:"custom_domain,omitempty"`
+	EnforceHttps *bool   `protobuf:"varint,4,opt,name=enforce_https,json=enforceHttps,proto3,oneof" json:"enforce_https,omitempty"`
+}
+
+func (x *Pages) Reset() {
+	*x = Pages{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_concord_github_v1_github_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pages) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pages) ProtoMessage() {}
+
+func (x *Pages) ProtoReflect() protoreflect.Message {
+	mi := &file_concord_github_v1_github_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pages.ProtoReflect.Descriptor instead.
+func (*Pages) Descriptor() ([]byte, []int) {
+	return file_concord_github_v1_github_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *Pages) GetBranch() string {
+	if x != nil {
+		return x.Branch
+	}
+	return ""
+}
+
+func (x *Pages) GetPath() string {
+	if x != nil && x.Path != nil {
+		return *x.Path
+	}
+	return ""
+}
+
+func (x *Pages) GetCustomDomain() string {
+	if x != nil && x.CustomDomain != nil {
+		return *x.CustomDomain
+	}
+	return ""
+}
+
+func (x *Pages) GetEnforceHttps() bool {
+	if x != nil && x.EnforceHttps != nil {
+		return *x.EnforceHttps
+	}
+	return false
+}
+
+// A ruleset targets branches unless set to tags. Include and exclude take ref
+// patterns such as refs/heads/main, refs/tags/v*, or ~DEFAULT_BRANCH.
+type Ruleset struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string        `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Target      *string       `protobuf:"bytes,2,opt,name=target,proto3,oneof" json:"target,omitempty"`
+	Enforcement string        `protobuf:"bytes,3,opt,name=enforcement,proto3" json:"enforcement,omitempty"`
+	Include     []string      `protobuf:"bytes,4,rep,name=include,proto3" json:"include,omitempty"`
+	Exclude     []string      `protobuf:"bytes,5,rep,name=exclude,proto3" json:"exclude,omitempty"`
+	Rules       *RulesetRules `protobuf:"bytes,6,opt,name=rules,proto3" json:"rules,omitempty"`
+}
+
+func (x *Ruleset) Reset() {
+	*x = Ruleset{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_concord_github_v1_github_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Ruleset) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Ruleset) ProtoMessage() {}
+
+func (x *Ruleset) ProtoReflect() protoreflect.Message {
+	mi := &file_concord_github_v1_github_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Ruleset.ProtoReflect.Descriptor instead.
+func (*Ruleset) Descriptor() ([]byte, []int) {
+	return file_concord_github_v1_github_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *Ruleset) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Ruleset) GetTarget() string {
+	if x != nil && x.Target != nil {
+		return *x.Target
+	}
+	return ""
+}
+
+func (x *Ruleset) GetEnforcement() string {
+	if x != nil {
+		return x.Enforcement
+	}
+	return ""
+}
+
+func (x *Ruleset) GetInclude() []string {
+	if x != nil {
+		return x.Include
+	}
+	return nil
+}
+
+func (x *Ruleset) GetExclude() []string {
+	if x != nil {
+		return x.Exclude
+	}
+	return nil
+}
+
+func (x *Ruleset) GetRules() *RulesetRules {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+// Only the rules set to true, or given, are part of the ruleset.
+type RulesetRules struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Creation              bool                `protobuf:"varint,1,opt,name=creation,proto3" json:"creation,omitempty"`
+	Deletion              bool                `protobuf:"varint,2,opt,name=deletion,proto3" json:"deletion,omitempty"`
+	NonFastForward        bool                `protobuf:"varint,3,opt,name=non_fast_forward,json=nonFastForward,proto3" json:"non_fast_forward,omitempty"`
+	RequiredLinearHistory bool                `protobuf:"varint,4,opt,name=required_linear_history,json=requiredLinearHistory,proto3" json:"required_linear_history,omitempty"`
+	RequiredSignatures    bool                `protobuf:"varint,5,opt,name=required_signatures,json=requiredSignatures,proto3" json:"required_signatures,omitempty"`
+	PullRequest           *RulesetPullRequest `protobuf:"bytes,6,opt,name=pull_request,json=pullRequest,proto3" json:"pull_request,omitempty"`
+	RequiredStatusChecks  []string            `protobuf:"bytes,7,rep,name=required_status_checks,json=requiredStatusChecks,proto3" json:"required_status_checks,omitempty"`
+	StrictStatusChecks    bool                `protobuf:"varint,8,opt,name=strict_status_checks,json=strictStatusChecks,proto3" json:"strict_status_checks,omitempty"`
+}
+
+func (x *RulesetRules) Reset() {
+	*x = RulesetRules{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_concord_github_v1_github_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RulesetRules) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RulesetRules) ProtoMessage() {}
+
+func (x *RulesetRules) ProtoReflect() protoreflect.Message {
+	mi := &file_concord_github_v1_github_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RulesetRules.ProtoReflect.Descriptor instead.
+func (*RulesetRules) Descriptor() ([]byte, []int) {
+	return file_concord_github_v1_github_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *RulesetRules) GetCreation() bool {
+	if x != nil {
+		return x.Creation
+	}
+	return false
+}
+
+func (x *RulesetRules) GetDeletion() bool {
+	if x != nil {
+		return x.Deletion
+	}
+	return false
+}
+
+func (x *RulesetRules) GetNonFastForward() bool {
+	if x != nil {
+		return x.NonFastForward
+	}
+	return false
+}
+
+func (x *RulesetRules) GetRequiredLinearHistory() bool {
+	if x != nil {
+		return x.RequiredLinearHistory
+	}
+	return false
+}
+
+func (x *RulesetRules) GetRequiredSignatures() bool {
+	if x != nil {
+		return x.RequiredSignatures
+	}
+	return false
+}
+
+func (x *RulesetRules) GetPullRequest() *RulesetPullRequest {
+	if x != nil {
+		return x.PullRequest
+	}
+	return nil
+}
+
+func (x *RulesetRules) GetRequiredStatusChecks() []string {
+	if x != nil {
+		return x.RequiredStatusChecks
+	}
+	return nil
+}
+
+func (x *RulesetRules) GetStrictStatusChecks() bool {
+	if x != nil {
+		return x.StrictStatusChecks
+	}
+	return false
+}
+
+type RulesetPullRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RequiredApprovingReviewCount   uint32 `protobuf:"varint,1,opt,name=required_approving_review_count,json=requiredApprovingReviewCount,proto3" json:"required_approving_review_count,omitempty"`
+	DismissStaleReviewsOnPush      bool   `protobuf:"varint,2,opt,name=dismiss_stale_reviews_on_push,json=dismissStaleReviewsOnPush,proto3" json:"dismiss_stale_reviews_on_push,omitempty"`
+	RequireCodeOwnerReview         bool   `protobuf:"varint,3,opt,name=require_code_owner_review,json=requireCodeOwnerReview,proto3" json:"require_code_owner_review,omitempty"`
+	RequireLastPushApproval        bool   `protobuf:"varint,4,opt,name=require_last_push_approval,json=requireLastPushApproval,proto3" json:"require_last_push_approval,omitempty"`
+	RequiredReviewThreadResolution bool   `protobuf:"varint,5,opt,name=required_review_thread_resolution,json=requiredReviewThreadResolution,proto3" json:"required_review_thread_resolution,omitempty"`
+}
+
+func (x *RulesetPullRequest) Reset() {
+	*x = RulesetPullRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_concord_github_v1_github_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RulesetPullRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RulesetPullRequest) ProtoMessage() {}
+
+func (x *RulesetPullRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_concord_github_v1_github_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RulesetPullRequest.ProtoReflect.Descriptor instead.
+func (*RulesetPullRequest) Descriptor() ([]byte, []int) {
+	return file_concord_github_v1_github_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *RulesetPullRequest) GetRequiredApprovingReviewCount() uint32 {
+	if x != nil {
+		return x.RequiredApprovingReviewCount
+	}
+	return 0
+}
+
+func (x *RulesetPullRequest) GetDismissStaleReviewsOnPush() bool {
+	if x != nil {
+		return x.DismissStaleReviewsOnPush
+	}
+	return false
+}
+
+func (x *RulesetPullRequest) GetRequireCodeOwnerReview() bool {
+	if x != nil {
+		return x.RequireCodeOwnerReview
+	}
+	return false
+}
+
+func (x *RulesetPullRequest) GetRequireLastPushApproval() bool {
+	if x != nil {
+		return x.RequireLastPushApproval
+	}
+	return false
+}
+
+func (x *RulesetPullRequest) GetRequiredReviewThreadResolution() bool {
+	if x != nil {
+		return x.RequiredReviewThreadResolution
+	}
+	return false
+}
+
+type Collaborator struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Username   string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Permission string `protobuf:"bytes,2,opt,name=permission,proto3" json:"permission,omitempty"`
+}
+
+func (x *Collaborator) Reset() {
+	*x = Collaborator{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_concord_github_v1_github_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Collaborator) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Collaborator) ProtoMessage() {}
+
+func (x *Collaborator) ProtoReflect() protoreflect.Message {
+	mi := &file_concord_github_v1_github_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Collaborator.ProtoReflect.Descriptor instead.
+func (*Collaborator) Descriptor() ([]byte, []int) {
+	return file_concord_github_v1_github_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *Collaborator) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
 }
 
-func (x *Repository) GetAutoDeleteHeadBranches() bool {
-	if x != nil && x.AutoDeleteHeadBranches != nil {
-		return *x.AutoDeleteHeadBranches
+func (x *Collaborator) GetPermission() string {
+	if x != nil {
+		return x.Permission
 	}
-	return false
+	return ""
 }
 
-func (x *Repository) GetProtectedBranches() []*Branch {
+type PreReceiveHook struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Enforcement string `protobuf:"bytes,2,opt,name=enforcement,proto3" json:"enforcement,omitempty"`
+}
+
+func (x *PreReceiveHook) Reset() {
+	*x = PreReceiveHook{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_concord_github_v1_github_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PreReceiveHook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreReceiveHook) ProtoMessage() {}
+
+func (x *PreReceiveHook) ProtoReflect() protoreflect.Message {
+	mi := &file_concord_github_v1_github_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreReceiveHook.ProtoReflect.Descriptor instead.
+func (*PreReceiveHook) Descriptor() ([]byte, []int) {
+	return file_concord_github_v1_github_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *PreReceiveHook) GetName() string {
 	if x != nil {
-		return x.ProtectedBranches
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *Repository) GetPermissions() map[string]*TeamPermissions {
+func (x *PreReceiveHook) GetEnforcement() string {
 	if x != nil {
-		return x.Permissions
+		return x.Enforcement
 	}
-	return nil
+	return ""
 }
 
+// A branch entry protects either the branch named, or every branch matching
+// the pattern, a glob such as release/*. Branches matching a pattern are found
+// on each run, so ones created later are protected the next time.
 type Branch struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Name       string      `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Pattern    string      `protobuf:"bytes,3,opt,name=pattern,proto3" json:"pattern,omitempty"`
 	Protection *Protection `protobuf:"bytes,2,opt,name=protection,proto3" json:"protection,omitempty"`
 }
 
 func (x *Branch) Reset() {
 	*x = Branch{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_concord_github_v1_github_proto_msgTypes[6]
+		mi := &file_concord_github_v1_github_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -522,7 +2277,7 @@ func (x *Branch) String() string {
 func (*Branch) ProtoMessage() {}
 
 func (x *Branch) ProtoReflect() protoreflect.Message {
-	mi := &file_concord_github_v1_github_proto_msgTypes[6]
+	mi := &file_concord_github_v1_github_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -535,7 +2290,7 @@ func (x *Branch) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Branch.ProtoReflect.Descriptor instead.
 func (*Branch) Descriptor() ([]byte, []int) {
-	return file_concord_github_v1_github_proto_rawDescGZIP(), []int{6}
+	return file_concord_github_v1_github_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *Branch) GetName() string {
@@ -545,6 +2300,13 @@ func (x *Branch) GetName() string {
 	return ""
 }
 
+func (x *Branch) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
 func (x *Branch) GetProtection() *Protection {
 	if x != nil {
 		return x.Protection
@@ -561,12 +2323,16 @@ type Protection struct {
 	ChecksMustPass *bool    `protobuf:"varint,2,opt,name=checks_must_pass,json=checksMustPass,proto3,oneof" json:"checks_must_pass,omitempty"`
 	SignedCommits  *bool    `protobuf:"varint,3,opt,name=signed_commits,json=signedCommits,proto3,oneof" json:"signed_commits,omitempty"`
 	RequiredChecks []string `protobuf:"bytes,10,rep,name=required_checks,json=requiredChecks,proto3" json:"required_checks,omitempty"`
+	// Pins required checks, by name, to the id of the github app that must
+	// report them, so no other app can satisfy them. Checks not pinned can be
+	// reported by any app.
+	CheckAppIds map[string]int64 `protobuf:"bytes,11,rep,name=check_app_ids,json=checkAppIds,proto3" json:"check_app_ids,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
 }
 
 func (x *Protection) Reset() {
 	*x = Protection{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_concord_github_v1_github_proto_msgTypes[7]
+		mi := &file_concord_github_v1_github_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -579,7 +2345,7 @@ func (x *Protection) String() string {
 func (*Protection) ProtoMessage() {}
 
 func (x *Protection) ProtoReflect() protoreflect.Message {
-	mi := &file_concord_github_v1_github_proto_msgTypes[7]
+	mi := &file_concord_github_v1_github_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -592,7 +2358,7 @@ func (x *Protection) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Protection.ProtoReflect.Descriptor instead.
 func (*Protection) Descriptor() ([]byte, []int) {
-	return file_concord_github_v1_github_proto_rawDescGZIP(), []int{7}
+	return file_concord_github_v1_github_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *Protection) GetRequirePr() bool {
@@ -623,6 +2389,13 @@ func (x *Protection) GetRequiredChecks() []string {
 	return nil
 }
 
+func (x *Protection) GetCheckAppIds() map[string]int64 {
+	if x != nil {
+		return x.CheckAppIds
+	}
+	return nil
+}
+
 var File_concord_github_v1_github_proto protoreflect.FileDescriptor
 
 var file_concord_github_v1_github_proto_rawDesc = []byte{
@@ -631,7 +2404,7 @@ var file_concord_github_v1_github_proto_rawDesc = []byte{
 	0x12, 0x11, 0x63, 0x6f, 0x6e, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
 	0x2e, 0x76, 0x31, 0x1a, 0x1b, 0x62, 0x75, 0x66, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
 	0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x22, 0xcd, 0x02, 0x0a, 0x0c, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f,
+	0x22, 0x98, 0x0c, 0x0a, 0x0c, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f,
 	0x6e, 0x12, 0x1b, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
 	0x07, 0xba, 0x48, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x37,
 	0x0a, 0x08, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
@@ -641,153 +2414,720 @@ var file_concord_github_v1_github_proto_rawDesc = []byte{
 	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63,
 	0x6f, 0x6e, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x76, 0x31,
 	0x2e, 0x4f, 0x72, 0x67, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52,
-	0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05,
-	0x74, 0x65, 0x61, 0x6d, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x74, 0x65, 0x61,
-	0x6d, 0x73, 0x12, 0x31, 0x0a, 0x06, 0x70, 0x65, 0x6f, 0x70, 0x6c, 0x65, 0x18, 0x0b, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x6e, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x6f, 0x70, 0x6c, 0x65, 0x52, 0x06, 0x70,
-	0x65, 0x6f, 0x70, 0x6c, 0x65, 0x12, 0x41, 0x0a, 0x0c, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
-	0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x6f,
-	0x6e, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x76, 0x31, 0x2e,
-	0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x0c, 0x72, 0x65, 0x70, 0x6f,
-	0x73, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65,
-	0x6c, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73,
-	0x22, 0x93, 0x02, 0x0a, 0x0e, 0x4f, 0x72, 0x67, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x73, 0x12, 0x4f, 0x0a, 0x10, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x6d,
-	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1f, 0xba,
-	0x48, 0x1c, 0x72, 0x1a, 0x52, 0x04, 0x6e, 0x6f, 0x6e, 0x65, 0x52, 0x04, 0x72, 0x65, 0x61, 0x64,
-	0x52, 0x05, 0x77, 0x72, 0x69, 0x74, 0x65, 0x52, 0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x48, 0x00,
-	0x52, 0x0f, 0x62, 0x61, 0x73, 0x65, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x73, 0x88, 0x01, 0x01, 0x12, 0x35, 0x0a, 0x14, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x70,
-	0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x08, 0x48, 0x01, 0x52, 0x12, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x69, 0x76,
-	0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x88, 0x01, 0x01, 0x12, 0x33, 0x0a, 0x13, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x72, 0x65, 0x70,
-	0x6f, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x48, 0x02, 0x52, 0x11, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x88, 0x01, 0x01,
-	0x42, 0x13, 0x0a, 0x11, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x17, 0x0a, 0x15, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x5f, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x42, 0x16,
-	0x0a, 0x14, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63,
-	0x5f, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x22, 0xd0, 0x04, 0x0a, 0x08, 0x44, 0x65, 0x66, 0x61, 0x75,
-	0x6c, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x07, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x07, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x88,
-	0x01, 0x01, 0x12, 0x33, 0x0a, 0x0e, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x62, 0x72,
-	0x61, 0x6e, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04, 0x72,
-	0x02, 0x10, 0x01, 0x48, 0x01, 0x52, 0x0d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x42, 0x72,
-	0x61, 0x6e, 0x63, 0x68, 0x88, 0x01, 0x01, 0x12, 0x2d, 0x0a, 0x10, 0x61, 0x6c, 0x6c, 0x6f, 0x77,
-	0x5f, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x08, 0x48, 0x02, 0x52, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x41, 0x75, 0x74, 0x6f, 0x4d, 0x65,
-	0x72, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x3e, 0x0a, 0x19, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x64,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x5f, 0x62, 0x72, 0x61, 0x6e, 0x63,
-	0x68, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x48, 0x03, 0x52, 0x16, 0x61, 0x75, 0x74,
-	0x6f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x42, 0x72, 0x61, 0x6e, 0x63,
-	0x68, 0x65, 0x73, 0x88, 0x01, 0x01, 0x12, 0x48, 0x0a, 0x12, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63,
-	0x74, 0x65, 0x64, 0x5f, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x6e, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x52, 0x11, 0x70,
-	0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x73,
-	0x12, 0x80, 0x01, 0x0a, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
-	0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x63, 0x6f, 0x6e, 0x63, 0x6f, 0x72, 0x64,
-	0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x66, 0x61, 0x75,
-	0x6c, 0x74, 0x73, 0x2e, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x42, 0x30, 0xba, 0x48, 0x2d, 0x9a, 0x01, 0x2a, 0x22, 0x28, 0x72, 0x26,
-	0x52, 0x04, 0x72, 0x65, 0x61, 0x64, 0x52, 0x06, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x52, 0x05,
-	0x77, 0x72, 0x69, 0x74, 0x65, 0x52, 0x08, 0x6d, 0x61, 0x69, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x52,
-	0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x73, 0x1a, 0x62, 0x0a, 0x10, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x38, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x63, 0x6f, 0x6e, 0x63, 0x6f,
-	0x72, 0x64, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x61,
-	0x6d, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x70, 0x72, 0x69, 0x76,
-	0x61, 0x74, 0x65, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f,
-	0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x77,
-	0x5f, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x42, 0x1c, 0x0a, 0x1a, 0x5f,
+	0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x32, 0x0a, 0x12,
+	0x77, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x69,
+	0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x11, 0x77, 0x61, 0x74, 0x63,
+	0x68, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x88, 0x01, 0x01,
+	0x12, 0x34, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x6f, 0x6e, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x07, 0x70,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x2d, 0x0a, 0x05, 0x74, 0x65, 0x61, 0x6d, 0x73, 0x18,
+	0x10, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x6f, 0x6e, 0x63, 0x6f, 0x72, 0x64, 0x2e,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x05,
+	0x74, 0x65, 0x61, 0x6d, 0x73, 0x12, 0x31, 0x0a, 0x06, 0x70, 0x65, 0x6f, 0x70, 0x6c, 0x65, 0x18,
+	0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x6e, 0x63, 0x6f, 0x72, 0x64, 0x2e,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x6f, 0x70, 0x6c, 0x65,
+	0x52, 0x06, 0x70, 0x65, 0x6f, 0x70, 0x6c, 0x65, 0x12, 0x41, 0x0a, 0x0c, 0x72, 0x65, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d,
+	0x2e, 0x63, 0x6f, 0x6e, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x0c, 0x72,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x74,
+	0x6f, 0x70, 0x69, 0x63, 0x73, 0x18, 0x11, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x74, 0x6f, 0x70,
+	0x69, 0x63, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x0d, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x36, 0x0a, 0x07, 0x73,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63,
+	0x6f, 0x6e, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x76, 0x31,
+	0x2e, 0x4f, 0x72, 0x67, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x07, 0x73, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x73, 0x12, 0x3c, 0x0a, 0x09, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73,
+	0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x6f, 0x6e, 0x63, 0x6f, 0x72, 0x64,
+	0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x72, 0x67, 0x56, 0x61,
+	0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x09, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65,
+	0x73, 0x12, 0x36, 0x0a, 0x08, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x18, 0x13, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x6f, 0x6e, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52,
+	0x08, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x12, 0x59, 0x0a, 0x13, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x63, 0x6f, 0x6e, 0x63, 0x6f, 0x72, 0x64,
+	0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x72, 0x67, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x12, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x87, 0x06, 0x0a, 0x0e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x64,
+	0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x12, 0x20, 0x03, 0x28, 0x09, 0x42, 0xdf, 0x05,
+	0xba, 0x48, 0xdb, 0x05, 0x92, 0x01, 0xd7, 0x05, 0x22, 0xd4, 0x05, 0x72, 0xd1, 0x05, 0x52, 0x03,
+	0x6f, 0x72, 0x67, 0x52, 0x0b, 0x6f, 0x72, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x52, 0x0f, 0x6f, 0x72, 0x67, 0x2e, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x0b, 0x6f, 0x72, 0x67, 0x2e, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x52, 0x0d,
+	0x6f, 0x72, 0x67, 0x2e, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x52, 0x0c, 0x6f,
+	0x72, 0x67, 0x2e, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x52, 0x17, 0x6f, 0x72, 0x67,
+	0x2e, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x05, 0x74,
+	0x65, 0x61, 0x6d, 0x73, 0x52, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x52, 0x10, 0x72, 0x65, 0x70, 0x6f,
+	0x2e, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x72, 0x65,
+	0x70, 0x6f, 0x2e, 0x68, 0x6f, 0x6d, 0x65, 0x70, 0x61, 0x67, 0x65, 0x52, 0x0d, 0x72, 0x65, 0x70,
+	0x6f, 0x2e, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x52, 0x0f, 0x72, 0x65, 0x70, 0x6f,
+	0x2e, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x13, 0x72, 0x65, 0x70,
+	0x6f, 0x2e, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68,
+	0x52, 0x0f, 0x72, 0x65, 0x70, 0x6f, 0x2e, 0x68, 0x61, 0x73, 0x5f, 0x69, 0x73, 0x73, 0x75, 0x65,
+	0x73, 0x52, 0x11, 0x72, 0x65, 0x70, 0x6f, 0x2e, 0x68, 0x61, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x73, 0x52, 0x0d, 0x72, 0x65, 0x70, 0x6f, 0x2e, 0x68, 0x61, 0x73, 0x5f, 0x77,
+	0x69, 0x6b, 0x69, 0x52, 0x14, 0x72, 0x65, 0x70, 0x6f, 0x2e, 0x68, 0x61, 0x73, 0x5f, 0x64, 0x69,
+	0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x1e, 0x72, 0x65, 0x70, 0x6f, 0x2e,
 	0x61, 0x75, 0x74, 0x6f, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x5f, 0x68, 0x65, 0x61, 0x64,
-	0x5f, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x22, 0x27, 0x0a, 0x0f, 0x54, 0x65, 0x61,
-	0x6d, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05,
-	0x74, 0x65, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x74, 0x65, 0x61,
-	0x6d, 0x73, 0x22, 0x60, 0x0a, 0x06, 0x50, 0x65, 0x6f, 0x70, 0x6c, 0x65, 0x12, 0x1b, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04, 0x72,
-	0x02, 0x10, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x08, 0x75, 0x73, 0x65,
-	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04,
-	0x72, 0x02, 0x10, 0x01, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14,
-	0x0a, 0x05, 0x74, 0x65, 0x61, 0x6d, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x74,
-	0x65, 0x61, 0x6d, 0x73, 0x22, 0xee, 0x05, 0x0a, 0x0a, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
-	0x6f, 0x72, 0x79, 0x12, 0x1b, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x07, 0xba, 0x48, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x25, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x61, 0x72, 0x63, 0x68, 0x69,
-	0x76, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x48, 0x01, 0x52, 0x08, 0x61, 0x72, 0x63,
-	0x68, 0x69, 0x76, 0x65, 0x64, 0x88, 0x01, 0x01, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65,
-	0x6c, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73,
-	0x12, 0x1d, 0x0a, 0x07, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28,
-	0x08, 0x48, 0x02, 0x52, 0x07, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12,
-	0x33, 0x0a, 0x0e, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x62, 0x72, 0x61, 0x6e, 0x63,
-	0x68, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04, 0x72, 0x02, 0x10, 0x01,
-	0x48, 0x03, 0x52, 0x0d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x42, 0x72, 0x61, 0x6e, 0x63,
-	0x68, 0x88, 0x01, 0x01, 0x12, 0x2d, 0x0a, 0x10, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x61, 0x75,
-	0x74, 0x6f, 0x5f, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x48, 0x04,
-	0x52, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x41, 0x75, 0x74, 0x6f, 0x4d, 0x65, 0x72, 0x67, 0x65,
-	0x88, 0x01, 0x01, 0x12, 0x3e, 0x0a, 0x19, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x64, 0x65, 0x6c, 0x65,
+	0x5f, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x52, 0x15, 0x72, 0x65, 0x70, 0x6f, 0x2e,
+	0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x6d, 0x65, 0x72, 0x67, 0x65,
+	0x52, 0x0b, 0x72, 0x65, 0x70, 0x6f, 0x2e, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x52, 0x0a, 0x72,
+	0x65, 0x70, 0x6f, 0x2e, 0x74, 0x65, 0x61, 0x6d, 0x73, 0x52, 0x16, 0x72, 0x65, 0x70, 0x6f, 0x2e,
+	0x70, 0x72, 0x65, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x5f, 0x68, 0x6f, 0x6f, 0x6b,
+	0x73, 0x52, 0x0c, 0x72, 0x65, 0x70, 0x6f, 0x2e, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x52,
+	0x0e, 0x72, 0x65, 0x70, 0x6f, 0x2e, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x52,
+	0x0d, 0x72, 0x65, 0x70, 0x6f, 0x2e, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x52, 0x0d,
+	0x72, 0x65, 0x70, 0x6f, 0x2e, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x52, 0x0a, 0x72,
+	0x65, 0x70, 0x6f, 0x2e, 0x70, 0x61, 0x67, 0x65, 0x73, 0x52, 0x0d, 0x72, 0x65, 0x70, 0x6f, 0x2e,
+	0x72, 0x75, 0x6c, 0x65, 0x73, 0x65, 0x74, 0x73, 0x52, 0x13, 0x72, 0x65, 0x70, 0x6f, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x61, 0x67, 0x73, 0x52, 0x0e, 0x72,
+	0x65, 0x70, 0x6f, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x52, 0x12, 0x72,
+	0x65, 0x70, 0x6f, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x74, 0x6f, 0x72,
+	0x73, 0x52, 0x11, 0x72, 0x65, 0x70, 0x6f, 0x2e, 0x69, 0x73, 0x73, 0x75, 0x65, 0x5f, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x73, 0x52, 0x18, 0x72, 0x65, 0x70, 0x6f, 0x2e, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0c,
+	0x72, 0x65, 0x70, 0x6f, 0x2e, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x72, 0x65,
+	0x70, 0x6f, 0x2e, 0x77, 0x61, 0x74, 0x63, 0x68, 0x52, 0x11, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68,
+	0x5f, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x1c, 0x62, 0x72, 0x61,
+	0x6e, 0x63, 0x68, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x72,
+	0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x5f, 0x70, 0x72, 0x52, 0x22, 0x62, 0x72, 0x61, 0x6e, 0x63,
+	0x68, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x65,
+	0x63, 0x6b, 0x73, 0x5f, 0x6d, 0x75, 0x73, 0x74, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x52, 0x20, 0x62,
+	0x72, 0x61, 0x6e, 0x63, 0x68, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x52,
+	0x0d, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x64, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x42, 0x15,
+	0x0a, 0x13, 0x5f, 0x77, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x6f, 0x72, 0x69, 0x65, 0x73, 0x4a, 0x04, 0x08, 0x0a, 0x10, 0x0b, 0x22, 0x8d, 0x01, 0x0a, 0x04,
+	0x54, 0x65, 0x61, 0x6d, 0x12, 0x1b, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x24, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x07, 0xba, 0x48, 0x04, 0x72, 0x02, 0x10, 0x01, 0x48, 0x00, 0x52, 0x06, 0x70, 0x61,
+	0x72, 0x65, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x29, 0x0a, 0x09, 0x69, 0x64, 0x70, 0x5f, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04, 0x72,
+	0x02, 0x10, 0x01, 0x48, 0x01, 0x52, 0x08, 0x69, 0x64, 0x70, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x88,
+	0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x42, 0x0c, 0x0a,
+	0x0a, 0x5f, 0x69, 0x64, 0x70, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x22, 0x80, 0x02, 0x0a, 0x07,
+	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x25, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x31,
+	0x0a, 0x0d, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04, 0x72, 0x02, 0x60, 0x01, 0x48, 0x01,
+	0x52, 0x0c, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x88, 0x01,
+	0x01, 0x12, 0x1d, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x02, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x88, 0x01, 0x01,
+	0x12, 0x1f, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x03, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01,
+	0x01, 0x12, 0x17, 0x0a, 0x04, 0x62, 0x6c, 0x6f, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x04, 0x52, 0x04, 0x62, 0x6c, 0x6f, 0x67, 0x88, 0x01, 0x01, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x62,
+	0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x42, 0x0a, 0x0a, 0x08,
+	0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x62, 0x6c, 0x6f, 0x67, 0x22, 0x9f,
+	0x03, 0x0a, 0x0e, 0x4f, 0x72, 0x67, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x4f, 0x0a, 0x10, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1f, 0xba, 0x48, 0x1c,
+	0x72, 0x1a, 0x52, 0x04, 0x6e, 0x6f, 0x6e, 0x65, 0x52, 0x04, 0x72, 0x65, 0x61, 0x64, 0x52, 0x05,
+	0x77, 0x72, 0x69, 0x74, 0x65, 0x52, 0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x48, 0x00, 0x52, 0x0f,
+	0x62, 0x61, 0x73, 0x65, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x88,
+	0x01, 0x01, 0x12, 0x35, 0x0a, 0x14, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x72, 0x69,
+	0x76, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x48, 0x01, 0x52, 0x12, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x88, 0x01, 0x01, 0x12, 0x33, 0x0a, 0x13, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x48, 0x02, 0x52, 0x11, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x88, 0x01, 0x01, 0x12, 0x37,
+	0x0a, 0x15, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x48, 0x03, 0x52,
+	0x13, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x52,
+	0x65, 0x70, 0x6f, 0x73, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x48, 0x04, 0x52,
+	0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x61, 0x67, 0x65, 0x73, 0x88, 0x01, 0x01, 0x42,
+	0x13, 0x0a, 0x11, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x42, 0x17, 0x0a, 0x15, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f,
+	0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x42, 0x16, 0x0a,
+	0x14, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f,
+	0x72, 0x65, 0x70, 0x6f, 0x73, 0x42, 0x18, 0x0a, 0x16, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x42,
+	0x0f, 0x0a, 0x0d, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x73,
+	0x22, 0xa7, 0x09, 0x0a, 0x08, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x1d, 0x0a,
+	0x07, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00,
+	0x52, 0x07, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x33, 0x0a, 0x0e,
+	0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04, 0x72, 0x02, 0x10, 0x01, 0x48, 0x01, 0x52,
+	0x0d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x88, 0x01,
+	0x01, 0x12, 0x2d, 0x0a, 0x10, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x61, 0x75, 0x74, 0x6f, 0x5f,
+	0x6d, 0x65, 0x72, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x48, 0x02, 0x52, 0x0e, 0x61,
+	0x6c, 0x6c, 0x6f, 0x77, 0x41, 0x75, 0x74, 0x6f, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x88, 0x01, 0x01,
+	0x12, 0x3e, 0x0a, 0x19, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x5f,
+	0x68, 0x65, 0x61, 0x64, 0x5f, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x08, 0x48, 0x03, 0x52, 0x16, 0x61, 0x75, 0x74, 0x6f, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x48, 0x65, 0x61, 0x64, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x88, 0x01, 0x01,
+	0x12, 0x48, 0x0a, 0x12, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x72,
+	0x61, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63,
+	0x6f, 0x6e, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x76, 0x31,
+	0x2e, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x52, 0x11, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x12, 0x80, 0x01, 0x0a, 0x0b, 0x70,
+	0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2c, 0x2e, 0x63, 0x6f, 0x6e, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x2e, 0x50, 0x65,
+	0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x30,
+	0xba, 0x48, 0x2d, 0x9a, 0x01, 0x2a, 0x22, 0x28, 0x72, 0x26, 0x52, 0x04, 0x72, 0x65, 0x61, 0x64,
+	0x52, 0x06, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x52, 0x05, 0x77, 0x72, 0x69, 0x74, 0x65, 0x52,
+	0x08, 0x6d, 0x61, 0x69, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x52, 0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x52, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x45, 0x0a,
+	0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x20, 0xba, 0x48, 0x1d, 0x72, 0x1b, 0x52, 0x06, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63,
+	0x52, 0x07, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x48, 0x04, 0x52, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x79, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x68, 0x61, 0x73, 0x5f, 0x69, 0x73, 0x73, 0x75,
+	0x65, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x48, 0x05, 0x52, 0x09, 0x68, 0x61, 0x73, 0x49,
+	0x73, 0x73, 0x75, 0x65, 0x73, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x0c, 0x68, 0x61, 0x73, 0x5f,
+	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x48, 0x06,
+	0x52, 0x0b, 0x68, 0x61, 0x73, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x88, 0x01, 0x01,
+	0x12, 0x1e, 0x0a, 0x08, 0x68, 0x61, 0x73, 0x5f, 0x77, 0x69, 0x6b, 0x69, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x08, 0x48, 0x07, 0x52, 0x07, 0x68, 0x61, 0x73, 0x57, 0x69, 0x6b, 0x69, 0x88, 0x01, 0x01,
+	0x12, 0x2c, 0x0a, 0x0f, 0x68, 0x61, 0x73, 0x5f, 0x64, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x48, 0x08, 0x52, 0x0e, 0x68, 0x61, 0x73,
+	0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x88, 0x01, 0x01, 0x12, 0x36,
+	0x0a, 0x14, 0x76, 0x75, 0x6c, 0x6e, 0x65, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x5f,
+	0x61, 0x6c, 0x65, 0x72, 0x74, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x48, 0x09, 0x52, 0x13,
+	0x76, 0x75, 0x6c, 0x6e, 0x65, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x41, 0x6c, 0x65,
+	0x72, 0x74, 0x73, 0x88, 0x01, 0x01, 0x12, 0x3d, 0x0a, 0x18, 0x61, 0x75, 0x74, 0x6f, 0x6d, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x66, 0x69, 0x78,
+	0x65, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x08, 0x48, 0x0a, 0x52, 0x16, 0x61, 0x75, 0x74, 0x6f,
+	0x6d, 0x61, 0x74, 0x65, 0x64, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x46, 0x69, 0x78,
+	0x65, 0x73, 0x88, 0x01, 0x01, 0x12, 0x2c, 0x0a, 0x0f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x5f,
+	0x73, 0x63, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x48, 0x0b,
+	0x52, 0x0e, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x53, 0x63, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67,
+	0x88, 0x01, 0x01, 0x12, 0x35, 0x0a, 0x0f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x5f,
+	0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x18, 0x11, 0x20, 0x03, 0x28, 0x09, 0x42, 0x0c, 0xba, 0x48,
+	0x09, 0x92, 0x01, 0x06, 0x22, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x0e, 0x72, 0x65, 0x71, 0x75,
+	0x69, 0x72, 0x65, 0x64, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x1a, 0x62, 0x0a, 0x10, 0x50, 0x65,
+	0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x38, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x22, 0x2e, 0x63, 0x6f, 0x6e, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x61, 0x6d, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x0a,
+	0x0a, 0x08, 0x5f, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x64,
+	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x42, 0x13, 0x0a,
+	0x11, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x6d, 0x65, 0x72,
+	0x67, 0x65, 0x42, 0x1c, 0x0a, 0x1a, 0x5f, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x64, 0x65, 0x6c, 0x65,
 	0x74, 0x65, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x5f, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x73,
-	0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x48, 0x05, 0x52, 0x16, 0x61, 0x75, 0x74, 0x6f, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x48, 0x65, 0x61, 0x64, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x73,
-	0x88, 0x01, 0x01, 0x12, 0x48, 0x0a, 0x12, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64,
-	0x5f, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x42,
+	0x0d, 0x0a, 0x0b, 0x5f, 0x68, 0x61, 0x73, 0x5f, 0x69, 0x73, 0x73, 0x75, 0x65, 0x73, 0x42, 0x0f,
+	0x0a, 0x0d, 0x5f, 0x68, 0x61, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x42,
+	0x0b, 0x0a, 0x09, 0x5f, 0x68, 0x61, 0x73, 0x5f, 0x77, 0x69, 0x6b, 0x69, 0x42, 0x12, 0x0a, 0x10,
+	0x5f, 0x68, 0x61, 0x73, 0x5f, 0x64, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x42, 0x17, 0x0a, 0x15, 0x5f, 0x76, 0x75, 0x6c, 0x6e, 0x65, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x79, 0x5f, 0x61, 0x6c, 0x65, 0x72, 0x74, 0x73, 0x42, 0x1b, 0x0a, 0x19, 0x5f, 0x61, 0x75,
+	0x74, 0x6f, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79,
+	0x5f, 0x66, 0x69, 0x78, 0x65, 0x73, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x73, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x5f, 0x73, 0x63, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x22, 0x27, 0x0a, 0x0f, 0x54, 0x65,
+	0x61, 0x6d, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x65, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x74, 0x65,
+	0x61, 0x6d, 0x73, 0x22, 0x60, 0x0a, 0x06, 0x50, 0x65, 0x6f, 0x70, 0x6c, 0x65, 0x12, 0x1b, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04,
+	0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x08, 0x75, 0x73,
+	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba, 0x48,
+	0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x65, 0x61, 0x6d, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05,
+	0x74, 0x65, 0x61, 0x6d, 0x73, 0x22, 0x83, 0x01, 0x0a, 0x06, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x12, 0x1b, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07,
+	0xba, 0x48, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a,
+	0x08, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x65, 0x6e, 0x76, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x07, 0xba, 0x48, 0x04, 0x72, 0x02, 0x10, 0x01, 0x48, 0x00, 0x52, 0x07, 0x66, 0x72, 0x6f, 0x6d,
+	0x45, 0x6e, 0x76, 0x12, 0x26, 0x0a, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x66, 0x69, 0x6c, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04, 0x72, 0x02, 0x10, 0x01, 0x48,
+	0x00, 0x52, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x46, 0x69, 0x6c, 0x65, 0x42, 0x0e, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x12, 0x05, 0xba, 0x48, 0x02, 0x08, 0x01, 0x22, 0xfa, 0x01, 0x0a, 0x09,
+	0x4f, 0x72, 0x67, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x1b, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04, 0x72, 0x02, 0x10, 0x01,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x65,
+	0x6e, 0x76, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04, 0x72, 0x02, 0x10,
+	0x01, 0x48, 0x00, 0x52, 0x07, 0x66, 0x72, 0x6f, 0x6d, 0x45, 0x6e, 0x76, 0x12, 0x26, 0x0a, 0x09,
+	0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x07, 0xba, 0x48, 0x04, 0x72, 0x02, 0x10, 0x01, 0x48, 0x00, 0x52, 0x08, 0x66, 0x72, 0x6f, 0x6d,
+	0x46, 0x69, 0x6c, 0x65, 0x12, 0x3d, 0x0a, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1d, 0xba, 0x48, 0x1a, 0x72, 0x18, 0x52,
+	0x03, 0x61, 0x6c, 0x6c, 0x52, 0x07, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x52, 0x08, 0x73,
+	0x65, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x52, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c,
+	0x69, 0x74, 0x79, 0x12, 0x33, 0x0a, 0x15, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f,
+	0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x14, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x52, 0x65, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x42, 0x0e, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x12, 0x05, 0xba, 0x48, 0x02, 0x08, 0x01, 0x22, 0xb4, 0x01, 0x0a, 0x0b, 0x4f, 0x72, 0x67,
+	0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1b, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x3d, 0x0a, 0x0a, 0x76,
+	0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x1d, 0xba, 0x48, 0x1a, 0x72, 0x18, 0x52, 0x03, 0x61, 0x6c, 0x6c, 0x52, 0x07, 0x70, 0x72, 0x69,
+	0x76, 0x61, 0x74, 0x65, 0x52, 0x08, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x52, 0x0a,
+	0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x33, 0x0a, 0x15, 0x73, 0x65,
+	0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72,
+	0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x14, 0x73, 0x65, 0x6c, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x22,
+	0x3d, 0x0a, 0x08, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1b, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04, 0x72, 0x02,
+	0x10, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xf7,
+	0x14, 0x0a, 0x0a, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x1b, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04,
+	0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2d, 0x0a, 0x0b, 0x72, 0x65,
+	0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x22, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x07, 0xba, 0x48, 0x04, 0x72, 0x02, 0x10, 0x01, 0x48, 0x00, 0x52, 0x0a, 0x72, 0x65, 0x6e, 0x61,
+	0x6d, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01,
+	0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01,
+	0x12, 0x1f, 0x0a, 0x08, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x48, 0x02, 0x52, 0x08, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x88, 0x01,
+	0x01, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x18, 0x26, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x61, 0x62,
+	0x65, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c,
+	0x73, 0x12, 0x22, 0x0a, 0x0a, 0x68, 0x61, 0x73, 0x5f, 0x69, 0x73, 0x73, 0x75, 0x65, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x08, 0x48, 0x03, 0x52, 0x09, 0x68, 0x61, 0x73, 0x49, 0x73, 0x73, 0x75,
+	0x65, 0x73, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x0c, 0x68, 0x61, 0x73, 0x5f, 0x70, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x48, 0x04, 0x52, 0x0b, 0x68,
+	0x61, 0x73, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x88, 0x01, 0x01, 0x12, 0x1e, 0x0a,
+	0x08, 0x68, 0x61, 0x73, 0x5f, 0x77, 0x69, 0x6b, 0x69, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x48,
+	0x05, 0x52, 0x07, 0x68, 0x61, 0x73, 0x57, 0x69, 0x6b, 0x69, 0x88, 0x01, 0x01, 0x12, 0x2c, 0x0a,
+	0x0f, 0x68, 0x61, 0x73, 0x5f, 0x64, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x48, 0x06, 0x52, 0x0e, 0x68, 0x61, 0x73, 0x44, 0x69, 0x73,
+	0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x68,
+	0x6f, 0x6d, 0x65, 0x70, 0x61, 0x67, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x48, 0x07, 0x52,
+	0x08, 0x68, 0x6f, 0x6d, 0x65, 0x70, 0x61, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07,
+	0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x48, 0x08, 0x52,
+	0x07, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x33, 0x0a, 0x0e, 0x64,
+	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04, 0x72, 0x02, 0x10, 0x01, 0x48, 0x09, 0x52, 0x0d,
+	0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x88, 0x01, 0x01,
+	0x12, 0x2d, 0x0a, 0x10, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x6d,
+	0x65, 0x72, 0x67, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x48, 0x0a, 0x52, 0x0e, 0x61, 0x6c,
+	0x6c, 0x6f, 0x77, 0x41, 0x75, 0x74, 0x6f, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12,
+	0x3e, 0x0a, 0x19, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x5f, 0x68,
+	0x65, 0x61, 0x64, 0x5f, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x08, 0x48, 0x0b, 0x52, 0x16, 0x61, 0x75, 0x74, 0x6f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x48, 0x65, 0x61, 0x64, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x88, 0x01, 0x01, 0x12,
+	0x48, 0x0a, 0x12, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x72, 0x61,
+	0x6e, 0x63, 0x68, 0x65, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f,
+	0x6e, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x76, 0x31, 0x2e,
+	0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x52, 0x11, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x12, 0x82, 0x01, 0x0a, 0x0b, 0x70, 0x65,
+	0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x2e, 0x2e, 0x63, 0x6f, 0x6e, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x50,
+	0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42,
+	0x30, 0xba, 0x48, 0x2d, 0x9a, 0x01, 0x2a, 0x22, 0x28, 0x72, 0x26, 0x52, 0x04, 0x72, 0x65, 0x61,
+	0x64, 0x52, 0x06, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x52, 0x05, 0x77, 0x72, 0x69, 0x74, 0x65,
+	0x52, 0x08, 0x6d, 0x61, 0x69, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x52, 0x05, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x52, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x33,
+	0x0a, 0x07, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x18, 0x11, 0x20, 0x03, 0x28, 0x0b, 0x32,
 	0x19, 0x2e, 0x63, 0x6f, 0x6e, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x76, 0x31, 0x2e, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x52, 0x11, 0x70, 0x72, 0x6f, 0x74,
-	0x65, 0x63, 0x74, 0x65, 0x64, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x12, 0x82, 0x01,
-	0x0a, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0f, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x63, 0x6f, 0x6e, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x67, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f,
-	0x72, 0x79, 0x2e, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x42, 0x30, 0xba, 0x48, 0x2d, 0x9a, 0x01, 0x2a, 0x22, 0x28, 0x72, 0x26, 0x52,
-	0x04, 0x72, 0x65, 0x61, 0x64, 0x52, 0x06, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x52, 0x05, 0x77,
-	0x72, 0x69, 0x74, 0x65, 0x52, 0x08, 0x6d, 0x61, 0x69, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x52, 0x05,
-	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x73, 0x1a, 0x62, 0x0a, 0x10, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x38, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x63, 0x6f, 0x6e, 0x63, 0x6f, 0x72,
-	0x64, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x61, 0x6d,
-	0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x61, 0x72, 0x63, 0x68, 0x69,
-	0x76, 0x65, 0x64, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x42,
-	0x11, 0x0a, 0x0f, 0x5f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x62, 0x72, 0x61, 0x6e,
-	0x63, 0x68, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x61, 0x75, 0x74,
-	0x6f, 0x5f, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x42, 0x1c, 0x0a, 0x1a, 0x5f, 0x61, 0x75, 0x74, 0x6f,
-	0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x5f, 0x62, 0x72, 0x61,
-	0x6e, 0x63, 0x68, 0x65, 0x73, 0x22, 0x6c, 0x0a, 0x06, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x12,
-	0x1b, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba,
-	0x48, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x45, 0x0a, 0x0a,
-	0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x07, 0x73, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x73, 0x12, 0x39, 0x0a, 0x09, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73,
+	0x18, 0x19, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x6f, 0x6e, 0x63, 0x6f, 0x72, 0x64,
+	0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x72, 0x69, 0x61,
+	0x62, 0x6c, 0x65, 0x52, 0x09, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x12, 0x36,
+	0x0a, 0x08, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x18, 0x1a, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x63, 0x6f, 0x6e, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x08, 0x77, 0x65,
+	0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x12, 0x36, 0x0a, 0x14, 0x76, 0x75, 0x6c, 0x6e, 0x65, 0x72,
+	0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x61, 0x6c, 0x65, 0x72, 0x74, 0x73, 0x18, 0x1b,
+	0x20, 0x01, 0x28, 0x08, 0x48, 0x0c, 0x52, 0x13, 0x76, 0x75, 0x6c, 0x6e, 0x65, 0x72, 0x61, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x79, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x73, 0x88, 0x01, 0x01, 0x12, 0x3d,
+	0x0a, 0x18, 0x61, 0x75, 0x74, 0x6f, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x65, 0x63, 0x75,
+	0x72, 0x69, 0x74, 0x79, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x73, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x08,
+	0x48, 0x0d, 0x52, 0x16, 0x61, 0x75, 0x74, 0x6f, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x53, 0x65, 0x63,
+	0x75, 0x72, 0x69, 0x74, 0x79, 0x46, 0x69, 0x78, 0x65, 0x73, 0x88, 0x01, 0x01, 0x12, 0x2c, 0x0a,
+	0x0f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x5f, 0x73, 0x63, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67,
+	0x18, 0x1d, 0x20, 0x01, 0x28, 0x08, 0x48, 0x0e, 0x52, 0x0e, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x53, 0x63, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x88, 0x01, 0x01, 0x12, 0x2e, 0x0a, 0x05, 0x70,
+	0x61, 0x67, 0x65, 0x73, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x63, 0x6f, 0x6e,
+	0x63, 0x6f, 0x72, 0x64, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x61, 0x67, 0x65, 0x73, 0x52, 0x05, 0x70, 0x61, 0x67, 0x65, 0x73, 0x12, 0x36, 0x0a, 0x08, 0x72,
+	0x75, 0x6c, 0x65, 0x73, 0x65, 0x74, 0x73, 0x18, 0x1f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x63, 0x6f, 0x6e, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x65, 0x74, 0x52, 0x08, 0x72, 0x75, 0x6c, 0x65, 0x73,
+	0x65, 0x74, 0x73, 0x12, 0x33, 0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x5f, 0x74, 0x61, 0x67, 0x73, 0x18, 0x20, 0x20, 0x03, 0x28, 0x09, 0x42, 0x0c, 0xba, 0x48, 0x09,
+	0x92, 0x01, 0x06, 0x22, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x0d, 0x70, 0x72, 0x6f, 0x74, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x54, 0x61, 0x67, 0x73, 0x12, 0x45, 0x0a, 0x0d, 0x63, 0x6f, 0x6c, 0x6c,
+	0x61, 0x62, 0x6f, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x21, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1f, 0x2e, 0x63, 0x6f, 0x6e, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x74, 0x6f, 0x72,
+	0x52, 0x0d, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12,
+	0x39, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x6f, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x18, 0x24, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x6f, 0x6e, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x6f, 0x6c, 0x69, 0x6e, 0x6b, 0x52,
+	0x09, 0x61, 0x75, 0x74, 0x6f, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x12, 0x40, 0x0a, 0x0c, 0x69, 0x73,
+	0x73, 0x75, 0x65, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x25, 0x20, 0x03, 0x28, 0x0b,
 	0x32, 0x1d, 0x2e, 0x63, 0x6f, 0x6e, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42,
-	0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x22, 0xeb, 0x01, 0x0a, 0x0a, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x5f, 0x70, 0x72,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72,
-	0x65, 0x50, 0x72, 0x88, 0x01, 0x01, 0x12, 0x2d, 0x0a, 0x10, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73,
-	0x5f, 0x6d, 0x75, 0x73, 0x74, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
-	0x48, 0x01, 0x52, 0x0e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x4d, 0x75, 0x73, 0x74, 0x50, 0x61,
-	0x73, 0x73, 0x88, 0x01, 0x01, 0x12, 0x2a, 0x0a, 0x0e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f,
-	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x48, 0x02, 0x52,
-	0x0d, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x88, 0x01,
-	0x01, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x63, 0x68,
-	0x65, 0x63, 0x6b, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x71, 0x75,
-	0x69, 0x72, 0x65, 0x64, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x72,
-	0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x5f, 0x70, 0x72, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x63, 0x68,
-	0x65, 0x63, 0x6b, 0x73, 0x5f, 0x6d, 0x75, 0x73, 0x74, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x42, 0x11,
-	0x0a, 0x0f, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
-	0x73, 0x42, 0x2c, 0x5a, 0x2a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x67, 0x6f, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x2f, 0x63, 0x6f, 0x6e, 0x63, 0x6f, 0x72, 0x64, 0x2f,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2f, 0x76, 0x31, 0x3b, 0x67, 0x68, 0x5f, 0x70, 0x62, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x62, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x73, 0x73, 0x75, 0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52,
+	0x0b, 0x69, 0x73, 0x73, 0x75, 0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x56, 0x0a, 0x13,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x28, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x63, 0x6f, 0x6e, 0x63,
+	0x6f, 0x72, 0x64, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x12, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x45, 0x0a, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x79, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x42, 0x20, 0xba, 0x48, 0x1d, 0x72, 0x1b, 0x52,
+	0x06, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x52, 0x07, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65,
+	0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x48, 0x0f, 0x52, 0x0a, 0x76, 0x69,
+	0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x88, 0x01, 0x01, 0x12, 0x4d, 0x0a, 0x11, 0x70,
+	0x72, 0x65, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x5f, 0x68, 0x6f, 0x6f, 0x6b, 0x73,
+	0x18, 0x12, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x6f, 0x6e, 0x63, 0x6f, 0x72, 0x64,
+	0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x65, 0x52, 0x65,
+	0x63, 0x65, 0x69, 0x76, 0x65, 0x48, 0x6f, 0x6f, 0x6b, 0x52, 0x0f, 0x70, 0x72, 0x65, 0x52, 0x65,
+	0x63, 0x65, 0x69, 0x76, 0x65, 0x48, 0x6f, 0x6f, 0x6b, 0x73, 0x12, 0x20, 0x0a, 0x09, 0x61, 0x75,
+	0x74, 0x6f, 0x5f, 0x69, 0x6e, 0x69, 0x74, 0x18, 0x14, 0x20, 0x01, 0x28, 0x08, 0x48, 0x10, 0x52,
+	0x08, 0x61, 0x75, 0x74, 0x6f, 0x49, 0x6e, 0x69, 0x74, 0x88, 0x01, 0x01, 0x12, 0x2e, 0x0a, 0x10,
+	0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x18, 0x15, 0x20, 0x01, 0x28, 0x09, 0x48, 0x11, 0x52, 0x0f, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73,
+	0x65, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x32, 0x0a, 0x12,
+	0x67, 0x69, 0x74, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61,
+	0x74, 0x65, 0x18, 0x16, 0x20, 0x01, 0x28, 0x09, 0x48, 0x12, 0x52, 0x11, 0x67, 0x69, 0x74, 0x69,
+	0x67, 0x6e, 0x6f, 0x72, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x88, 0x01, 0x01,
+	0x12, 0x26, 0x0a, 0x0c, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x5f, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73,
+	0x18, 0x27, 0x20, 0x01, 0x28, 0x08, 0x48, 0x13, 0x52, 0x0b, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x54,
+	0x6f, 0x70, 0x69, 0x63, 0x73, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x0c, 0x63, 0x6c, 0x65, 0x61,
+	0x72, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x17, 0x20, 0x01, 0x28, 0x08, 0x48, 0x14,
+	0x52, 0x0b, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x88, 0x01, 0x01,
+	0x12, 0x37, 0x0a, 0x15, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x64, 0x65, 0x66, 0x61, 0x75,
+	0x6c, 0x74, 0x5f, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x18, 0x18, 0x20, 0x01, 0x28, 0x08, 0x48,
+	0x15, 0x52, 0x13, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x07, 0x6c, 0x69, 0x63,
+	0x65, 0x6e, 0x73, 0x65, 0x18, 0x23, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04, 0x72,
+	0x02, 0x10, 0x01, 0x48, 0x16, 0x52, 0x07, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x88, 0x01,
+	0x01, 0x1a, 0x62, 0x0a, 0x10, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x38, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x63, 0x6f, 0x6e, 0x63, 0x6f, 0x72, 0x64,
+	0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x61, 0x6d, 0x50,
+	0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x3a, 0x79, 0xba, 0x48, 0x76, 0x1a, 0x74, 0x0a, 0x16, 0x72, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x72, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x5f,
+	0x66, 0x72, 0x6f, 0x6d, 0x12, 0x21, 0x72, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x66, 0x72, 0x6f,
+	0x6d, 0x20, 0x6d, 0x75, 0x73, 0x74, 0x20, 0x64, 0x69, 0x66, 0x66, 0x65, 0x72, 0x20, 0x66, 0x72,
+	0x6f, 0x6d, 0x20, 0x6e, 0x61, 0x6d, 0x65, 0x1a, 0x37, 0x21, 0x68, 0x61, 0x73, 0x28, 0x74, 0x68,
+	0x69, 0x73, 0x2e, 0x72, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x29, 0x20,
+	0x7c, 0x7c, 0x20, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x72, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x66,
+	0x72, 0x6f, 0x6d, 0x20, 0x21, 0x3d, 0x20, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x6e, 0x61, 0x6d, 0x65,
+	0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x72, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d,
+	0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x42, 0x0d, 0x0a,
+	0x0b, 0x5f, 0x68, 0x61, 0x73, 0x5f, 0x69, 0x73, 0x73, 0x75, 0x65, 0x73, 0x42, 0x0f, 0x0a, 0x0d,
+	0x5f, 0x68, 0x61, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x42, 0x0b, 0x0a,
+	0x09, 0x5f, 0x68, 0x61, 0x73, 0x5f, 0x77, 0x69, 0x6b, 0x69, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x68,
+	0x61, 0x73, 0x5f, 0x64, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x0b,
+	0x0a, 0x09, 0x5f, 0x68, 0x6f, 0x6d, 0x65, 0x70, 0x61, 0x67, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x5f,
+	0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x64, 0x65, 0x66, 0x61,
+	0x75, 0x6c, 0x74, 0x5f, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x61,
+	0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x42,
+	0x1c, 0x0a, 0x1a, 0x5f, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x5f,
+	0x68, 0x65, 0x61, 0x64, 0x5f, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x42, 0x17, 0x0a,
+	0x15, 0x5f, 0x76, 0x75, 0x6c, 0x6e, 0x65, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x5f,
+	0x61, 0x6c, 0x65, 0x72, 0x74, 0x73, 0x42, 0x1b, 0x0a, 0x19, 0x5f, 0x61, 0x75, 0x74, 0x6f, 0x6d,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x66, 0x69,
+	0x78, 0x65, 0x73, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x5f, 0x73,
+	0x63, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x76, 0x69, 0x73, 0x69,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x61, 0x75, 0x74, 0x6f, 0x5f,
+	0x69, 0x6e, 0x69, 0x74, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65,
+	0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x67, 0x69,
+	0x74, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x5f, 0x74, 0x6f, 0x70, 0x69, 0x63,
+	0x73, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x5f, 0x6c, 0x61, 0x62, 0x65,
+	0x6c, 0x73, 0x42, 0x18, 0x0a, 0x16, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x64, 0x65,
+	0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x42, 0x0a, 0x0a, 0x08,
+	0x5f, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x22, 0x89, 0x05, 0x0a, 0x12, 0x41, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x1d, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x48, 0x00, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x88, 0x01, 0x01, 0x12, 0x4e,
+	0x0a, 0x0f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x20, 0xba, 0x48, 0x1d, 0x72, 0x1b, 0x52, 0x03,
+	0x61, 0x6c, 0x6c, 0x52, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x52,
+	0x08, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x48, 0x01, 0x52, 0x0e, 0x61, 0x6c, 0x6c,
+	0x6f, 0x77, 0x65, 0x64, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x88, 0x01, 0x01, 0x12, 0x35,
+	0x0a, 0x14, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x64, 0x5f, 0x61,
+	0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x48, 0x02, 0x52, 0x12,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x4f, 0x77, 0x6e, 0x65, 0x64, 0x41, 0x6c, 0x6c, 0x6f, 0x77,
+	0x65, 0x64, 0x88, 0x01, 0x01, 0x12, 0x2e, 0x0a, 0x10, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65,
+	0x64, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x48,
+	0x03, 0x52, 0x0f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x41, 0x6c, 0x6c, 0x6f, 0x77,
+	0x65, 0x64, 0x88, 0x01, 0x01, 0x12, 0x37, 0x0a, 0x10, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e,
+	0x73, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x42,
+	0x0c, 0xba, 0x48, 0x09, 0x92, 0x01, 0x06, 0x22, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x0f, 0x70,
+	0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x73, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x3a, 0x95,
+	0x02, 0xba, 0x48, 0x91, 0x02, 0x1a, 0x8e, 0x02, 0x0a, 0x1c, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x5f, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x73, 0x65,
+	0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x61, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x5f, 0x6f,
+	0x77, 0x6e, 0x65, 0x64, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x2c, 0x20, 0x76, 0x65,
+	0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x2c, 0x20,
+	0x61, 0x6e, 0x64, 0x20, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x73, 0x5f, 0x61, 0x6c, 0x6c,
+	0x6f, 0x77, 0x65, 0x64, 0x20, 0x6e, 0x65, 0x65, 0x64, 0x20, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65,
+	0x64, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20, 0x73, 0x65, 0x74, 0x20, 0x74, 0x6f,
+	0x20, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x1a, 0x8a, 0x01, 0x28, 0x21, 0x68, 0x61,
+	0x73, 0x28, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x5f, 0x6f, 0x77,
+	0x6e, 0x65, 0x64, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x29, 0x20, 0x26, 0x26, 0x20,
+	0x21, 0x68, 0x61, 0x73, 0x28, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69,
+	0x65, 0x64, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x29, 0x20, 0x26, 0x26, 0x20, 0x73,
+	0x69, 0x7a, 0x65, 0x28, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e,
+	0x73, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x29, 0x20, 0x3d, 0x3d, 0x20, 0x30, 0x29,
+	0x20, 0x7c, 0x7c, 0x20, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64,
+	0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20, 0x3d, 0x3d, 0x20, 0x27, 0x73, 0x65, 0x6c,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x27, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x64, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x17, 0x0a, 0x15, 0x5f, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x64, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x42,
+	0x13, 0x0a, 0x11, 0x5f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x61, 0x6c, 0x6c,
+	0x6f, 0x77, 0x65, 0x64, 0x22, 0xdb, 0x07, 0x0a, 0x15, 0x4f, 0x72, 0x67, 0x41, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x52,
+	0x0a, 0x14, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1a, 0xba, 0x48,
+	0x17, 0x72, 0x15, 0x52, 0x03, 0x61, 0x6c, 0x6c, 0x52, 0x04, 0x6e, 0x6f, 0x6e, 0x65, 0x52, 0x08,
+	0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x48, 0x00, 0x52, 0x13, 0x65, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x64, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x88,
+	0x01, 0x01, 0x12, 0x41, 0x0a, 0x15, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x72,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x42, 0x0c, 0xba, 0x48, 0x09, 0x92, 0x01, 0x06, 0x22, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52,
+	0x14, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x4e, 0x0a, 0x0f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64,
+	0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x20,
+	0xba, 0x48, 0x1d, 0x72, 0x1b, 0x52, 0x03, 0x61, 0x6c, 0x6c, 0x52, 0x0a, 0x6c, 0x6f, 0x63, 0x61,
+	0x6c, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x52, 0x08, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x48, 0x01, 0x52, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x41, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x88, 0x01, 0x01, 0x12, 0x35, 0x0a, 0x14, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x5f,
+	0x6f, 0x77, 0x6e, 0x65, 0x64, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x08, 0x48, 0x02, 0x52, 0x12, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x4f, 0x77, 0x6e,
+	0x65, 0x64, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x88, 0x01, 0x01, 0x12, 0x2e, 0x0a, 0x10,
+	0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x48, 0x03, 0x52, 0x0f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69,
+	0x65, 0x64, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x88, 0x01, 0x01, 0x12, 0x37, 0x0a, 0x10,
+	0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x73, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64,
+	0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x42, 0x0c, 0xba, 0x48, 0x09, 0x92, 0x01, 0x06, 0x22, 0x04,
+	0x72, 0x02, 0x10, 0x01, 0x52, 0x0f, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x73, 0x41, 0x6c,
+	0x6c, 0x6f, 0x77, 0x65, 0x64, 0x3a, 0xdf, 0x03, 0xba, 0x48, 0xdb, 0x03, 0x1a, 0xc3, 0x01, 0x0a,
+	0x2d, 0x6f, 0x72, 0x67, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x70, 0x65, 0x72,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x40,
+	0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x6f, 0x72, 0x69, 0x65, 0x73, 0x20, 0x6e, 0x65, 0x65, 0x64, 0x73, 0x20, 0x65, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73,
+	0x20, 0x73, 0x65, 0x74, 0x20, 0x74, 0x6f, 0x20, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x1a, 0x50, 0x73, 0x69, 0x7a, 0x65, 0x28, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x73, 0x65, 0x6c, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x65,
+	0x73, 0x29, 0x20, 0x3d, 0x3d, 0x20, 0x30, 0x20, 0x7c, 0x7c, 0x20, 0x74, 0x68, 0x69, 0x73, 0x2e,
+	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f,
+	0x72, 0x69, 0x65, 0x73, 0x20, 0x3d, 0x3d, 0x20, 0x27, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x27, 0x1a, 0x92, 0x02, 0x0a, 0x20, 0x6f, 0x72, 0x67, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x73,
+	0x65, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x61, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x5f,
+	0x6f, 0x77, 0x6e, 0x65, 0x64, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x2c, 0x20, 0x76,
+	0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x2c,
+	0x20, 0x61, 0x6e, 0x64, 0x20, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x73, 0x5f, 0x61, 0x6c,
+	0x6c, 0x6f, 0x77, 0x65, 0x64, 0x20, 0x6e, 0x65, 0x65, 0x64, 0x20, 0x61, 0x6c, 0x6c, 0x6f, 0x77,
+	0x65, 0x64, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20, 0x73, 0x65, 0x74, 0x20, 0x74,
+	0x6f, 0x20, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x1a, 0x8a, 0x01, 0x28, 0x21, 0x68,
+	0x61, 0x73, 0x28, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x5f, 0x6f,
+	0x77, 0x6e, 0x65, 0x64, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x29, 0x20, 0x26, 0x26,
+	0x20, 0x21, 0x68, 0x61, 0x73, 0x28, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x76, 0x65, 0x72, 0x69, 0x66,
+	0x69, 0x65, 0x64, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x29, 0x20, 0x26, 0x26, 0x20,
+	0x73, 0x69, 0x7a, 0x65, 0x28, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72,
+	0x6e, 0x73, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x29, 0x20, 0x3d, 0x3d, 0x20, 0x30,
+	0x29, 0x20, 0x7c, 0x7c, 0x20, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65,
+	0x64, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20, 0x3d, 0x3d, 0x20, 0x27, 0x73, 0x65,
+	0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x27, 0x42, 0x17, 0x0a, 0x15, 0x5f, 0x65, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73,
+	0x42, 0x12, 0x0a, 0x10, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x42, 0x17, 0x0a, 0x15, 0x5f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x5f,
+	0x6f, 0x77, 0x6e, 0x65, 0x64, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x42, 0x13, 0x0a,
+	0x11, 0x5f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x77,
+	0x65, 0x64, 0x22, 0xa5, 0x01, 0x0a, 0x08, 0x41, 0x75, 0x74, 0x6f, 0x6c, 0x69, 0x6e, 0x6b, 0x12,
+	0x26, 0x0a, 0x0a, 0x6b, 0x65, 0x79, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x09, 0x6b, 0x65,
+	0x79, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x2f, 0x0a, 0x0c, 0x75, 0x72, 0x6c, 0x5f, 0x74,
+	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xba,
+	0x48, 0x09, 0x72, 0x07, 0x4a, 0x05, 0x3c, 0x6e, 0x75, 0x6d, 0x3e, 0x52, 0x0b, 0x75, 0x72, 0x6c,
+	0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x2c, 0x0a, 0x0f, 0x69, 0x73, 0x5f, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x6e, 0x75, 0x6d, 0x65, 0x72, 0x69, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x48, 0x00, 0x52, 0x0e, 0x69, 0x73, 0x41, 0x6c, 0x70, 0x68, 0x61, 0x6e, 0x75, 0x6d, 0x65,
+	0x72, 0x69, 0x63, 0x88, 0x01, 0x01, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x69, 0x73, 0x5f, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x6e, 0x75, 0x6d, 0x65, 0x72, 0x69, 0x63, 0x22, 0x91, 0x01, 0x0a, 0x0a, 0x49,
+	0x73, 0x73, 0x75, 0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x1b, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04, 0x72, 0x02, 0x10, 0x01,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2f, 0x0a, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x19, 0xba, 0x48, 0x16, 0x72, 0x14, 0x32, 0x12, 0x5e, 0x23,
+	0x3f, 0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d, 0x66, 0x41, 0x2d, 0x46, 0x5d, 0x7b, 0x36, 0x7d, 0x24,
+	0x52, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x25, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x0e,
+	0x0a, 0x0c, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xfb,
+	0x01, 0x0a, 0x07, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x12, 0x1a, 0x0a, 0x03, 0x75, 0x72,
+	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xba, 0x48, 0x05, 0x72, 0x03, 0x88, 0x01,
+	0x01, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x39, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0xba, 0x48,
+	0x0e, 0x72, 0x0c, 0x52, 0x04, 0x6a, 0x73, 0x6f, 0x6e, 0x52, 0x04, 0x66, 0x6f, 0x72, 0x6d, 0x48,
+	0x00, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x88, 0x01,
+	0x01, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x34, 0x0a, 0x0f, 0x73, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x65, 0x6e, 0x76, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04, 0x72, 0x02, 0x10, 0x01, 0x48, 0x01, 0x52, 0x0d, 0x73,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x46, 0x72, 0x6f, 0x6d, 0x45, 0x6e, 0x76, 0x88, 0x01, 0x01, 0x12,
+	0x1b, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x48,
+	0x02, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x88, 0x01, 0x01, 0x42, 0x0f, 0x0a, 0x0d,
+	0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x42, 0x12, 0x0a,
+	0x10, 0x5f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x65, 0x6e,
+	0x76, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x22, 0xd3, 0x01, 0x0a,
+	0x05, 0x50, 0x61, 0x67, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52,
+	0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x12, 0x28, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0f, 0xba, 0x48, 0x0c, 0x72, 0x0a, 0x52, 0x01, 0x2f, 0x52,
+	0x05, 0x2f, 0x64, 0x6f, 0x63, 0x73, 0x48, 0x00, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x88, 0x01,
+	0x01, 0x12, 0x28, 0x0a, 0x0d, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x64, 0x6f, 0x6d, 0x61,
+	0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0c, 0x63, 0x75, 0x73, 0x74,
+	0x6f, 0x6d, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x0d, 0x65,
+	0x6e, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x5f, 0x68, 0x74, 0x74, 0x70, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x48, 0x02, 0x52, 0x0c, 0x65, 0x6e, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x48, 0x74, 0x74,
+	0x70, 0x73, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x42, 0x10,
+	0x0a, 0x0e, 0x5f, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e,
+	0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x65, 0x6e, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x5f, 0x68, 0x74, 0x74,
+	0x70, 0x73, 0x22, 0x92, 0x02, 0x0a, 0x07, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x65, 0x74, 0x12, 0x1b,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba, 0x48,
+	0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2f, 0x0a, 0x06, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x12, 0xba, 0x48, 0x0f,
+	0x72, 0x0d, 0x52, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x52, 0x03, 0x74, 0x61, 0x67, 0x48,
+	0x00, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x88, 0x01, 0x01, 0x12, 0x43, 0x0a, 0x0b,
+	0x65, 0x6e, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x21, 0xba, 0x48, 0x1e, 0x72, 0x1c, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x52, 0x08, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x52, 0x08, 0x64, 0x69, 0x73, 0x61,
+	0x62, 0x6c, 0x65, 0x64, 0x52, 0x0b, 0x65, 0x6e, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x07, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65,
+	0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x65, 0x78,
+	0x63, 0x6c, 0x75, 0x64, 0x65, 0x12, 0x35, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x63, 0x6f, 0x6e, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x65, 0x74,
+	0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x42, 0x09, 0x0a, 0x07,
+	0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x22, 0x8b, 0x03, 0x0a, 0x0c, 0x52, 0x75, 0x6c, 0x65,
+	0x73, 0x65, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x28, 0x0a, 0x10, 0x6e, 0x6f, 0x6e, 0x5f, 0x66, 0x61, 0x73, 0x74, 0x5f, 0x66, 0x6f, 0x72,
+	0x77, 0x61, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x6e, 0x6f, 0x6e, 0x46,
+	0x61, 0x73, 0x74, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x12, 0x36, 0x0a, 0x17, 0x72, 0x65,
+	0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x61, 0x72, 0x5f, 0x68, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x72, 0x65, 0x71,
+	0x75, 0x69, 0x72, 0x65, 0x64, 0x4c, 0x69, 0x6e, 0x65, 0x61, 0x72, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x12, 0x2f, 0x0a, 0x13, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x73,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x12, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x73, 0x12, 0x48, 0x0a, 0x0c, 0x70, 0x75, 0x6c, 0x6c, 0x5f, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x63, 0x6f, 0x6e, 0x63,
+	0x6f, 0x72, 0x64, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x75,
+	0x6c, 0x65, 0x73, 0x65, 0x74, 0x50, 0x75, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x52, 0x0b, 0x70, 0x75, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x34, 0x0a,
+	0x16, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x14, 0x72,
+	0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x5f, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x12, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x73, 0x22, 0xe0, 0x02, 0x0a, 0x12, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x65,
+	0x74, 0x50, 0x75, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x45, 0x0a, 0x1f,
+	0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x69,
+	0x6e, 0x67, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x1c, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x41,
+	0x70, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x40, 0x0a, 0x1d, 0x64, 0x69, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x5f, 0x73,
+	0x74, 0x61, 0x6c, 0x65, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x5f, 0x6f, 0x6e, 0x5f,
+	0x70, 0x75, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x19, 0x64, 0x69, 0x73, 0x6d,
+	0x69, 0x73, 0x73, 0x53, 0x74, 0x61, 0x6c, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x4f,
+	0x6e, 0x50, 0x75, 0x73, 0x68, 0x12, 0x39, 0x0a, 0x19, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
+	0x5f, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x16, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72,
+	0x65, 0x43, 0x6f, 0x64, 0x65, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x12, 0x3b, 0x0a, 0x1a, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x5f, 0x6c, 0x61, 0x73, 0x74,
+	0x5f, 0x70, 0x75, 0x73, 0x68, 0x5f, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x17, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x4c, 0x61, 0x73,
+	0x74, 0x50, 0x75, 0x73, 0x68, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x12, 0x49, 0x0a,
+	0x21, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x5f, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x1e, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72,
+	0x65, 0x64, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x52, 0x65,
+	0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x80, 0x01, 0x0a, 0x0c, 0x43, 0x6f, 0x6c,
+	0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x23, 0x0a, 0x08, 0x75, 0x73, 0x65,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04,
+	0x72, 0x02, 0x10, 0x01, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x4b,
+	0x0a, 0x0a, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x2b, 0xba, 0x48, 0x28, 0x72, 0x26, 0x52, 0x04, 0x72, 0x65, 0x61, 0x64, 0x52,
+	0x06, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x52, 0x05, 0x77, 0x72, 0x69, 0x74, 0x65, 0x52, 0x08,
+	0x6d, 0x61, 0x69, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x52, 0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x52,
+	0x0a, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x72, 0x0a, 0x0e, 0x50,
+	0x72, 0x65, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x48, 0x6f, 0x6f, 0x6b, 0x12, 0x1b, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04,
+	0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x43, 0x0a, 0x0b, 0x65, 0x6e,
+	0x66, 0x6f, 0x72, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x21, 0xba, 0x48, 0x1e, 0x72, 0x1c, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x52,
+	0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x52, 0x07, 0x74, 0x65, 0x73, 0x74, 0x69,
+	0x6e, 0x67, 0x52, 0x0b, 0x65, 0x6e, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22,
+	0xf3, 0x01, 0x0a, 0x06, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x12, 0x45, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x74,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63,
+	0x6f, 0x6e, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x06, 0xba, 0x48, 0x03,
+	0xc8, 0x01, 0x01, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x3a,
+	0x74, 0xba, 0x48, 0x71, 0x1a, 0x6f, 0x0a, 0x16, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x2e, 0x6e,
+	0x61, 0x6d, 0x65, 0x5f, 0x6f, 0x72, 0x5f, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x12, 0x2a,
+	0x65, 0x78, 0x61, 0x63, 0x74, 0x6c, 0x79, 0x20, 0x6f, 0x6e, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x6e,
+	0x61, 0x6d, 0x65, 0x20, 0x6f, 0x72, 0x20, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x20, 0x6d,
+	0x75, 0x73, 0x74, 0x20, 0x62, 0x65, 0x20, 0x73, 0x65, 0x74, 0x1a, 0x29, 0x28, 0x74, 0x68, 0x69,
+	0x73, 0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x20, 0x21, 0x3d, 0x20, 0x27, 0x27, 0x29, 0x20, 0x21, 0x3d,
+	0x20, 0x28, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x20, 0x21,
+	0x3d, 0x20, 0x27, 0x27, 0x29, 0x22, 0x96, 0x04, 0x0a, 0x0a, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x5f,
+	0x70, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75,
+	0x69, 0x72, 0x65, 0x50, 0x72, 0x88, 0x01, 0x01, 0x12, 0x2d, 0x0a, 0x10, 0x63, 0x68, 0x65, 0x63,
+	0x6b, 0x73, 0x5f, 0x6d, 0x75, 0x73, 0x74, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x48, 0x01, 0x52, 0x0e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x4d, 0x75, 0x73, 0x74,
+	0x50, 0x61, 0x73, 0x73, 0x88, 0x01, 0x01, 0x12, 0x2a, 0x0a, 0x0e, 0x73, 0x69, 0x67, 0x6e, 0x65,
+	0x64, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x48,
+	0x02, 0x52, 0x0d, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73,
+	0x88, 0x01, 0x01, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x5f,
+	0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65,
+	0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x12, 0x52, 0x0a, 0x0d,
+	0x63, 0x68, 0x65, 0x63, 0x6b, 0x5f, 0x61, 0x70, 0x70, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x0b, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x63, 0x6f, 0x6e, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x70, 0x70, 0x49, 0x64, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x0b, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x70, 0x70, 0x49, 0x64, 0x73,
+	0x1a, 0x3e, 0x0a, 0x10, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x70, 0x70, 0x49, 0x64, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x3a, 0x94, 0x01, 0xba, 0x48, 0x90, 0x01, 0x1a, 0x8d, 0x01, 0x0a, 0x18, 0x70, 0x72, 0x6f, 0x74,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x5f, 0x61, 0x70, 0x70,
+	0x5f, 0x69, 0x64, 0x73, 0x12, 0x3b, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x5f, 0x61, 0x70, 0x70, 0x5f,
+	0x69, 0x64, 0x73, 0x20, 0x6d, 0x61, 0x79, 0x20, 0x6f, 0x6e, 0x6c, 0x79, 0x20, 0x70, 0x69, 0x6e,
+	0x20, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x20, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x64, 0x20, 0x69,
+	0x6e, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b,
+	0x73, 0x1a, 0x34, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x5f, 0x61, 0x70,
+	0x70, 0x5f, 0x69, 0x64, 0x73, 0x2e, 0x61, 0x6c, 0x6c, 0x28, 0x6b, 0x2c, 0x20, 0x6b, 0x20, 0x69,
+	0x6e, 0x20, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x5f,
+	0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x29, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x72, 0x65, 0x71, 0x75,
+	0x69, 0x72, 0x65, 0x5f, 0x70, 0x72, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b,
+	0x73, 0x5f, 0x6d, 0x75, 0x73, 0x74, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x42, 0x11, 0x0a, 0x0f, 0x5f,
+	0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x42, 0x2c,
+	0x5a, 0x2a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x6f, 0x6d,
+	0x69, 0x63, 0x72, 0x6f, 0x2f, 0x63, 0x6f, 0x6e, 0x63, 0x6f, 0x72, 0x64, 0x2f, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2f, 0x76, 0x31, 0x3b, 0x67, 0x68, 0x5f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -802,36 +3142,73 @@ func file_concord_github_v1_github_proto_rawDescGZIP() []byte {
 	return file_concord_github_v1_github_proto_rawDescData
 }
 
-var file_concord_github_v1_github_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_concord_github_v1_github_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
 var file_concord_github_v1_github_proto_goTypes = []interface{}{
-	(*Organization)(nil),    // 0: concord.github.v1.Organization
-	(*OrgPermissions)(nil),  // 1: concord.github.v1.OrgPermissions
-	(*Defaults)(nil),        // 2: concord.github.v1.Defaults
-	(*TeamPermissions)(nil), // 3: concord.github.v1.TeamPermissions
-	(*People)(nil),          // 4: concord.github.v1.People
-	(*Repository)(nil),      // 5: concord.github.v1.Repository
-	(*Branch)(nil),          // 6: concord.github.v1.Branch
-	(*Protection)(nil),      // 7: concord.github.v1.Protection
-	nil,                     // 8: concord.github.v1.Defaults.PermissionsEntry
-	nil,                     // 9: concord.github.v1.Repository.PermissionsEntry
+	(*Organization)(nil),          // 0: concord.github.v1.Organization
+	(*Team)(nil),                  // 1: concord.github.v1.Team
+	(*Profile)(nil),               // 2: concord.github.v1.Profile
+	(*OrgPermissions)(nil),        // 3: concord.github.v1.OrgPermissions
+	(*Defaults)(nil),              // 4: concord.github.v1.Defaults
+	(*TeamPermissions)(nil),       // 5: concord.github.v1.TeamPermissions
+	(*People)(nil),                // 6: concord.github.v1.People
+	(*Secret)(nil),                // 7: concord.github.v1.Secret
+	(*OrgSecret)(nil),             // 8: concord.github.v1.OrgSecret
+	(*OrgVariable)(nil),           // 9: concord.github.v1.OrgVariable
+	(*Variable)(nil),              // 10: concord.github.v1.Variable
+	(*Repository)(nil),            // 11: concord.github.v1.Repository
+	(*ActionsPermissions)(nil),    // 12: concord.github.v1.ActionsPermissions
+	(*OrgActionsPermissions)(nil), // 13: concord.github.v1.OrgActionsPermissions
+	(*Autolink)(nil),              // 14: concord.github.v1.Autolink
+	(*IssueLabel)(nil),            // 15: concord.github.v1.IssueLabel
+	(*Webhook)(nil),               // 16: concord.github.v1.Webhook
+	(*Pages)(nil),                 // 17: concord.github.v1.Pages
+	(*Ruleset)(nil),               // 18: concord.github.v1.Ruleset
+	(*RulesetRules)(nil),          // 19: concord.github.v1.RulesetRules
+	(*RulesetPullRequest)(nil),    // 20: concord.github.v1.RulesetPullRequest
+	(*Collaborator)(nil),          // 21: concord.github.v1.Collaborator
+	(*PreReceiveHook)(nil),        // 22: concord.github.v1.PreReceiveHook
+	(*Branch)(nil),                // 23: concord.github.v1.Branch
+	(*Protection)(nil),            // 24: concord.github.v1.Protection
+	nil,                           // 25: concord.github.v1.Defaults.PermissionsEntry
+	nil,                           // 26: concord.github.v1.Repository.PermissionsEntry
+	nil,                           // 27: concord.github.v1.Protection.CheckAppIdsEntry
 }
 var file_concord_github_v1_github_proto_depIdxs = []int32{
-	2,  // 0: concord.github.v1.Organization.defaults:type_name -> concord.github.v1.Defaults
-	1,  // 1: concord.github.v1.Organization.permissions:type_name -> concord.github.v1.OrgPermissions
-	4,  // 2: concord.github.v1.Organization.people:type_name -> concord.github.v1.People
-	5,  // 3: concord.github.v1.Organization.repositories:type_name -> concord.github.v1.Repository
-	6,  // 4: concord.github.v1.Defaults.protected_branches:type_name -> concord.github.v1.Branch
-	8,  // 5: concord.github.v1.Defaults.permissions:type_name -> concord.github.v1.Defaults.PermissionsEntry
-	6,  // 6: concord.github.v1.Repository.protected_branches:type_name -> concord.github.v1.Branch
-	9,  // 7: concord.github.v1.Repository.permissions:type_name -> concord.github.v1.Repository.PermissionsEntry
-	7,  // 8: concord.github.v1.Branch.protection:type_name -> concord.github.v1.Protection
-	3,  // 9: concord.github.v1.Defaults.PermissionsEntry.value:type_name -> concord.github.v1.TeamPermissions
-	3,  // 10: concord.github.v1.Repository.PermissionsEntry.value:type_name -> concord.github.v1.TeamPermissions
-	11, // [11:11] is the sub-list for method output_type
-	11, // [11:11] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	4,  // 0: concord.github.v1.Organization.defaults:type_name -> concord.github.v1.Defaults
+	3,  // 1: concord.github.v1.Organization.permissions:type_name -> concord.github.v1.OrgPermissions
+	2,  // 2: concord.github.v1.Organization.profile:type_name -> concord.github.v1.Profile
+	1,  // 3: concord.github.v1.Organization.teams:type_name -> concord.github.v1.Team
+	6,  // 4: concord.github.v1.Organization.people:type_name -> concord.github.v1.People
+	11, // 5: concord.github.v1.Organization.repositories:type_name -> concord.github.v1.Repository
+	8,  // 6: concord.github.v1.Organization.secrets:type_name -> concord.github.v1.OrgSecret
+	9,  // 7: concord.github.v1.Organization.variables:type_name -> concord.github.v1.OrgVariable
+	16, // 8: concord.github.v1.Organization.webhooks:type_name -> concord.github.v1.Webhook
+	13, // 9: concord.github.v1.Organization.actions_permissions:type_name -> concord.github.v1.OrgActionsPermissions
+	23, // 10: concord.github.v1.Defaults.protected_branches:type_name -> concord.github.v1.Branch
+	25, // 11: concord.github.v1.Defaults.permissions:type_name -> concord.github.v1.Defaults.PermissionsEntry
+	23, // 12: concord.github.v1.Repository.protected_branches:type_name -> concord.github.v1.Branch
+	26, // 13: concord.github.v1.Repository.permissions:type_name -> concord.github.v1.Repository.PermissionsEntry
+	7,  // 14: concord.github.v1.Repository.secrets:type_name -> concord.github.v1.Secret
+	10, // 15: concord.github.v1.Repository.variables:type_name -> concord.github.v1.Variable
+	16, // 16: concord.github.v1.Repository.webhooks:type_name -> concord.github.v1.Webhook
+	17, // 17: concord.github.v1.Repository.pages:type_name -> concord.github.v1.Pages
+	18, // 18: concord.github.v1.Repository.rulesets:type_name -> concord.github.v1.Ruleset
+	21, // 19: concord.github.v1.Repository.collaborators:type_name -> concord.github.v1.Collaborator
+	14, // 20: concord.github.v1.Repository.autolinks:type_name -> concord.github.v1.Autolink
+	15, // 21: concord.github.v1.Repository.issue_labels:type_name -> concord.github.v1.IssueLabel
+	12, // 22: concord.github.v1.Repository.actions_permissions:type_name -> concord.github.v1.ActionsPermissions
+	22, // 23: concord.github.v1.Repository.pre_receive_hooks:type_name -> concord.github.v1.PreReceiveHook
+	19, // 24: concord.github.v1.Ruleset.rules:type_name -> concord.github.v1.RulesetRules
+	20, // 25: concord.github.v1.RulesetRules.pull_request:type_name -> concord.github.v1.RulesetPullRequest
+	24, // 26: concord.github.v1.Branch.protection:type_name -> concord.github.v1.Protection
+	27, // 27: concord.github.v1.Protection.check_app_ids:type_name -> concord.github.v1.Protection.CheckAppIdsEntry
+	5,  // 28: concord.github.v1.Defaults.PermissionsEntry.value:type_name -> concord.github.v1.TeamPermissions
+	5,  // 29: concord.github.v1.Repository.PermissionsEntry.value:type_name -> concord.github.v1.TeamPermissions
+	30, // [30:30] is the sub-list for method output_type
+	30, // [30:30] is the sub-list for method input_type
+	30, // [30:30] is the sub-list for extension type_name
+	30, // [30:30] is the sub-list for extension extendee
+	0,  // [0:30] is the sub-list for field type_name
 }
 
 func init() { file_concord_github_v1_github_proto_init() }
@@ -853,7 +3230,7 @@ func file_concord_github_v1_github_proto_init() {
 			}
 		}
 		file_concord_github_v1_github_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OrgPermissions); i {
+			switch v := v.(*Team); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -865,7 +3242,7 @@ func file_concord_github_v1_github_proto_init() {
 			}
 		}
 		file_concord_github_v1_github_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Defaults); i {
+			switch v := v.(*Profile); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -877,7 +3254,7 @@ func file_concord_github_v1_github_proto_init() {
 			}
 		}
 		file_concord_github_v1_github_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TeamPermissions); i {
+			switch v := v.(*OrgPermissions); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -889,7 +3266,7 @@ func file_concord_github_v1_github_proto_init() {
 			}
 		}
 		file_concord_github_v1_github_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*People); i {
+			switch v := v.(*Defaults); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -901,7 +3278,7 @@ func file_concord_github_v1_github_proto_init() {
 			}
 		}
 		file_concord_github_v1_github_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Repository); i {
+			switch v := v.(*TeamPermissions); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -913,7 +3290,7 @@ func file_concord_github_v1_github_proto_init() {
 			}
 		}
 		file_concord_github_v1_github_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Branch); i {
+			switch v := v.(*People); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -925,6 +3302,210 @@ func file_concord_github_v1_github_proto_init() {
 			}
 		}
 		file_concord_github_v1_github_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Secret); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_concord_github_v1_github_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OrgSecret); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_concord_github_v1_github_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OrgVariable); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_concord_github_v1_github_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Variable); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_concord_github_v1_github_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Repository); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_concord_github_v1_github_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ActionsPermissions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_concord_github_v1_github_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OrgActionsPermissions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_concord_github_v1_github_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Autolink); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_concord_github_v1_github_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IssueLabel); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_concord_github_v1_github_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Webhook); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_concord_github_v1_github_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pages); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_concord_github_v1_github_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Ruleset); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_concord_github_v1_github_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RulesetRules); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_concord_github_v1_github_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RulesetPullRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_concord_github_v1_github_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Collaborator); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_concord_github_v1_github_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PreReceiveHook); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_concord_github_v1_github_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Branch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_concord_github_v1_github_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Protection); i {
 			case 0:
 				return &v.state
@@ -937,17 +3518,35 @@ func file_concord_github_v1_github_proto_init() {
 			}
 		}
 	}
+	file_concord_github_v1_github_proto_msgTypes[0].OneofWrappers = []interface{}{}
 	file_concord_github_v1_github_proto_msgTypes[1].OneofWrappers = []interface{}{}
 	file_concord_github_v1_github_proto_msgTypes[2].OneofWrappers = []interface{}{}
-	file_concord_github_v1_github_proto_msgTypes[5].OneofWrappers = []interface{}{}
-	file_concord_github_v1_github_proto_msgTypes[7].OneofWrappers = []interface{}{}
+	file_concord_github_v1_github_proto_msgTypes[3].OneofWrappers = []interface{}{}
+	file_concord_github_v1_github_proto_msgTypes[4].OneofWrappers = []interface{}{}
+	file_concord_github_v1_github_proto_msgTypes[7].OneofWrappers = []interface{}{
+		(*Secret_FromEnv)(nil),
+		(*Secret_FromFile)(nil),
+	}
+	file_concord_github_v1_github_proto_msgTypes[8].OneofWrappers = []interface{}{
+		(*OrgSecret_FromEnv)(nil),
+		(*OrgSecret_FromFile)(nil),
+	}
+	file_concord_github_v1_github_proto_msgTypes[11].OneofWrappers = []interface{}{}
+	file_concord_github_v1_github_proto_msgTypes[12].OneofWrappers = []interface{}{}
+	file_concord_github_v1_github_proto_msgTypes[13].OneofWrappers = []interface{}{}
+	file_concord_github_v1_github_proto_msgTypes[14].OneofWrappers = []interface{}{}
+	file_concord_github_v1_github_proto_msgTypes[15].OneofWrappers = []interface{}{}
+	file_concord_github_v1_github_proto_msgTypes[16].OneofWrappers = []interface{}{}
+	file_concord_github_v1_github_proto_msgTypes[17].OneofWrappers = []interface{}{}
+	file_concord_github_v1_github_proto_msgTypes[18].OneofWrappers = []interface{}{}
+	file_concord_github_v1_github_proto_msgTypes[24].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_concord_github_v1_github_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   10,
+			NumMessages:   28,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
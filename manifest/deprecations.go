@@ -0,0 +1,80 @@
+package manifest
+
+import (
+	gh_pb "github.com/gomicro/concord/github/v1"
+	"github.com/gomicro/concord/report"
+)
+
+// deprecation is a manifest field that still works but has been superseded.
+// used returns where in the manifest the field is set.
+type deprecation struct {
+	field       string
+	replacement string
+	used        func(o *gh_pb.Organization) []string
+}
+
+// deprecations lists every superseded field, add to it as fields evolve.
+var deprecations = []deprecation{
+	{
+		field:       "private",
+		replacement: "visibility",
+		used: func(o *gh_pb.Organization) []string {
+			var where []string
+			if o.Defaults != nil && o.Defaults.Private != nil {
+				where = append(where, "defaults")
+			}
+
+			for _, r := range o.Repositories {
+				if r.Private != nil {
+					where = append(where, "repository "+r.Name)
+				}
+			}
+
+			return where
+		},
+	},
+	{
+		field:       "labels",
+		replacement: "topics",
+		used: func(o *gh_pb.Organization) []string {
+			var where []string
+			if len(o.Labels) > 0 {
+				where = append(where, "organization")
+			}
+
+			for _, r := range o.Repositories {
+				if len(r.Labels) > 0 {
+					where = append(where, "repository "+r.Name)
+				}
+			}
+
+			return where
+		},
+	},
+	{
+		field:       "clear_labels",
+		replacement: "clear_topics",
+		used: func(o *gh_pb.Organization) []string {
+			var where []string
+			for _, r := range o.Repositories {
+				if r.ClearLabels != nil {
+					where = append(where, "repository "+r.Name)
+				}
+			}
+
+			return where
+		},
+	},
+}
+
+// warnDeprecated prints a warning for each deprecated field the manifest
+// sets. It runs before defaults are filled so fields are only reported where
+// they were written.
+func warnDeprecated(o *gh_pb.Organization) {
+	for _, d := range deprecations {
+		for _, where := range d.used(o) {
+			report.PrintWarn("manifest: " + where + " sets deprecated field '" + d.field + "', use '" + d.replacement + "' instead")
+			report.Println()
+		}
+	}
+}
@@ -0,0 +1,140 @@
+package manifest
+
+import (
+	"encoding/json"
+
+	"buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	gh_pb "github.com/gomicro/concord/github/v1"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// JSONSchema describes the manifest format as a JSON Schema, for editors and
+// other tools. It is generated from the same message definitions the manifest
+// is parsed into, including the allowed values and lengths they validate.
+func JSONSchema() ([]byte, error) {
+	defs := map[string]interface{}{}
+	org := messageRef((&gh_pb.Organization{}).ProtoReflect().Descriptor(), defs)
+
+	s := map[string]interface{}{
+		"$schema": "https://json-schema.org/draft/2020-12/schema",
+		"title":   "concord manifest",
+		"type":    "object",
+		"properties": map[string]interface{}{
+			"schema_version": map[string]interface{}{
+				"type":    "integer",
+				"minimum": 1,
+				"maximum": SchemaVersion,
+			},
+			"organization": org,
+			"organizations": map[string]interface{}{
+				"type":     "array",
+				"items":    org,
+				"minItems": 1,
+			},
+		},
+		"oneOf": []interface{}{
+			map[string]interface{}{"required": []string{"organization"}},
+			map[string]interface{}{"required": []string{"organizations"}},
+		},
+		"additionalProperties": false,
+		"$defs":                defs,
+	}
+
+	return json.MarshalIndent(s, "", "  ")
+}
+
+// messageRef adds a message, and every message it uses, to the definitions
+// and returns a reference to it.
+func messageRef(md protoreflect.MessageDescriptor, defs map[string]interface{}) map[string]interface{} {
+	name := string(md.Name())
+	ref := map[string]interface{}{"$ref": "#/$defs/" + name}
+
+	if _, ok := defs[name]; ok {
+		return ref
+	}
+
+	// claimed before the fields are walked so recursive messages terminate
+	defs[name] = nil
+
+	props := map[string]interface{}{}
+	fields := md.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		props[fd.TextName()] = fieldSchema(fd, fieldConstraints(fd), defs)
+	}
+
+	defs[name] = map[string]interface{}{
+		"type":                 "object",
+		"properties":           props,
+		"additionalProperties": false,
+	}
+
+	return ref
+}
+
+func fieldSchema(fd protoreflect.FieldDescriptor, fc *validate.FieldConstraints, defs map[string]interface{}) map[string]interface{} {
+	if fd.IsMap() {
+		s := map[string]interface{}{
+			"type":                 "object",
+			"additionalProperties": singularSchema(fd.MapValue(), nil, defs),
+		}
+
+		if keys := fc.GetMap().GetKeys(); keys != nil {
+			s["propertyNames"] = singularSchema(fd.MapKey(), keys, defs)
+		}
+
+		return s
+	}
+
+	if fd.IsList() {
+		return map[string]interface{}{
+			"type":  "array",
+			"items": singularSchema(fd, fc.GetRepeated().GetItems(), defs),
+		}
+	}
+
+	return singularSchema(fd, fc, defs)
+}
+
+func singularSchema(fd protoreflect.FieldDescriptor, fc *validate.FieldConstraints, defs map[string]interface{}) map[string]interface{} {
+	switch fd.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return messageRef(fd.Message(), defs)
+
+	case protoreflect.EnumKind:
+		values := []string{}
+		evs := fd.Enum().Values()
+		for i := 0; i < evs.Len(); i++ {
+			values = append(values, string(evs.Get(i).Name()))
+		}
+
+		return map[string]interface{}{"type": "string", "enum": values}
+
+	case protoreflect.BoolKind:
+		return map[string]interface{}{"type": "boolean"}
+
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		return map[string]interface{}{"type": "number"}
+
+	case protoreflect.StringKind, protoreflect.BytesKind:
+		s := map[string]interface{}{"type": "string"}
+
+		if in := fc.GetString_().GetIn(); len(in) > 0 {
+			s["enum"] = in
+		}
+
+		if fc.GetString_().GetMinLen() > 0 {
+			s["minLength"] = fc.GetString_().GetMinLen()
+		}
+
+		return s
+	}
+
+	return map[string]interface{}{"type": "integer"}
+}
+
+func fieldConstraints(fd protoreflect.FieldDescriptor) *validate.FieldConstraints {
+	fc, _ := proto.GetExtension(fd.Options(), validate.E_Field).(*validate.FieldConstraints)
+	return fc
+}
@@ -1,11 +1,14 @@
 package manifest
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
+	"io"
 	"os"
-	"path"
+	"path/filepath"
 	"strings"
 
 	"github.com/bufbuild/protovalidate-go"
@@ -18,70 +21,218 @@ type ctxKey string
 
 const (
 	manifestKey ctxKey = "manifest"
+	orgsKey     ctxKey = "orgs"
 )
 
 var (
 	ErrManifestnotFound    = errors.New("manifest not found")
 	ErrManifestOrgRequried = errors.New("organization is required")
+	ErrManifestFormat      = errors.New("manifest is not valid yaml or json")
+	ErrManifestOrgs        = errors.New("set either organization or organizations, not both")
+	ErrDuplicateOrg        = errors.New("organization listed more than once")
+	ErrMultipleOrgs        = errors.New("manifest lists more than one organization")
 )
 
+// ReadManifest reads and parses the manifest at the given path, failing when
+// it lists more than one organization. See ReadManifests.
 func ReadManifest(file string) (*gh_pb.Organization, error) {
-	p, err := os.Getwd()
+	orgs, err := ReadManifests(file)
 	if err != nil {
 		return nil, err
 	}
 
-	b, err := os.ReadFile(path.Join(p, file))
+	return single(orgs)
+}
+
+// ReadManifests reads and parses the manifest at the given path, or from
+// stdin when the path is "-", returning every organization it lists. Files
+// ending in .json are read as JSON and .yml or .yaml as YAML, anything else is
+// detected from its content.
+func ReadManifests(file string) ([]*gh_pb.Organization, error) {
+	if file == "-" {
+		return ParseAll(os.Stdin)
+	}
+
+	f, err := os.Open(file)
 	if err != nil {
 		return nil, err
 	}
+	defer f.Close()
 
-	var v map[string]interface{}
-	err = yaml.Unmarshal(b, &v)
+	b, err := io.ReadAll(f)
 	if err != nil {
 		return nil, err
 	}
 
-	if v["organization"] == nil {
+	switch strings.ToLower(filepath.Ext(file)) {
+	case ".json":
+		return decode(b, formatJSON)
+	case ".yml", ".yaml":
+		return decode(b, formatYAML)
+	}
+
+	return decode(b, sniffFormat(b))
+}
+
+// Parse reads a whole manifest, detecting whether it is YAML or JSON, then
+// validates it and fills in its defaults. It fails when the manifest lists
+// more than one organization.
+func Parse(r io.Reader) (*gh_pb.Organization, error) {
+	orgs, err := ParseAll(r)
+	if err != nil {
 		return nil, err
 	}
 
-	j, err := json.Marshal(v["organization"])
+	return single(orgs)
+}
+
+// ParseAll is Parse for manifests that may list several organizations.
+func ParseAll(r io.Reader) ([]*gh_pb.Organization, error) {
+	b, err := io.ReadAll(r)
 	if err != nil {
 		return nil, err
 	}
 
-	var m gh_pb.Organization
-	err = protojson.Unmarshal(j, &m)
+	return decode(b, sniffFormat(b))
+}
+
+func single(orgs []*gh_pb.Organization) (*gh_pb.Organization, error) {
+	if len(orgs) > 1 {
+		return nil, ErrMultipleOrgs
+	}
+
+	return orgs[0], nil
+}
+
+type format int
+
+const (
+	formatYAML format = iota
+	formatJSON
+)
+
+// sniffFormat treats content starting with an object as JSON and anything
+// else as YAML.
+func sniffFormat(b []byte) format {
+	if bytes.HasPrefix(bytes.TrimSpace(b), []byte("{")) {
+		return formatJSON
+	}
+
+	return formatYAML
+}
+
+// decode parses a manifest holding either a single organization or a list of
+// them under organizations. Each is migrated, validated, and has its defaults
+// filled on its own, and their names must be unique.
+func decode(b []byte, f format) ([]*gh_pb.Organization, error) {
+	b, err := substitute(b)
 	if err != nil {
 		return nil, err
 	}
 
-	validator, err := protovalidate.New()
+	var v map[string]interface{}
+
+	switch f {
+	case formatJSON:
+		d := json.NewDecoder(bytes.NewReader(b))
+		d.UseNumber()
+
+		err = d.Decode(&v)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrManifestFormat, err)
+		}
+
+	default:
+		err = yaml.Unmarshal(b, &v)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrManifestFormat, err)
+		}
+	}
+
+	var raw []interface{}
+	switch {
+	case v["organization"] != nil && v["organizations"] != nil:
+		return nil, ErrManifestOrgs
+	case v["organization"] != nil:
+		raw = []interface{}{v["organization"]}
+	case v["organizations"] != nil:
+		list, ok := v["organizations"].([]interface{})
+		if !ok || len(list) == 0 {
+			return nil, fmt.Errorf("%w: organizations must be a list of organizations", ErrManifestOrgRequried)
+		}
+
+		raw = list
+	default:
+		return nil, ErrManifestOrgRequried
+	}
+
+	version, err := schemaVersion(v["schema_version"])
 	if err != nil {
 		return nil, err
 	}
 
-	err = validator.Validate(&m)
+	validator, err := protovalidate.New()
 	if err != nil {
 		return nil, err
 	}
 
-	fillDefaults(&m)
+	orgs := []*gh_pb.Organization{}
+	for _, r := range raw {
+		o, err := migrate(version, r)
+		if err != nil {
+			return nil, err
+		}
 
-	return &m, nil
-}
+		// both formats are bridged through JSON so field names map the same way
+		org, err := json.Marshal(o)
+		if err != nil {
+			return nil, err
+		}
+
+		var m gh_pb.Organization
+		err = protojson.Unmarshal(org, &m)
+		if err != nil {
+			return nil, err
+		}
+
+		err = validator.Validate(&m)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, prev := range orgs {
+			if strings.EqualFold(prev.Name, m.Name) {
+				return nil, fmt.Errorf("%w: %s", ErrDuplicateOrg, m.Name)
+			}
+		}
+
+		warnDeprecated(&m)
+
+		fillDefaults(&m)
 
-func WithManifest(ctx context.Context, file string) context.Context {
-	ctx, cancel := context.WithCancelCause(ctx)
+		orgs = append(orgs, &m)
+	}
+
+	return orgs, nil
+}
 
-	m, err := ReadManifest(file)
+// WithManifest reads the manifest at the given path into the context. Every
+// organization it lists is kept, see OrgsFromContext, with the first being
+// the one OrgFromContext returns until WithOrg picks another.
+func WithManifest(ctx context.Context, file string) (context.Context, error) {
+	orgs, err := ReadManifests(file)
 	if err != nil {
-		cancel(err)
-		return ctx
+		return nil, err
 	}
 
-	return context.WithValue(ctx, manifestKey, m)
+	ctx = context.WithValue(ctx, orgsKey, orgs)
+
+	return context.WithValue(ctx, manifestKey, orgs[0]), nil
+}
+
+// WithOrg adds an already parsed manifest to the context.
+func WithOrg(ctx context.Context, org *gh_pb.Organization) context.Context {
+	return context.WithValue(ctx, manifestKey, org)
 }
 
 func OrgFromContext(ctx context.Context) (*gh_pb.Organization, error) {
@@ -93,40 +244,65 @@ func OrgFromContext(ctx context.Context) (*gh_pb.Organization, error) {
 	return m, nil
 }
 
+// OrgsFromContext returns every organization in the manifest, or only the
+// one added with WithOrg when no manifest was read into the context.
+func OrgsFromContext(ctx context.Context) ([]*gh_pb.Organization, error) {
+	orgs, ok := ctx.Value(orgsKey).([]*gh_pb.Organization)
+	if ok {
+		return orgs, nil
+	}
+
+	org, err := OrgFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return []*gh_pb.Organization{org}, nil
+}
+
 func fillDefaults(o *gh_pb.Organization) {
-	for _, gl := range o.Labels {
+	// labels are the deprecated name for topics and only stand in for them
+	// when no topics are given
+	if len(o.Topics) == 0 {
+		o.Topics = o.Labels
+	}
+
+	for _, r := range o.Repositories {
+		if len(r.Topics) == 0 {
+			r.Topics = r.Labels
+		}
+
+		inherit(&r.ClearTopics, r.ClearLabels)
+	}
+
+	for _, gt := range o.Topics {
 		for _, r := range o.Repositories {
-			if !hasDefaultLabel(r.Labels, gl) {
-				r.Labels = append(r.Labels, gl)
+			if !hasDefaultTopic(r.Topics, gt) {
+				r.Topics = append(r.Topics, gt)
 			}
 		}
 	}
 
 	if o.Defaults != nil {
 		for _, r := range o.Repositories {
-			if o.Defaults.Private != nil {
-				if r.Private == nil {
-					r.Private = o.Defaults.Private
-				}
-			}
-
-			if o.Defaults.DefaultBranch != nil {
-				if r.DefaultBranch == nil {
-					r.DefaultBranch = o.Defaults.DefaultBranch
-				}
-			}
-
-			if o.Defaults.AllowAutoMerge != nil {
-				if r.AllowAutoMerge == nil {
-					r.AllowAutoMerge = o.Defaults.AllowAutoMerge
+			// a repo choosing either private or visibility opts out of both defaults
+			if o.Defaults.Visibility != nil {
+				if r.Visibility == nil && r.Private == nil {
+					r.Visibility = o.Defaults.Visibility
 				}
 			}
 
-			if o.Defaults.AutoDeleteHeadBranches != nil {
-				if r.AutoDeleteHeadBranches == nil {
-					r.AutoDeleteHeadBranches = o.Defaults.AutoDeleteHeadBranches
-				}
-			}
+			inherit(&r.Private, o.Defaults.Private)
+			inherit(&r.DefaultBranch, o.Defaults.DefaultBranch)
+			inherit(&r.AllowAutoMerge, o.Defaults.AllowAutoMerge)
+			inherit(&r.AutoDeleteHeadBranches, o.Defaults.AutoDeleteHeadBranches)
+			inherit(&r.HasIssues, o.Defaults.HasIssues)
+			inherit(&r.HasProjects, o.Defaults.HasProjects)
+			inherit(&r.HasWiki, o.Defaults.HasWiki)
+			inherit(&r.HasDiscussions, o.Defaults.HasDiscussions)
+			inherit(&r.VulnerabilityAlerts, o.Defaults.VulnerabilityAlerts)
+			inherit(&r.AutomatedSecurityFixes, o.Defaults.AutomatedSecurityFixes)
+			inherit(&r.SecretScanning, o.Defaults.SecretScanning)
 
 			for _, p := range o.Defaults.ProtectedBranches {
 				if !hasDefaultProtectedBranch(r.ProtectedBranches, p) {
@@ -153,9 +329,18 @@ func fillDefaults(o *gh_pb.Organization) {
 	}
 }
 
-func hasDefaultLabel(labels []string, label string) bool {
-	for _, l := range labels {
-		if strings.EqualFold(l, label) {
+// inherit sets a repo's setting to the default when the repo leaves it out.
+// A setting given in the repo, even as false or empty, is kept.
+func inherit[T any](setting **T, def *T) {
+	if *setting == nil && def != nil {
+		v := *def
+		*setting = &v
+	}
+}
+
+func hasDefaultTopic(topics []string, topic string) bool {
+	for _, t := range topics {
+		if strings.EqualFold(t, topic) {
 			return true
 		}
 	}
@@ -177,7 +362,7 @@ func hasDefaultFile(files []*gh_pb.File, file *gh_pb.File) bool {
 
 func hasDefaultProtectedBranch(branches []*gh_pb.Branch, branch *gh_pb.Branch) bool {
 	for _, b := range branches {
-		if strings.EqualFold(b.Name, branch.Name) {
+		if sameBranch(b, branch) {
 			return true
 		}
 	}
@@ -187,7 +372,7 @@ func hasDefaultProtectedBranch(branches []*gh_pb.Branch, branch *gh_pb.Branch) b
 
 func fillDefaultProtections(branches []*gh_pb.Branch, branch *gh_pb.Branch) {
 	for _, b := range branches {
-		if strings.EqualFold(b.Name, branch.Name) {
+		if sameBranch(b, branch) {
 			if b.Protection.RequirePr == nil {
 				b.Protection.RequirePr = branch.Protection.RequirePr
 			}
@@ -209,10 +394,28 @@ func fillDefaultProtections(branches []*gh_pb.Branch, branch *gh_pb.Branch) {
 					}
 				}
 			}
+
+			for rc, id := range branch.Protection.CheckAppIds {
+				if _, ok := b.Protection.CheckAppIds[rc]; ok {
+					continue
+				}
+
+				if b.Protection.CheckAppIds == nil {
+					b.Protection.CheckAppIds = map[string]int64{}
+				}
+
+				b.Protection.CheckAppIds[rc] = id
+			}
 		}
 	}
 }
 
+// sameBranch reports whether two protected branch entries are for the same
+// branch name or the same pattern.
+func sameBranch(a, b *gh_pb.Branch) bool {
+	return strings.EqualFold(a.Name, b.Name) && a.Pattern == b.Pattern
+}
+
 func hasDefaultRequiredCheck(checks []string, check string) bool {
 	for _, c := range checks {
 		if strings.EqualFold(c, check) {
@@ -0,0 +1,351 @@
+package manifest
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/gomicro/concord/report"
+	"golang.org/x/exp/slices"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+const yamlManifest = `organization:
+  name: acme
+  teams:
+    - platform
+  repositories:
+    - name: widgets
+      description: Widgets for everyone
+      topics:
+        - go
+`
+
+const jsonManifest = `{
+  "organization": {
+    "name": "acme",
+    "teams": ["platform"],
+    "repositories": [
+      {"name": "widgets", "description": "Widgets for everyone", "topics": ["go"]}
+    ]
+  }
+}`
+
+// writeManifest writes a manifest to a file with the given name in a
+// directory removed after the test, returning its path.
+func writeManifest(t *testing.T, name, content string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), name)
+
+	err := os.WriteFile(path, []byte(content), 0o600)
+	if err != nil {
+		t.Fatalf("write manifest: %v", err)
+	}
+
+	return path
+}
+
+func TestParseMatchesFile(t *testing.T) {
+	fromFile, err := ReadManifest(writeManifest(t, "concord.yml", yamlManifest))
+	if err != nil {
+		t.Fatalf("read manifest: %v", err)
+	}
+
+	fromReader, err := Parse(strings.NewReader(yamlManifest))
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+
+	if !proto.Equal(fromFile, fromReader) {
+		t.Errorf("parsed %v, read from file %v", fromReader, fromFile)
+	}
+}
+
+func TestReadManifestStdin(t *testing.T) {
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("pipe: %v", err)
+	}
+
+	stdin := os.Stdin
+	os.Stdin = r
+	t.Cleanup(func() { os.Stdin = stdin })
+
+	go func() {
+		w.Write([]byte(yamlManifest)) //nolint: errcheck
+		w.Close()
+	}()
+
+	org, err := ReadManifest("-")
+	if err != nil {
+		t.Fatalf("read manifest: %v", err)
+	}
+
+	want, err := Parse(strings.NewReader(yamlManifest))
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+
+	if !proto.Equal(org, want) {
+		t.Errorf("read %v from stdin, want %v", org, want)
+	}
+}
+
+func TestReadManifestFormats(t *testing.T) {
+	want, err := Parse(strings.NewReader(yamlManifest))
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		file    string
+		content string
+	}{
+		{name: "yaml by extension", file: "concord.yaml", content: yamlManifest},
+		{name: "json by extension", file: "concord.json", content: jsonManifest},
+		{name: "yaml by content", file: "concord", content: yamlManifest},
+		{name: "json by content", file: "concord", content: jsonManifest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			org, err := ReadManifest(writeManifest(t, tt.file, tt.content))
+			if err != nil {
+				t.Fatalf("read manifest: %v", err)
+			}
+
+			if !proto.Equal(org, want) {
+				t.Errorf("read %v, want %v", org, want)
+			}
+		})
+	}
+}
+
+func TestReadManifestBadFormat(t *testing.T) {
+	tests := []struct {
+		name    string
+		file    string
+		content string
+	}{
+		{name: "yaml in a json file", file: "concord.json", content: yamlManifest},
+		{name: "neither", file: "concord", content: "{organization: [acme"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ReadManifest(writeManifest(t, tt.file, tt.content))
+			if !errors.Is(err, ErrManifestFormat) {
+				t.Errorf("err = %v, want %v", err, ErrManifestFormat)
+			}
+		})
+	}
+}
+
+func TestManifestRoundTrip(t *testing.T) {
+	want, err := Parse(strings.NewReader(yamlManifest))
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+
+	b, err := protojson.Marshal(want)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+
+	org, err := Parse(strings.NewReader(`{"organization": ` + string(b) + `}`))
+	if err != nil {
+		t.Fatalf("parse written manifest: %v", err)
+	}
+
+	if !proto.Equal(org, want) {
+		t.Errorf("read back %v, want %v", org, want)
+	}
+}
+
+const defaultsManifest = `organization:
+  name: acme
+  topics:
+    - acme
+  defaults:
+    private: true
+    visibility: internal
+    allow_auto_merge: true
+    has_wiki: false
+    protected_branches:
+      - name: main
+        protection:
+          require_pr: true
+          signed_commits: true
+          required_checks:
+            - ci/build
+  repositories:
+    - name: inherits
+    - name: overrides
+      private: false
+      allow_auto_merge: false
+      has_wiki: true
+      topics:
+        - go
+      protected_branches:
+        - name: main
+          protection:
+            require_pr: false
+            required_checks:
+              - ci/lint
+`
+
+func TestFillDefaults(t *testing.T) {
+	org, err := Parse(strings.NewReader(defaultsManifest))
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+
+	inherits, overrides := org.Repositories[0], org.Repositories[1]
+
+	t.Run("left out settings are inherited", func(t *testing.T) {
+		if !inherits.GetAllowAutoMerge() || inherits.HasWiki == nil || inherits.GetHasWiki() {
+			t.Errorf("allow auto merge = %v, has wiki = %v, want the defaults", inherits.AllowAutoMerge, inherits.HasWiki)
+		}
+
+		// private and visibility both come from the defaults when the repo
+		// sets neither
+		if !inherits.GetPrivate() || inherits.GetVisibility() != "internal" {
+			t.Errorf("private = %v, visibility = %v, want the defaults", inherits.Private, inherits.Visibility)
+		}
+
+		if !slices.Equal(inherits.Topics, []string{"acme"}) {
+			t.Errorf("topics = %v, want the org's", inherits.Topics)
+		}
+
+		if len(inherits.ProtectedBranches) != 1 || !inherits.ProtectedBranches[0].Protection.GetRequirePr() {
+			t.Errorf("protected branches = %v, want the defaults", inherits.ProtectedBranches)
+		}
+	})
+
+	t.Run("settings given as false are kept", func(t *testing.T) {
+		if overrides.Private == nil || overrides.GetPrivate() {
+			t.Errorf("private = %v, want false", overrides.Private)
+		}
+
+		if overrides.Visibility != nil {
+			t.Errorf("visibility = %v, a repo setting private opts out of the default", overrides.GetVisibility())
+		}
+
+		if overrides.AllowAutoMerge == nil || overrides.GetAllowAutoMerge() {
+			t.Errorf("allow auto merge = %v, want false", overrides.AllowAutoMerge)
+		}
+
+		if !overrides.GetHasWiki() {
+			t.Errorf("has wiki = %v, want true", overrides.HasWiki)
+		}
+
+		if !slices.Equal(overrides.Topics, []string{"go", "acme"}) {
+			t.Errorf("topics = %v, want the repo's then the org's", overrides.Topics)
+		}
+	})
+
+	t.Run("branch protection is merged", func(t *testing.T) {
+		if len(overrides.ProtectedBranches) != 1 {
+			t.Fatalf("protected branches = %v, want main only", overrides.ProtectedBranches)
+		}
+
+		p := overrides.ProtectedBranches[0].Protection
+
+		if p.RequirePr == nil || p.GetRequirePr() {
+			t.Errorf("require pr = %v, want false", p.RequirePr)
+		}
+
+		if !p.GetSignedCommits() {
+			t.Errorf("signed commits = %v, want the default", p.SignedCommits)
+		}
+
+		if !slices.Equal(p.RequiredChecks, []string{"ci/lint", "ci/build"}) {
+			t.Errorf("required checks = %v, want the repo's then the default's", p.RequiredChecks)
+		}
+	})
+
+	t.Run("repos do not share inherited values", func(t *testing.T) {
+		*inherits.AllowAutoMerge = false
+
+		if !org.Defaults.GetAllowAutoMerge() {
+			t.Error("changing a repo's inherited setting changed the default")
+		}
+	})
+}
+
+func TestLabelsAsTopics(t *testing.T) {
+	tests := []struct {
+		name       string
+		manifest   string
+		want       []string
+		clear      bool
+		deprecated string
+	}{
+		{
+			name:     "topics",
+			manifest: "organization:\n  name: acme\n  repositories:\n    - name: widgets\n      topics: [go]\n",
+			want:     []string{"go"},
+		},
+		{
+			name:       "labels stand in for topics",
+			manifest:   "organization:\n  name: acme\n  repositories:\n    - name: widgets\n      labels: [go]\n",
+			want:       []string{"go"},
+			deprecated: "repository widgets sets deprecated field 'labels', use 'topics' instead",
+		},
+		{
+			name:       "topics win over labels",
+			manifest:   "organization:\n  name: acme\n  repositories:\n    - name: widgets\n      topics: [go]\n      labels: [python]\n",
+			want:       []string{"go"},
+			deprecated: "repository widgets sets deprecated field 'labels'",
+		},
+		{
+			name:       "org labels stand in for org topics",
+			manifest:   "organization:\n  name: acme\n  labels: [acme]\n  repositories:\n    - name: widgets\n      topics: [go]\n",
+			want:       []string{"go", "acme"},
+			deprecated: "organization sets deprecated field 'labels'",
+		},
+		{
+			name:       "clear labels stands in for clear topics",
+			manifest:   "organization:\n  name: acme\n  repositories:\n    - name: widgets\n      clear_labels: true\n",
+			clear:      true,
+			deprecated: "repository widgets sets deprecated field 'clear_labels', use 'clear_topics' instead",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out bytes.Buffer
+			report.SetOutput(&out)
+			report.SetColor(false)
+			t.Cleanup(func() { report.SetOutput(os.Stdout) })
+
+			org, err := Parse(strings.NewReader(tt.manifest))
+			if err != nil {
+				t.Fatalf("parse: %v", err)
+			}
+
+			r := org.Repositories[0]
+			if !slices.Equal(r.Topics, tt.want) {
+				t.Errorf("topics = %v, want %v", r.Topics, tt.want)
+			}
+
+			if r.GetClearTopics() != tt.clear {
+				t.Errorf("clear topics = %v, want %v", r.GetClearTopics(), tt.clear)
+			}
+
+			if tt.deprecated == "" && out.Len() > 0 {
+				t.Errorf("unexpected warning: %s", out.String())
+			}
+
+			if !strings.Contains(out.String(), tt.deprecated) {
+				t.Errorf("warning missing %q: %s", tt.deprecated, out.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package manifest
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// SchemaVersion is the manifest schema this build of concord understands.
+// Manifests without a schema_version are version 1, the schema from before
+// versioning was added.
+const SchemaVersion = 2
+
+var ErrSchemaVersion = errors.New("unsupported schema_version")
+
+// migrations upgrade the organization of a manifest from the version they are
+// keyed by to the next one. Bump SchemaVersion and add a migration whenever a
+// field changes in a way older manifests would mis-parse.
+var migrations = map[int]func(org interface{}) (interface{}, error){
+	1: migrateTeamNames,
+}
+
+// migrateTeamNames turns the plain team names of version 1 into the team
+// objects of version 2. Teams already written as objects are kept as they are.
+func migrateTeamNames(org interface{}) (interface{}, error) {
+	o, ok := org.(map[string]interface{})
+	if !ok {
+		return org, nil
+	}
+
+	teams, ok := o["teams"].([]interface{})
+	if !ok {
+		return org, nil
+	}
+
+	for i, t := range teams {
+		if name, ok := t.(string); ok {
+			teams[i] = map[string]interface{}{"name": name}
+		}
+	}
+
+	return org, nil
+}
+
+func schemaVersion(v interface{}) (int, error) {
+	var version int
+
+	switch n := v.(type) {
+	case nil:
+		return 1, nil
+	case int:
+		version = n
+	case float64:
+		version = int(n)
+		if float64(version) != n {
+			return 0, fmt.Errorf("%w %v, must be a whole number", ErrSchemaVersion, v)
+		}
+	case json.Number:
+		i, err := n.Int64()
+		if err != nil {
+			return 0, fmt.Errorf("%w %v, must be a whole number", ErrSchemaVersion, v)
+		}
+
+		version = int(i)
+	default:
+		return 0, fmt.Errorf("%w %v, must be a whole number", ErrSchemaVersion, v)
+	}
+
+	if version < 1 {
+		return 0, fmt.Errorf("%w %d", ErrSchemaVersion, version)
+	}
+
+	if version > SchemaVersion {
+		return 0, fmt.Errorf("%w %d, this concord supports up to %d, upgrade concord to use this manifest", ErrSchemaVersion, version, SchemaVersion)
+	}
+
+	return version, nil
+}
+
+// migrate brings an organization at the given version up to SchemaVersion.
+func migrate(version int, org interface{}) (interface{}, error) {
+	for v := version; v < SchemaVersion; v++ {
+		m, ok := migrations[v]
+		if !ok {
+			return nil, fmt.Errorf("no migration from schema_version %d", v)
+		}
+
+		var err error
+		org, err = m(org)
+		if err != nil {
+			return nil, fmt.Errorf("migrate from schema_version %d: %w", v, err)
+		}
+	}
+
+	return org, nil
+}
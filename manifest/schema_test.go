@@ -0,0 +1,74 @@
+package manifest
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	gh_pb "github.com/gomicro/concord/github/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// v1Manifest is written the way manifests were before schema_version, with
+// teams as plain names.
+const v1Manifest = `organization:
+  name: acme
+  teams:
+    - platform
+    - security
+`
+
+func TestMigrateV1(t *testing.T) {
+	want := []*gh_pb.Team{{Name: "platform"}, {Name: "security"}}
+
+	tests := []struct {
+		name     string
+		manifest string
+	}{
+		{name: "unversioned", manifest: v1Manifest},
+		{name: "version 1", manifest: "schema_version: 1\n" + v1Manifest},
+		{name: "mixed names and objects", manifest: "schema_version: 1\norganization:\n  name: acme\n  teams:\n    - platform\n    - name: security\n"},
+		{name: "current", manifest: "schema_version: 2\norganization:\n  name: acme\n  teams:\n    - name: platform\n    - name: security\n"},
+		{name: "json", manifest: `{"organization": {"name": "acme", "teams": ["platform", "security"]}}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			org, err := Parse(strings.NewReader(tt.manifest))
+			if err != nil {
+				t.Fatalf("parse: %v", err)
+			}
+
+			if len(org.Teams) != len(want) {
+				t.Fatalf("teams = %v, want %v", org.Teams, want)
+			}
+
+			for i := range want {
+				if !proto.Equal(org.Teams[i], want[i]) {
+					t.Errorf("team %d = %v, want %v", i, org.Teams[i], want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestSchemaVersionRejected(t *testing.T) {
+	tests := []struct {
+		name    string
+		version string
+	}{
+		{name: "future", version: "3"},
+		{name: "zero", version: "0"},
+		{name: "fraction", version: "1.5"},
+		{name: "not a number", version: "two"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(strings.NewReader("schema_version: " + tt.version + "\n" + v1Manifest))
+			if !errors.Is(err, ErrSchemaVersion) {
+				t.Errorf("err = %v, want %v", err, ErrSchemaVersion)
+			}
+		})
+	}
+}
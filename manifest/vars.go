@@ -0,0 +1,56 @@
+package manifest
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+)
+
+var ErrUndefinedVar = errors.New("undefined manifest variable")
+
+var varPattern = regexp.MustCompile(`\$\{([A-Za-z_][A-Za-z0-9_]*)\}`)
+
+var (
+	vars       = map[string]string{}
+	strictVars bool
+)
+
+// SetVars sets the values substituted for ${NAME} references in manifests,
+// which take precedence over environment variables of the same name. When
+// strict, a reference to a variable defined in neither is an error, otherwise
+// it is left as written.
+func SetVars(v map[string]string, strict bool) {
+	vars = v
+	strictVars = strict
+}
+
+// substitute replaces the ${NAME} references in a manifest before it is
+// decoded. Values are inserted as they are, so ones holding quotes or YAML
+// syntax should be quoted in the manifest.
+func substitute(b []byte) ([]byte, error) {
+	var undefined []string
+
+	out := varPattern.ReplaceAllFunc(b, func(ref []byte) []byte {
+		name := string(varPattern.FindSubmatch(ref)[1])
+
+		if v, ok := vars[name]; ok {
+			return []byte(v)
+		}
+
+		if v, ok := os.LookupEnv(name); ok {
+			return []byte(v)
+		}
+
+		undefined = append(undefined, name)
+
+		return ref
+	})
+
+	if strictVars && len(undefined) > 0 {
+		return nil, fmt.Errorf("%w: %s", ErrUndefinedVar, strings.Join(undefined, ", "))
+	}
+
+	return out, nil
+}
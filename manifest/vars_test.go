@@ -0,0 +1,59 @@
+package manifest
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestSubstitute(t *testing.T) {
+	t.Setenv("CONCORD_TEST_ORG", "from-env")
+	t.Setenv("CONCORD_TEST_TEAM", "platform")
+
+	tests := []struct {
+		name    string
+		vars    map[string]string
+		strict  bool
+		in      string
+		want    string
+		wantErr error
+	}{
+		{name: "from a var", vars: map[string]string{"ORG": "acme"}, in: "name: ${ORG}", want: "name: acme"},
+		{name: "from the environment", in: "name: ${CONCORD_TEST_TEAM}", want: "name: platform"},
+		{name: "vars before the environment", vars: map[string]string{"CONCORD_TEST_ORG": "acme"}, in: "name: ${CONCORD_TEST_ORG}", want: "name: acme"},
+		{name: "undefined and lax", in: "name: ${CONCORD_TEST_UNSET}", want: "name: ${CONCORD_TEST_UNSET}"},
+		{name: "undefined and strict", strict: true, in: "name: ${CONCORD_TEST_UNSET}", wantErr: ErrUndefinedVar},
+		{name: "defined and strict", vars: map[string]string{"ORG": "acme"}, strict: true, in: "${ORG}/${CONCORD_TEST_TEAM}", want: "acme/platform"},
+		{name: "not a reference", strict: true, in: "cost: $5 {x} $NAME", want: "cost: $5 {x} $NAME"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SetVars(tt.vars, tt.strict)
+			t.Cleanup(func() { SetVars(map[string]string{}, false) })
+
+			got, err := substitute([]byte(tt.in))
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("err = %v, want %v", err, tt.wantErr)
+			}
+
+			if tt.wantErr == nil && string(got) != tt.want {
+				t.Errorf("substituted %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseSubstitutes(t *testing.T) {
+	SetVars(map[string]string{"ORG": "acme"}, true)
+	t.Cleanup(func() { SetVars(map[string]string{}, false) })
+
+	org, err := Parse(strings.NewReader("organization:\n  name: ${ORG}\n"))
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+
+	if org.Name != "acme" {
+		t.Errorf("name = %s, want acme", org.Name)
+	}
+}
@@ -0,0 +1,40 @@
+package report
+
+import (
+	"strings"
+)
+
+// Format is how the print helpers render their output.
+type Format int
+
+const (
+	// Text is plain, optionally colored, text for a terminal.
+	Text Format = iota
+	// GitHubActions renders warnings, errors, and changes as GitHub Actions
+	// workflow commands, so they show up as annotations on the run and its
+	// pull request.
+	GitHubActions
+)
+
+var format = Text
+
+// SetFormat sets how all print helpers render their output. Colors are turned
+// off for GitHub Actions, as annotations show them as raw escape codes.
+func SetFormat(f Format) {
+	format = f
+
+	if f == GitHubActions {
+		color = false
+	}
+}
+
+// commandEscaper escapes the characters GitHub Actions would otherwise read
+// as the end of a workflow command's message.
+var commandEscaper = strings.NewReplacer("%", "%25", "\r", "%0D", "\n", "%0A")
+
+// workflowCommand formats text as a GitHub Actions workflow command, such as
+// ::warning::text. Commands are only recognized at the start of a line, so
+// they are not indented.
+func workflowCommand(name, text string) string {
+	return "::" + name + "::" + commandEscaper.Replace(text)
+}
@@ -0,0 +1,77 @@
+package report
+
+import (
+	"bytes"
+	"sync"
+)
+
+// Buffer collects the output of one unit of work, such as reconciling a repo,
+// so workers running at the same time do not interleave their lines. It has
+// the same print helpers as the package, rendered the same way, and is
+// written to the output by the Buffers it came from once it is done. A Buffer
+// is only meant to be written to by one goroutine.
+type Buffer struct {
+	printer
+	buf  bytes.Buffer
+	done bool
+}
+
+func (b *Buffer) PrintHeader(text string)  { b.header(text) }
+func (b *Buffer) PrintSection(text string) { b.section(text) }
+func (b *Buffer) Println()                 { b.println() }
+func (b *Buffer) PrintInfo(text string)    { b.info(text) }
+func (b *Buffer) PrintTrace(text string)   { b.trace(text) }
+func (b *Buffer) PrintWarn(text string)    { b.colored(colorYellow, "warning", text) }
+func (b *Buffer) PrintSuccess(text string) { b.success(text) }
+func (b *Buffer) PrintError(text string)   { b.colored(colorRed, "error", text) }
+func (b *Buffer) PrintAdd(text string)     { b.colored(colorGreen, "notice", text) }
+func (b *Buffer) PrintDelete(text string)  { b.colored(colorRed, "warning", text) }
+
+// Buffers hands out a Buffer for each unit of work and writes them to the
+// output in the order they were handed out, no matter which finishes first.
+// A buffer is written as soon as it and every one before it are done, so
+// output keeps flowing rather than waiting for the whole run.
+type Buffers struct {
+	mu      sync.Mutex
+	pending []*Buffer
+}
+
+// Next returns a new buffer, to be written after every one handed out before
+// it.
+func (bs *Buffers) Next() *Buffer {
+	b := &Buffer{}
+	b.w = &b.buf
+
+	bs.mu.Lock()
+	bs.pending = append(bs.pending, b)
+	bs.mu.Unlock()
+
+	return b
+}
+
+// Done marks a buffer finished and writes out every finished buffer at the
+// front of the queue. The buffer must not be written to afterwards.
+func (bs *Buffers) Done(b *Buffer) {
+	bs.mu.Lock()
+	defer bs.mu.Unlock()
+
+	b.done = true
+
+	for len(bs.pending) > 0 && bs.pending[0].done {
+		out.Write(bs.pending[0].buf.Bytes()) //nolint: errcheck
+		bs.pending = bs.pending[1:]
+	}
+}
+
+// Flush writes out every buffer still queued, finished or not, in order. It
+// is meant for when a run stops early, so partial output is not lost.
+func (bs *Buffers) Flush() {
+	bs.mu.Lock()
+	defer bs.mu.Unlock()
+
+	for _, b := range bs.pending {
+		out.Write(b.buf.Bytes()) //nolint: errcheck
+	}
+
+	bs.pending = nil
+}
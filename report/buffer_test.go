@@ -0,0 +1,110 @@
+package report
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestBuffersConcurrentWriters(t *testing.T) {
+	out := capture(t)
+
+	const workers, lines = 20, 50
+
+	var bs Buffers
+
+	bufs := make([]*Buffer, workers)
+	for i := range bufs {
+		bufs[i] = bs.Next()
+	}
+
+	var wg sync.WaitGroup
+	for i, b := range bufs {
+		wg.Add(1)
+
+		go func(i int, b *Buffer) {
+			defer wg.Done()
+
+			// later workers finish first
+			time.Sleep(time.Duration(workers-i) * time.Millisecond)
+
+			b.PrintSection(fmt.Sprintf("repo%02d", i))
+			b.Println()
+
+			for j := 0; j < lines; j++ {
+				b.PrintInfo(fmt.Sprintf("repo%02d line%02d", i, j))
+				b.Println()
+			}
+
+			bs.Done(b)
+		}(i, b)
+	}
+
+	wg.Wait()
+
+	var got []string
+	for _, l := range strings.Split(out.String(), "\n") {
+		if strings.Contains(l, " line") {
+			got = append(got, l[strings.Index(l, "repo"):])
+		}
+	}
+
+	if len(got) != workers*lines {
+		t.Fatalf("wrote %d lines, want %d:\n%s", len(got), workers*lines, out)
+	}
+
+	for i := 0; i < workers; i++ {
+		for j := 0; j < lines; j++ {
+			want := fmt.Sprintf("repo%02d line%02d", i, j)
+			if got[i*lines+j] != want {
+				t.Fatalf("line %d = %q, want %q", i*lines+j, got[i*lines+j], want)
+			}
+		}
+	}
+}
+
+func TestBuffersWaitForEarlierBuffers(t *testing.T) {
+	out := capture(t)
+
+	var bs Buffers
+	first, second := bs.Next(), bs.Next()
+
+	second.PrintInfo("second")
+	second.Println()
+	bs.Done(second)
+
+	if out.Len() != 0 {
+		t.Fatalf("wrote %q before the first buffer was done", out)
+	}
+
+	first.PrintInfo("first")
+	first.Println()
+	bs.Done(first)
+
+	if !strings.Contains(out.String(), "first") || strings.Index(out.String(), "first") > strings.Index(out.String(), "second") {
+		t.Errorf("wrote %q, want first then second", out)
+	}
+}
+
+func TestBuffersFlush(t *testing.T) {
+	out := capture(t)
+
+	var bs Buffers
+	first, second := bs.Next(), bs.Next()
+
+	first.PrintInfo("first")
+	first.Println()
+
+	second.PrintInfo("second")
+	second.Println()
+	bs.Done(second)
+
+	// the first never finished, as when a run stops early
+	bs.Flush()
+
+	if !strings.Contains(out.String(), "first") || strings.Index(out.String(), "first") > strings.Index(out.String(), "second") {
+		t.Errorf("flushed %q, want first then second", out)
+	}
+}
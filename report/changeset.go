@@ -1,5 +1,26 @@
 package report
 
+// planned counts the changes printed by all change sets, letting callers tell
+// whether reconciling a resource found anything to do.
+var planned int
+
+// described holds the lines printed by change sets since they were last
+// taken, so the change they describe can carry them.
+var described []string
+
+// Planned returns the number of changes planned so far.
+func Planned() int {
+	return planned
+}
+
+// TakeDescribed returns the lines printed by change sets since the last call.
+func TakeDescribed() []string {
+	d := described
+	described = nil
+
+	return d
+}
+
 type ChangeSet struct {
 	changes []change
 }
@@ -11,8 +32,17 @@ func (c *ChangeSet) Add(pre, post string) {
 	})
 }
 
+// HasChanges reports whether any changes were added to the set.
+func (c *ChangeSet) HasChanges() bool {
+	return len(c.changes) > 0
+}
+
 func (c *ChangeSet) PrintPre() {
+	planned += len(c.changes)
+
 	for i := range c.changes {
+		described = append(described, c.changes[i].pre)
+
 		PrintAdd(c.changes[i].pre)
 		Println()
 	}
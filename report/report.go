@@ -1,6 +1,10 @@
 package report
 
-import "fmt"
+import (
+	"fmt"
+	"io"
+	"os"
+)
 
 const (
 	colorRed    = "\033[1;31m"
@@ -13,34 +17,190 @@ const (
 	colorReset  = "\033[0m"
 )
 
+// Level controls how much output the print helpers produce.
+type Level int
+
+const (
+	// Quiet drops informational lines about things already in sync.
+	Quiet Level = iota - 1
+	// Normal is the default level.
+	Normal
+	// Verbose adds tracing of every API call.
+	Verbose
+)
+
+var (
+	out io.Writer = os.Stdout
+
+	level = Normal
+
+	// color is on by default only when writing to a terminal and NO_COLOR
+	// is not set, see https://no-color.org.
+	color = isTerminal(os.Stdout) && os.Getenv("NO_COLOR") == ""
+
+	// std prints to out for the package level helpers.
+	std = &printer{}
+)
+
+// SetLevel sets the output level for all print helpers.
+func SetLevel(l Level) {
+	level = l
+}
+
+// SetOutput sets where all print helpers write to. Colors are turned off
+// when the writer is not a terminal.
+func SetOutput(w io.Writer) {
+	out = w
+
+	if f, ok := w.(*os.File); !ok || !isTerminal(f) {
+		color = false
+	}
+}
+
+// SetColor turns ANSI colors on or off for all print helpers.
+func SetColor(enabled bool) {
+	color = enabled
+}
+
+func isTerminal(f *os.File) bool {
+	fi, err := f.Stat()
+	if err != nil {
+		return false
+	}
+
+	return fi.Mode()&os.ModeCharDevice != 0
+}
+
+func paint(c, text string) string {
+	if !color {
+		return text
+	}
+
+	return c + text + colorReset
+}
+
+// printer writes lines for the print helpers. Lines go to w, or to the
+// package output when w is nil.
+type printer struct {
+	w io.Writer
+
+	// skipped is set when the last print was dropped, so the Println ending
+	// that line is dropped with it.
+	skipped bool
+}
+
+func (p *printer) writer() io.Writer {
+	if p.w != nil {
+		return p.w
+	}
+
+	return out
+}
+
+func (p *printer) header(text string) {
+	p.skipped = false
+	fmt.Fprint(p.writer(), paint(colorBlue, text))
+}
+
+func (p *printer) section(text string) {
+	p.skipped = false
+	fmt.Fprint(p.writer(), paint(colorPurple, "== "+text+" =="))
+}
+
+func (p *printer) println() {
+	if p.skipped {
+		p.skipped = false
+		return
+	}
+
+	fmt.Fprintln(p.writer())
+}
+
+func (p *printer) info(text string) {
+	if level < Normal {
+		p.skipped = true
+		return
+	}
+
+	p.skipped = false
+	fmt.Fprint(p.writer(), "  "+paint(colorWhite, text))
+}
+
+func (p *printer) prompt(text string) {
+	p.skipped = false
+	fmt.Fprint(p.writer(), "  "+paint(colorWhite, text))
+}
+
+func (p *printer) trace(text string) {
+	if level < Verbose {
+		return
+	}
+
+	fmt.Fprintln(p.writer(), "  "+paint(colorCyan, text))
+}
+
+// colored prints text in a color, or as a workflow command when rendering
+// for GitHub Actions.
+func (p *printer) colored(c, command, text string) {
+	p.skipped = false
+
+	if format == GitHubActions {
+		fmt.Fprint(p.writer(), workflowCommand(command, text))
+		return
+	}
+
+	fmt.Fprint(p.writer(), "  "+paint(c, text))
+}
+
+func (p *printer) success(text string) {
+	p.skipped = false
+	fmt.Fprint(p.writer(), "  "+paint(colorGreen, text))
+}
+
 func PrintHeader(text string) {
-	fmt.Printf("%s%s%s", colorBlue, text, colorReset)
+	std.header(text)
+}
+
+// PrintSection prints a header set apart from the others, for sections such
+// as repos that repeat many times in a run.
+func PrintSection(text string) {
+	std.section(text)
 }
 
 func Println() {
-	fmt.Println()
+	std.println()
 }
 
 func PrintInfo(text string) {
-	fmt.Printf("  %s%s%s", colorWhite, text, colorReset)
+	std.info(text)
+}
+
+// PrintPrompt prints a question for the user regardless of the output level.
+func PrintPrompt(text string) {
+	std.prompt(text)
+}
+
+// PrintTrace prints a full line of debug output when running verbosely.
+func PrintTrace(text string) {
+	std.trace(text)
 }
 
 func PrintWarn(text string) {
-	fmt.Printf("  %s%s%s", colorYellow, text, colorReset)
+	std.colored(colorYellow, "warning", text)
 }
 
 func PrintSuccess(text string) {
-	fmt.Printf("  %s%s%s", colorGreen, text, colorReset)
+	std.success(text)
 }
 
 func PrintError(text string) {
-	fmt.Printf("  %s%s%s", colorRed, text, colorReset)
+	std.colored(colorRed, "error", text)
 }
 
 func PrintAdd(text string) {
-	fmt.Printf("  %s%s%s", colorGreen, text, colorReset)
+	std.colored(colorGreen, "notice", text)
 }
 
 func PrintDelete(text string) {
-	fmt.Printf("  %s%s%s", colorRed, text, colorReset)
+	std.colored(colorRed, "warning", text)
 }
@@ -0,0 +1,99 @@
+package report
+
+import (
+	"bytes"
+	"os"
+	"strings"
+	"testing"
+)
+
+// capture sends the print helpers to a buffer for the length of the test,
+// putting the package settings back afterwards.
+func capture(t *testing.T) *bytes.Buffer {
+	t.Helper()
+
+	prevColor, prevLevel := color, level
+	t.Cleanup(func() {
+		out = os.Stdout
+		color, level = prevColor, prevLevel
+	})
+
+	var buf bytes.Buffer
+	SetOutput(&buf)
+
+	return &buf
+}
+
+func printAll() {
+	PrintHeader("header")
+	Println()
+	PrintInfo("info")
+	Println()
+	PrintPrompt("prompt")
+	Println()
+	PrintWarn("warn")
+	Println()
+	PrintSuccess("success")
+	Println()
+	PrintError("error")
+	Println()
+	PrintAdd("add")
+	Println()
+	PrintDelete("delete")
+	Println()
+}
+
+func TestColorDisabled(t *testing.T) {
+	buf := capture(t)
+	SetColor(false)
+
+	printAll()
+
+	if strings.Contains(buf.String(), "\033[") {
+		t.Errorf("output has color codes:\n%q", buf.String())
+	}
+
+	for _, want := range []string{"header", "  info", "  warn", "  delete"} {
+		if !strings.Contains(buf.String(), want) {
+			t.Errorf("output is missing %q:\n%s", want, buf.String())
+		}
+	}
+}
+
+func TestColorEnabled(t *testing.T) {
+	buf := capture(t)
+	SetColor(true)
+
+	printAll()
+
+	if !strings.Contains(buf.String(), colorYellow+"warn"+colorReset) {
+		t.Errorf("output has no color codes:\n%q", buf.String())
+	}
+}
+
+func TestPipeIsNotTerminal(t *testing.T) {
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("pipe: %v", err)
+	}
+	defer r.Close()
+	defer w.Close()
+
+	if isTerminal(w) {
+		t.Errorf("a pipe is reported as a terminal")
+	}
+}
+
+func TestSetOutputDisablesColorOffTerminal(t *testing.T) {
+	capture(t)
+	SetColor(true)
+
+	var buf bytes.Buffer
+	SetOutput(&buf)
+
+	PrintWarn("warn")
+
+	if buf.String() != "  warn" {
+		t.Errorf("output = %q, want it without color", buf.String())
+	}
+}
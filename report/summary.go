@@ -0,0 +1,141 @@
+package report
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Action is the outcome of reconciling a single resource.
+type Action int
+
+const (
+	Created Action = iota
+	Updated
+	Invited
+	Removed
+	Unchanged
+	Unmanaged
+	Failed
+)
+
+var actionWords = map[Action][2]string{
+	Created:   {"to create", "created"},
+	Updated:   {"to update", "updated"},
+	Invited:   {"to invite", "invited"},
+	Removed:   {"to remove", "removed"},
+	Unchanged: {"unchanged", "unchanged"},
+	Unmanaged: {"unmanaged", "unmanaged"},
+	Failed:    {"failed", "failed"},
+}
+
+var actionNames = map[Action]string{
+	Created:   "created",
+	Updated:   "updated",
+	Invited:   "invited",
+	Removed:   "removed",
+	Unchanged: "unchanged",
+	Unmanaged: "unmanaged",
+	Failed:    "failed",
+}
+
+// String returns the action's name, such as created.
+func (a Action) String() string {
+	return actionNames[a]
+}
+
+// SectionCounts is how many resources in a section ended with each outcome,
+// keyed by the outcome's name. Outcomes no resource had are left out.
+type SectionCounts struct {
+	Section string         `json:"section"`
+	Counts  map[string]int `json:"counts"`
+}
+
+// Summary aggregates resource outcomes per section, such as "Repos" or
+// "Members", for printing at the end of a run.
+type Summary struct {
+	sections []string
+	counts   map[string]map[Action]int
+}
+
+func NewSummary() *Summary {
+	return &Summary{
+		counts: map[string]map[Action]int{},
+	}
+}
+
+// Count records n resources in a section with the given outcome.
+func (s *Summary) Count(section string, action Action, n int) {
+	if n <= 0 {
+		return
+	}
+
+	if _, ok := s.counts[section]; !ok {
+		s.sections = append(s.sections, section)
+		s.counts[section] = map[Action]int{}
+	}
+
+	s.counts[section][action] += n
+}
+
+// Sections returns the counts of every section in the order they were first
+// counted.
+func (s *Summary) Sections() []SectionCounts {
+	sections := []SectionCounts{}
+	for _, section := range s.sections {
+		counts := map[string]int{}
+		for a, n := range s.counts[section] {
+			counts[a.String()] = n
+		}
+
+		sections = append(sections, SectionCounts{Section: section, Counts: counts})
+	}
+
+	return sections
+}
+
+// Print renders one line per section in the order they were first counted.
+// Dry runs are worded as what would happen rather than what did.
+func (s *Summary) Print(dry bool) {
+	if len(s.sections) == 0 {
+		return
+	}
+
+	word := 1
+	if dry {
+		word = 0
+	}
+
+	Println()
+	PrintHeader("Summary")
+	Println()
+
+	for _, section := range s.sections {
+		parts := []string{}
+		for a := Created; a <= Failed; a++ {
+			if n := s.counts[section][a]; n > 0 {
+				parts = append(parts, fmt.Sprintf("%d %s", n, actionWords[a][word]))
+			}
+		}
+
+		// printed as a prompt so the summary survives quiet output
+		PrintPrompt(section + ": " + strings.Join(parts, ", "))
+		Println()
+	}
+}
+
+var summary = NewSummary()
+
+// Count records outcomes on the default summary.
+func Count(section string, action Action, n int) {
+	summary.Count(section, action, n)
+}
+
+// PrintSummary prints the default summary.
+func PrintSummary(dry bool) {
+	summary.Print(dry)
+}
+
+// Sections returns the counts on the default summary.
+func Sections() []SectionCounts {
+	return summary.Sections()
+}